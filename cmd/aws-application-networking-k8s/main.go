@@ -27,6 +27,7 @@ import (
 	"go.uber.org/zap/zapcore"
 	k8swebhook "sigs.k8s.io/controller-runtime/pkg/webhook"
 	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	"github.com/aws/aws-application-networking-k8s/pkg/aws"
 	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
@@ -50,6 +51,7 @@ import (
 	//+kubebuilder:scaffold:imports
 	anv1alpha1 "github.com/aws/aws-application-networking-k8s/pkg/apis/applicationnetworking/v1alpha1"
 	"github.com/aws/aws-application-networking-k8s/pkg/config"
+	"github.com/aws/aws-application-networking-k8s/pkg/deploy/lattice"
 	"github.com/aws/aws-application-networking-k8s/pkg/k8s"
 	discoveryv1 "k8s.io/api/discovery/v1"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
@@ -67,6 +69,7 @@ func init() {
 	//+kubebuilder:scaffold:scheme
 	utilruntime.Must(gwv1alpha2.Install(scheme))
 	utilruntime.Must(gwv1.Install(scheme))
+	utilruntime.Must(gwv1beta1.Install(scheme))
 	utilruntime.Must(anv1alpha1.Install(scheme))
 	utilruntime.Must(discoveryv1.AddToScheme(scheme))
 	addOptionalCRDs(scheme)
@@ -90,7 +93,9 @@ func addOptionalCRDs(scheme *runtime.Scheme) {
 		&anv1alpha1.AccessLogPolicy{}, &anv1alpha1.AccessLogPolicyList{},
 		&anv1alpha1.VpcAssociationPolicy{}, &anv1alpha1.VpcAssociationPolicyList{},
 		&anv1alpha1.IAMAuthPolicy{}, &anv1alpha1.IAMAuthPolicyList{},
-		&anv1alpha1.ServiceNetwork{}, &anv1alpha1.ServiceNetworkList{})
+		&anv1alpha1.ServiceNetwork{}, &anv1alpha1.ServiceNetworkList{},
+		&anv1alpha1.GatewayClassConfig{}, &anv1alpha1.GatewayClassConfigList{},
+		&anv1alpha1.ServiceNetworkEndpoint{}, &anv1alpha1.ServiceNetworkEndpointList{})
 
 	metav1.AddToGroupVersion(scheme, groupVersion)
 }
@@ -251,7 +256,16 @@ func main() {
 		setupLog.Fatalf("gateway controller setup failed: %s", err)
 	}
 
-	err = controllers.RegisterAllRouteControllers(ctrlLog.Named("route"), cloud, finalizerManager, mgr)
+	var targetsFastPath *lattice.TargetsFastPath
+	if config.TargetsFastPath {
+		targetsFastPath = lattice.NewTargetsFastPath(ctrlLog.Named("targets-fast-path"), mgr.GetClient(),
+			lattice.NewTargetsManager(ctrlLog.Named("targets-fast-path"), cloud), config.TargetsCoalesceWindow)
+		if err := mgr.Add(targetsFastPath); err != nil {
+			setupLog.Fatalf("targets fast path setup failed: %s", err)
+		}
+	}
+
+	err = controllers.RegisterAllRouteControllers(ctrlLog.Named("route"), cloud, finalizerManager, mgr, targetsFastPath)
 	if err != nil {
 		setupLog.Fatalf("route controller setup failed: %s", err)
 	}
@@ -261,7 +275,7 @@ func main() {
 		setupLog.Fatalf("serviceimport controller setup failed: %s", err)
 	}
 
-	err = controllers.RegisterServiceExportController(ctrlLog.Named("service-export"), cloud, finalizerManager, mgr)
+	err = controllers.RegisterServiceExportController(ctrlLog.Named("service-export"), cloud, finalizerManager, mgr, targetsFastPath)
 	if err != nil {
 		setupLog.Fatalf("serviceexport controller setup failed: %s", err)
 	}
@@ -296,6 +310,17 @@ func main() {
 	} else {
 		setupLog.Infof("ServiceNetwork CRD not installed, skipping controller registration")
 	}
+
+	if ok, err := k8s.IsGVKSupported(mgr, anv1alpha1.GroupVersion.String(), anv1alpha1.ServiceNetworkEndpointKind); err != nil {
+		setupLog.Fatalf("error checking ServiceNetworkEndpoint CRD: %s", err)
+	} else if ok {
+		err = controllers.RegisterServiceNetworkEndpointController(ctrlLog.Named("service-network-endpoint"), cloud, finalizerManager, mgr)
+		if err != nil {
+			setupLog.Fatalf("service network endpoint controller setup failed: %s", err)
+		}
+	} else {
+		setupLog.Infof("ServiceNetworkEndpoint CRD not installed, skipping controller registration")
+	}
 	//+kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
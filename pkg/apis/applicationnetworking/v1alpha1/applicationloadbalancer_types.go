@@ -0,0 +1,62 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ApplicationLoadBalancerKind = "ApplicationLoadBalancer"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=gateway-api,shortName=alb
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Load Balancer ARN",type=string,JSONPath=`.spec.loadBalancerArn`
+// +kubebuilder:printcolumn:name="Port",type=integer,JSONPath=`.spec.port`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ApplicationLoadBalancer is an existing Application Load Balancer that HTTPRoute rules can forward requests to.
+// It is referenced from a rule's backendRefs, and gets its own VPC Lattice ALB target group.
+type ApplicationLoadBalancer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ApplicationLoadBalancerSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+// ApplicationLoadBalancerList contains a list of ApplicationLoadBalancers.
+type ApplicationLoadBalancerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ApplicationLoadBalancer `json:"items"`
+}
+
+// ApplicationLoadBalancerSpec defines the desired state of ApplicationLoadBalancer.
+type ApplicationLoadBalancerSpec struct {
+	// The ARN of the internal Application Load Balancer. It must be in the VPC of the cluster.
+	//
+	// +kubebuilder:validation:Pattern=`^arn:aws[a-z-]*:elasticloadbalancing:[a-z0-9-]+:\d{12}:loadbalancer/app/[a-zA-Z0-9-]+/[a-z0-9]+$`
+	LoadBalancerArn string `json:"loadBalancerArn"`
+
+	// The port of the load balancer listener receiving the traffic.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+
+	// The protocol of the load balancer listener. Defaults to HTTP.
+	//
+	// +optional
+	// +kubebuilder:validation:Enum=HTTP;HTTPS
+	// +kubebuilder:default=HTTP
+	Protocol *string `json:"protocol,omitempty"`
+
+	// The protocol version used to forward requests to the load balancer. Defaults to HTTP1.
+	//
+	// +optional
+	// +kubebuilder:validation:Enum=HTTP1;HTTP2
+	// +kubebuilder:default=HTTP1
+	ProtocolVersion *string `json:"protocolVersion,omitempty"`
+}
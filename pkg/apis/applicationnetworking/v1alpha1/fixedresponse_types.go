@@ -0,0 +1,42 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	FixedResponseKind = "FixedResponse"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=gateway-api,shortName=fr
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Status Code",type=integer,JSONPath=`.spec.statusCode`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// FixedResponse is an HTTPRoute filter that makes a rule return a fixed response instead of
+// forwarding requests to its backendRefs. It is referenced from a rule's filters[].extensionRef.
+type FixedResponse struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec FixedResponseSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+// FixedResponseList contains a list of FixedResponses.
+type FixedResponseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FixedResponse `json:"items"`
+}
+
+// FixedResponseSpec defines the desired state of FixedResponse.
+type FixedResponseSpec struct {
+	// The HTTP status code returned for requests matching the rule.
+	// VPC Lattice only supports 404 and 500 fixed responses.
+	//
+	// +kubebuilder:validation:Enum=404;500
+	StatusCode int32 `json:"statusCode"`
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	GatewayClassConfigKind = "GatewayClassConfig"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,categories=gateway-api,shortName=gcc
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="AutoProvision",type=boolean,JSONPath=`.spec.autoProvisionServiceNetwork`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// GatewayClassConfig is a cluster scoped resource referenced by the parametersRef of a GatewayClass.
+// It configures the Gateways of that GatewayClass.
+type GatewayClassConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec GatewayClassConfigSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// GatewayClassConfigList contains a list of GatewayClassConfigs.
+type GatewayClassConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GatewayClassConfig `json:"items"`
+}
+
+// GatewayClassConfigSpec defines the desired state of GatewayClassConfig.
+type GatewayClassConfigSpec struct {
+	// AutoProvisionServiceNetwork indicates whether the controller creates the service network of a Gateway
+	// when it does not exist, and deletes it along with the Gateway when no services remain associated with it.
+	//
+	// +optional
+	AutoProvisionServiceNetwork bool `json:"autoProvisionServiceNetwork,omitempty"`
+
+	// Tags applied to the provisioned service networks, in addition to the tags of the controller.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxProperties=40
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// The auth type of the provisioned service networks. An IAMAuthPolicy attached to the Gateway
+	// sets the AWS_IAM auth type regardless.
+	//
+	// +optional
+	// +kubebuilder:default=NONE
+	// +kubebuilder:validation:Enum=NONE;AWS_IAM
+	AuthType *string `json:"authType,omitempty"`
+
+	// AssociateWithVpc indicates whether the provisioned service networks are associated with the VPC of the cluster.
+	//
+	// This value will be considered true by default.
+	// +optional
+	AssociateWithVpc *bool `json:"associateWithVpc,omitempty"`
+
+	// SecurityGroupIds defines the security groups enforced on the association with the VPC of the cluster.
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=5
+	SecurityGroupIds []SecurityGroupId `json:"securityGroupIds,omitempty"`
+}
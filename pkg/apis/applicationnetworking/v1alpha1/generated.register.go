@@ -62,14 +62,26 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&AccessLogPolicy{},
 		&AccessLogPolicyList{},
+		&ApplicationLoadBalancer{},
+		&ApplicationLoadBalancerList{},
+		&FixedResponse{},
+		&FixedResponseList{},
+		&GatewayClassConfig{},
+		&GatewayClassConfigList{},
+		&HeaderMatchFilter{},
+		&HeaderMatchFilterList{},
 		&IAMAuthPolicy{},
 		&IAMAuthPolicyList{},
+		&LambdaFunction{},
+		&LambdaFunctionList{},
 		&ServiceExport{},
 		&ServiceExportList{},
 		&ServiceImport{},
 		&ServiceImportList{},
 		&ServiceNetwork{},
 		&ServiceNetworkList{},
+		&ServiceNetworkEndpoint{},
+		&ServiceNetworkEndpointList{},
 		&TargetGroupPolicy{},
 		&TargetGroupPolicyList{},
 		&VpcAssociationPolicy{},
@@ -0,0 +1,42 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	HeaderMatchFilterKind = "HeaderMatchFilter"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=gateway-api,shortName=hmf
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Case Insensitive",type=boolean,JSONPath=`.spec.caseInsensitive`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// HeaderMatchFilter is an HTTPRoute and GRPCRoute filter that changes how the header matches of a
+// rule are translated to VPC Lattice. It is referenced from a rule's filters[].extensionRef.
+type HeaderMatchFilter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec HeaderMatchFilterSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+// HeaderMatchFilterList contains a list of HeaderMatchFilters.
+type HeaderMatchFilterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HeaderMatchFilter `json:"items"`
+}
+
+// HeaderMatchFilterSpec defines the desired state of HeaderMatchFilter.
+type HeaderMatchFilterSpec struct {
+	// Makes all header value matches of the rule case-insensitive, including prefix and contains
+	// matches translated from regular expressions, which are otherwise case-sensitive.
+	//
+	// +optional
+	CaseInsensitive bool `json:"caseInsensitive,omitempty"`
+}
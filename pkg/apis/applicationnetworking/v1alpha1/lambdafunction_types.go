@@ -0,0 +1,49 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	LambdaFunctionKind = "LambdaFunction"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=gateway-api,shortName=lf
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Function ARN",type=string,JSONPath=`.spec.functionArn`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// LambdaFunction is an AWS Lambda function that HTTPRoute rules can forward requests to.
+// It is referenced from a rule's backendRefs, and gets its own VPC Lattice LAMBDA target group.
+type LambdaFunction struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec LambdaFunctionSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+// LambdaFunctionList contains a list of LambdaFunctions.
+type LambdaFunctionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LambdaFunction `json:"items"`
+}
+
+// LambdaFunctionSpec defines the desired state of LambdaFunction.
+type LambdaFunctionSpec struct {
+	// The ARN of the Lambda function, optionally qualified with a version or alias.
+	// The function must allow VPC Lattice to invoke it.
+	//
+	// +kubebuilder:validation:Pattern=`^arn:aws[a-z-]*:lambda:[a-z0-9-]+:\d{12}:function:[a-zA-Z0-9-_]+(:[a-zA-Z0-9-_$]+)?$`
+	FunctionArn string `json:"functionArn"`
+
+	// The version of the event structure the function receives. Defaults to V2.
+	//
+	// +optional
+	// +kubebuilder:validation:Enum=V1;V2
+	// +kubebuilder:default=V2
+	EventStructureVersion *string `json:"eventStructureVersion,omitempty"`
+}
@@ -51,8 +51,88 @@ type ServiceNetworkList struct {
 }
 
 // ServiceNetworkSpec defines the desired state of ServiceNetwork.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.authPolicy) || (has(self.authType) && self.authType == 'AWS_IAM')",message="authPolicy requires authType AWS_IAM"
 type ServiceNetworkSpec struct {
-	// Reserved for future use.
+	// The auth type of the service network. When unset, the controller leaves the auth type as it is,
+	// so that it can be managed with an IAMAuthPolicy attached to the Gateway instead.
+	//
+	// +optional
+	// +kubebuilder:validation:Enum=NONE;AWS_IAM
+	AuthType *string `json:"authType,omitempty"`
+
+	// The IAM auth policy document of the service network. It requires the AWS_IAM auth type.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	AuthPolicy *string `json:"authPolicy,omitempty"`
+
+	// The ARNs of the S3 buckets, CloudWatch log groups or Kinesis Data Firehose delivery streams
+	// receiving the access logs of the service network. There can be one destination of each type.
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=3
+	// +kubebuilder:validation:items:Pattern=`^arn:aws[a-z-]*:(s3|logs|firehose):.+$`
+	AccessLogDestinations []string `json:"accessLogDestinations,omitempty"`
+
+	// The VPCs associated with the service network.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=vpcId
+	// +kubebuilder:validation:MaxItems=32
+	VpcAssociations []ServiceNetworkVpcAssociation `json:"vpcAssociations,omitempty"`
+
+	// Sharing shares the service network with other AWS accounts and organizational units through an
+	// AWS RAM resource share. Removing it deletes the resource share.
+	//
+	// +optional
+	Sharing *ServiceNetworkSharing `json:"sharing,omitempty"`
+}
+
+// ServiceNetworkSharing defines the principals a service network is shared with.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.accountIds) || has(self.organizationalUnitArns)",message="sharing requires accountIds or organizationalUnitArns"
+type ServiceNetworkSharing struct {
+	// The IDs of the AWS accounts the service network is shared with.
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=100
+	// +kubebuilder:validation:items:Pattern=`^\d{12}$`
+	AccountIds []string `json:"accountIds,omitempty"`
+
+	// The ARNs of the organizational units the service network is shared with.
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=100
+	// +kubebuilder:validation:items:Pattern=`^arn:aws[a-z-]*:organizations::\d{12}:ou/o-[a-z0-9]{10,32}/ou-[a-z0-9]{4,32}-[a-z0-9]{8,32}$`
+	OrganizationalUnitArns []string `json:"organizationalUnitArns,omitempty"`
+
+	// Whether the service network can be shared with accounts outside of the organization. Those accounts
+	// need to accept the resource share invitation. Defaults to true.
+	//
+	// +optional
+	// +kubebuilder:default=true
+	AllowExternalPrincipals *bool `json:"allowExternalPrincipals,omitempty"`
+}
+
+// ServiceNetworkVpcAssociation defines the association of a VPC with the service network.
+type ServiceNetworkVpcAssociation struct {
+	// The ID of the VPC.
+	//
+	// +kubebuilder:validation:Pattern=`^vpc-[0-9a-f]{8,17}$`
+	VpcId string `json:"vpcId"`
+
+	// The IDs of the security groups applied to the VPC association.
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=5
+	// +kubebuilder:validation:items:Pattern=`^sg-[0-9a-f]{8,17}$`
+	SecurityGroupIds []string `json:"securityGroupIds,omitempty"`
 }
 
 // ServiceNetworkStatus defines the observed state of ServiceNetwork.
@@ -78,4 +158,85 @@ type ServiceNetworkStatus struct {
 	// ID of the VPC Lattice service network.
 	// +optional
 	ServiceNetworkID string `json:"serviceNetworkID,omitempty"`
+
+	// Auth type of the VPC Lattice service network.
+	// +optional
+	AuthType string `json:"authType,omitempty"`
+
+	// State of the auth policy set from spec.authPolicy, Active or Inactive.
+	// +optional
+	AuthPolicyState string `json:"authPolicyState,omitempty"`
+
+	// Access log subscriptions created for spec.accessLogDestinations.
+	// +optional
+	AccessLogSubscriptions []ServiceNetworkAccessLogSubscriptionStatus `json:"accessLogSubscriptions,omitempty"`
+
+	// VPC associations created for spec.vpcAssociations.
+	// +optional
+	VpcAssociations []ServiceNetworkVpcAssociationStatus `json:"vpcAssociations,omitempty"`
+
+	// AWS RAM resource share created for spec.sharing.
+	// +optional
+	Sharing *ServiceNetworkSharingStatus `json:"sharing,omitempty"`
+}
+
+// ServiceNetworkSharingStatus defines the observed state of the AWS RAM resource share of a service network.
+type ServiceNetworkSharingStatus struct {
+	// ARN of the resource share.
+	ResourceShareArn string `json:"resourceShareArn"`
+
+	// Status of the resource share.
+	// +optional
+	Status string `json:"status,omitempty"`
+
+	// Status of the association of each principal with the resource share.
+	// +optional
+	Principals []ServiceNetworkSharingPrincipalStatus `json:"principals,omitempty"`
+}
+
+// ServiceNetworkSharingPrincipalStatus defines the observed state of a principal of a resource share.
+type ServiceNetworkSharingPrincipalStatus struct {
+	// The account ID or organizational unit ARN of the principal.
+	Principal string `json:"principal"`
+
+	// Status of the association of the principal with the resource share.
+	// +optional
+	AssociationStatus string `json:"associationStatus,omitempty"`
+
+	// Status of the resource share invitation sent to principals outside of the organization,
+	// Pending until the principal accepts it and Accepted after.
+	// +optional
+	InvitationStatus string `json:"invitationStatus,omitempty"`
+
+	// Message about the status of the association.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ServiceNetworkAccessLogSubscriptionStatus defines the observed state of an access log subscription.
+type ServiceNetworkAccessLogSubscriptionStatus struct {
+	// ARN of the access log destination.
+	DestinationArn string `json:"destinationArn"`
+
+	// ARN of the access log subscription.
+	// +optional
+	Arn string `json:"arn,omitempty"`
+}
+
+// ServiceNetworkVpcAssociationStatus defines the observed state of a VPC association.
+type ServiceNetworkVpcAssociationStatus struct {
+	// ID of the VPC.
+	VpcId string `json:"vpcId"`
+
+	// ARN of the service network VPC association.
+	// +optional
+	Arn string `json:"arn,omitempty"`
+
+	// Status of the service network VPC association.
+	// +optional
+	Status string `json:"status,omitempty"`
+
+	// IDs of the security groups applied to the VPC association.
+	// +optional
+	SecurityGroupIds []string `json:"securityGroupIds,omitempty"`
 }
@@ -0,0 +1,151 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ServiceNetworkEndpointKind = "ServiceNetworkEndpoint"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=gateway-api,shortName=sne
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Service Network",type=string,JSONPath=`.spec.serviceNetwork`
+// +kubebuilder:printcolumn:name="Endpoint",type=string,JSONPath=`.status.vpcEndpointId`
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:subresource:status
+
+// ServiceNetworkEndpoint manages a VPC endpoint of type ServiceNetwork, which gives access to the services of a
+// VPC Lattice service network from a VPC without associating the VPC with the service network.
+type ServiceNetworkEndpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ServiceNetworkEndpointSpec `json:"spec"`
+
+	// Status defines the current state of ServiceNetworkEndpoint.
+	//
+	// +kubebuilder:default={conditions: {{type: "Accepted", status: "Unknown", reason:"NotReconciled", message:"Waiting for controller", lastTransitionTime: "1970-01-01T00:00:00Z"}}}
+	Status ServiceNetworkEndpointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// ServiceNetworkEndpointList contains a list of ServiceNetworkEndpoints.
+type ServiceNetworkEndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceNetworkEndpoint `json:"items"`
+}
+
+// ServiceNetworkEndpointSpec defines the desired state of ServiceNetworkEndpoint.
+type ServiceNetworkEndpointSpec struct {
+	// The name or ID of the VPC Lattice service network, which can be shared from another account.
+	// Changing it recreates the VPC endpoint.
+	//
+	// +kubebuilder:validation:MinLength=3
+	// +kubebuilder:validation:MaxLength=63
+	ServiceNetwork string `json:"serviceNetwork"`
+
+	// The ID of the VPC of the endpoint. Defaults to the VPC of the cluster. Changing it recreates the VPC endpoint.
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern=`^vpc-[0-9a-f]{8,17}$`
+	VpcId *string `json:"vpcId,omitempty"`
+
+	// The IDs of the subnets in which the endpoint network interfaces are created, one per Availability Zone at most.
+	//
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:Pattern=`^subnet-[0-9a-f]{8,17}$`
+	SubnetIds []string `json:"subnetIds"`
+
+	// The IDs of the security groups associated with the endpoint network interfaces.
+	// Defaults to the default security group of the VPC.
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=5
+	SecurityGroupIds []SecurityGroupId `json:"securityGroupIds,omitempty"`
+
+	// GenerateDnsEndpoints indicates whether the controller creates a DNSEndpoint resource of external-dns with a
+	// CNAME record from the custom domain name of each service of the service network to its endpoint DNS name.
+	//
+	// +optional
+	GenerateDnsEndpoints bool `json:"generateDnsEndpoints,omitempty"`
+}
+
+// ServiceNetworkEndpointStatus defines the observed state of ServiceNetworkEndpoint.
+type ServiceNetworkEndpointStatus struct {
+	// Conditions describe the current conditions of the ServiceNetworkEndpoint.
+	//
+	// Known condition types are:
+	//
+	// * "Accepted"
+	// * "Programmed"
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	// +kubebuilder:default={{type: "Accepted", status: "Unknown", reason:"Pending", message:"Waiting for controller", lastTransitionTime: "1970-01-01T00:00:00Z"},{type: "Programmed", status: "Unknown", reason:"Pending", message:"Waiting for controller", lastTransitionTime: "1970-01-01T00:00:00Z"}}
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ARN of the VPC Lattice service network.
+	// +optional
+	ServiceNetworkARN string `json:"serviceNetworkARN,omitempty"`
+
+	// ID of the VPC endpoint.
+	// +optional
+	VpcEndpointId string `json:"vpcEndpointId,omitempty"`
+
+	// State of the VPC endpoint.
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// The services of the service network reachable through the endpoint.
+	// +optional
+	Services []ServiceNetworkEndpointServiceStatus `json:"services,omitempty"`
+}
+
+// ServiceNetworkEndpointServiceStatus defines the observed state of a service reachable through a service network
+// endpoint.
+type ServiceNetworkEndpointServiceStatus struct {
+	// ARN of the VPC Lattice service.
+	ServiceArn string `json:"serviceArn"`
+
+	// Custom domain name of the VPC Lattice service.
+	// +optional
+	CustomDomainName string `json:"customDomainName,omitempty"`
+
+	// DNS name of the service through the endpoint.
+	// +optional
+	DnsName string `json:"dnsName,omitempty"`
+
+	// ID of the hosted zone of the DNS name, to create alias records.
+	// +optional
+	HostedZoneId string `json:"hostedZoneId,omitempty"`
+
+	// Accessibility of the service through the endpoint, Available, Pending or Failed.
+	// +optional
+	Accessibility string `json:"accessibility,omitempty"`
+}
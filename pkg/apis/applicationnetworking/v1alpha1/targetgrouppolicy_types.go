@@ -37,6 +37,9 @@ type TargetGroupPolicyList struct {
 }
 
 // TargetGroupPolicySpec defines the desired state of TargetGroupPolicy.
+//
+// +kubebuilder:validation:XValidation:message="exactly one of targetRef and targetSelector must be set",rule="has(self.targetRef) != has(self.targetSelector)"
+// +kubebuilder:validation:XValidation:message="overrides are only supported for Gateway and Namespace targets",rule="!has(self.overrides) || (has(self.targetRef) && self.targetRef.kind in ['Gateway', 'Namespace'])"
 type TargetGroupPolicySpec struct {
 	// The protocol to use for routing traffic to the targets. Supported values are HTTP (default), HTTPS and TCP.
 	//
@@ -52,16 +55,74 @@ type TargetGroupPolicySpec struct {
 	// +optional
 	ProtocolVersion *string `json:"protocolVersion,omitempty"`
 
+	// The type of targets registered in the target group. Supported values are IP (default) and INSTANCE.
+	// IP registers the pod IPs of the service endpoints. INSTANCE registers the EC2 instances of the nodes
+	// hosting the service endpoints on the service NodePort, for pods whose IPs are not routable in the VPC.
+	// INSTANCE requires a NodePort or LoadBalancer service.
+	//
+	// Changes to this value results in a replacement of VPC Lattice target group.
+	// +optional
+	// +kubebuilder:validation:Enum=IP;INSTANCE
+	TargetType *string `json:"targetType,omitempty"`
+
 	// TargetRef points to the kubernetes Service resource that will have this policy attached.
+	// Either targetRef or targetSelector must be set.
+	//
+	// A policy for a Gateway, or for the Namespace of the policy, provides defaults to all the target groups built
+	// under it. The fields set by a more specific policy override them one by one: Namespace defaults are overridden
+	// by Gateway defaults, themselves overridden by the policy of the Service or ServiceExport. Such a policy can
+	// also set overrides, which take precedence over the more specific policies instead.
 	//
 	// This field is following the guidelines of Kubernetes Gateway API policy attachment.
-	TargetRef *gwv1alpha2.NamespacedPolicyTargetReference `json:"targetRef"`
+	// +optional
+	TargetRef *gwv1alpha2.NamespacedPolicyTargetReference `json:"targetRef,omitempty"`
+
+	// TargetSelector attaches this policy to all the Services or ServiceExports of the policy namespace
+	// matching its labels. A policy with a targetRef takes precedence over a policy with a targetSelector.
+	// +optional
+	TargetSelector *TargetSelector `json:"targetSelector,omitempty"`
+
+	// SectionName limits this policy to the Service port with this name. For a ServiceExport, it is the name of
+	// the exported port of its Service. A policy with a sectionName takes precedence over a policy without one.
+	// +optional
+	SectionName *gwv1alpha2.SectionName `json:"sectionName,omitempty"`
 
 	// The health check configuration.
 	//
 	// Changes to this value will update VPC Lattice resource in place.
 	// +optional
 	HealthCheck *HealthCheckConfig `json:"healthCheck,omitempty"`
+
+	// The maximum time, in seconds, the deletion of a terminating pod is held until VPC Lattice has drained
+	// the connections of its targets. While set, the controller adds a finalizer to the pods behind the targets.
+	// When not set, pod deletion is not held.
+	//
+	// Changes to this value do not affect the VPC Lattice target group.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3600
+	DrainingTimeoutSeconds *int64 `json:"drainingTimeoutSeconds,omitempty"`
+
+	// Overrides are fields that take precedence over the ones of the more specific policies of the target groups
+	// built under this policy, one by one: Namespace overrides take precedence over Gateway overrides, themselves
+	// taking precedence over the policy of the Service or ServiceExport. Only supported for a policy targeting a
+	// Gateway or Namespace.
+	// +optional
+	Overrides *TargetGroupPolicyConfig `json:"overrides,omitempty"`
+}
+
+// TargetSelector selects the resources a policy is attached to by their labels.
+type TargetSelector struct {
+	// Group is the group of the selected resources, "" for Service.
+	Group gwv1alpha2.Group `json:"group"`
+
+	// Kind is the kind of the selected resources.
+	Kind gwv1alpha2.Kind `json:"kind"`
+
+	// MatchLabels selects the resources having all these labels.
+	//
+	// +kubebuilder:validation:MinProperties=1
+	MatchLabels map[string]string `json:"matchLabels"`
 }
 
 // HealthCheckConfig defines health check configuration for given VPC Lattice target group.
@@ -116,6 +177,12 @@ type HealthCheckConfig struct {
 	// The protocol version used when performing health checks on targets. Defaults to HTTP/1.
 	// +optional
 	ProtocolVersion *HealthCheckProtocolVersion `json:"protocolVersion,omitempty"`
+
+	// Derives the health check from the httpGet readiness probe of the pods behind the service. The other
+	// fields of this health check take precedence over the derived settings.
+	// Defaults to the ENABLE_READINESS_PROBE_HEALTH_CHECKS controller setting.
+	// +optional
+	FromReadinessProbe *bool `json:"fromReadinessProbe,omitempty"`
 }
 
 // TargetGroupPolicyStatus defines the observed state of TargetGroupPolicy.
@@ -138,6 +205,40 @@ type TargetGroupPolicyStatus struct {
 	// +kubebuilder:validation:MaxItems=8
 	// +kubebuilder:default={{type: "Accepted", status: "Unknown", reason:"Pending", message:"Waiting for controller", lastTransitionTime: "1970-01-01T00:00:00Z"},{type: "Programmed", status: "Unknown", reason:"Pending", message:"Waiting for controller", lastTransitionTime: "1970-01-01T00:00:00Z"}}
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// The ARNs of the VPC Lattice target groups the policy is currently applied to.
+	//
+	// +optional
+	// +listType=set
+	TargetGroupArns []string `json:"targetGroupArns,omitempty"`
+
+	// The configuration of the policy merged over the defaults of the policy of its Namespace, when accepted.
+	// The defaults of Gateway policies depend on the routes of each target group, they are not included.
+	//
+	// +optional
+	EffectiveConfig *TargetGroupPolicyConfig `json:"effectiveConfig,omitempty"`
+}
+
+// TargetGroupPolicyConfig is the target group configuration of the overrides of a policy, or resulting from the
+// merge of policies. Its fields are the ones of TargetGroupPolicySpec.
+type TargetGroupPolicyConfig struct {
+	// +optional
+	Protocol *string `json:"protocol,omitempty"`
+
+	// +optional
+	ProtocolVersion *string `json:"protocolVersion,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Enum=IP;INSTANCE
+	TargetType *string `json:"targetType,omitempty"`
+
+	// +optional
+	HealthCheck *HealthCheckConfig `json:"healthCheck,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3600
+	DrainingTimeoutSeconds *int64 `json:"drainingTimeoutSeconds,omitempty"`
 }
 
 // +kubebuilder:validation:Enum=HTTP;HTTPS
@@ -160,6 +261,14 @@ func (p *TargetGroupPolicy) GetTargetRef() *gwv1alpha2.NamespacedPolicyTargetRef
 	return p.Spec.TargetRef
 }
 
+func (p *TargetGroupPolicy) GetTargetSelector() *TargetSelector {
+	return p.Spec.TargetSelector
+}
+
+func (p *TargetGroupPolicy) GetSectionName() *gwv1alpha2.SectionName {
+	return p.Spec.SectionName
+}
+
 func (p *TargetGroupPolicy) GetStatusConditions() *[]metav1.Condition {
 	return &p.Status.Conditions
 }
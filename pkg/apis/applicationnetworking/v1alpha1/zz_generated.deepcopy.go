@@ -117,6 +117,89 @@ func (in *AccessLogPolicyStatus) DeepCopy() *AccessLogPolicyStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationLoadBalancer) DeepCopyInto(out *ApplicationLoadBalancer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationLoadBalancer.
+func (in *ApplicationLoadBalancer) DeepCopy() *ApplicationLoadBalancer {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationLoadBalancer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApplicationLoadBalancer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationLoadBalancerList) DeepCopyInto(out *ApplicationLoadBalancerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ApplicationLoadBalancer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationLoadBalancerList.
+func (in *ApplicationLoadBalancerList) DeepCopy() *ApplicationLoadBalancerList {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationLoadBalancerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApplicationLoadBalancerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationLoadBalancerSpec) DeepCopyInto(out *ApplicationLoadBalancerSpec) {
+	*out = *in
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProtocolVersion != nil {
+		in, out := &in.ProtocolVersion, &out.ProtocolVersion
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationLoadBalancerSpec.
+func (in *ApplicationLoadBalancerSpec) DeepCopy() *ApplicationLoadBalancerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationLoadBalancerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 	*out = *in
@@ -147,6 +230,247 @@ func (in *ExportedPort) DeepCopy() *ExportedPort {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FixedResponse) DeepCopyInto(out *FixedResponse) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FixedResponse.
+func (in *FixedResponse) DeepCopy() *FixedResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(FixedResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FixedResponse) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FixedResponseList) DeepCopyInto(out *FixedResponseList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FixedResponse, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FixedResponseList.
+func (in *FixedResponseList) DeepCopy() *FixedResponseList {
+	if in == nil {
+		return nil
+	}
+	out := new(FixedResponseList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FixedResponseList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FixedResponseSpec) DeepCopyInto(out *FixedResponseSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FixedResponseSpec.
+func (in *FixedResponseSpec) DeepCopy() *FixedResponseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FixedResponseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayClassConfig) DeepCopyInto(out *GatewayClassConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayClassConfig.
+func (in *GatewayClassConfig) DeepCopy() *GatewayClassConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayClassConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GatewayClassConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayClassConfigList) DeepCopyInto(out *GatewayClassConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GatewayClassConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayClassConfigList.
+func (in *GatewayClassConfigList) DeepCopy() *GatewayClassConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayClassConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GatewayClassConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayClassConfigSpec) DeepCopyInto(out *GatewayClassConfigSpec) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AuthType != nil {
+		in, out := &in.AuthType, &out.AuthType
+		*out = new(string)
+		**out = **in
+	}
+	if in.AssociateWithVpc != nil {
+		in, out := &in.AssociateWithVpc, &out.AssociateWithVpc
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecurityGroupIds != nil {
+		in, out := &in.SecurityGroupIds, &out.SecurityGroupIds
+		*out = make([]SecurityGroupId, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayClassConfigSpec.
+func (in *GatewayClassConfigSpec) DeepCopy() *GatewayClassConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayClassConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeaderMatchFilter) DeepCopyInto(out *HeaderMatchFilter) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderMatchFilter.
+func (in *HeaderMatchFilter) DeepCopy() *HeaderMatchFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(HeaderMatchFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HeaderMatchFilter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeaderMatchFilterList) DeepCopyInto(out *HeaderMatchFilterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HeaderMatchFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderMatchFilterList.
+func (in *HeaderMatchFilterList) DeepCopy() *HeaderMatchFilterList {
+	if in == nil {
+		return nil
+	}
+	out := new(HeaderMatchFilterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HeaderMatchFilterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeaderMatchFilterSpec) DeepCopyInto(out *HeaderMatchFilterSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderMatchFilterSpec.
+func (in *HeaderMatchFilterSpec) DeepCopy() *HeaderMatchFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HeaderMatchFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HealthCheckConfig) DeepCopyInto(out *HealthCheckConfig) {
 	*out = *in
@@ -200,6 +524,11 @@ func (in *HealthCheckConfig) DeepCopyInto(out *HealthCheckConfig) {
 		*out = new(HealthCheckProtocolVersion)
 		**out = **in
 	}
+	if in.FromReadinessProbe != nil {
+		in, out := &in.FromReadinessProbe, &out.FromReadinessProbe
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckConfig.
@@ -313,6 +642,84 @@ func (in *IAMAuthPolicyStatus) DeepCopy() *IAMAuthPolicyStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LambdaFunction) DeepCopyInto(out *LambdaFunction) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LambdaFunction.
+func (in *LambdaFunction) DeepCopy() *LambdaFunction {
+	if in == nil {
+		return nil
+	}
+	out := new(LambdaFunction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LambdaFunction) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LambdaFunctionList) DeepCopyInto(out *LambdaFunctionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LambdaFunction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LambdaFunctionList.
+func (in *LambdaFunctionList) DeepCopy() *LambdaFunctionList {
+	if in == nil {
+		return nil
+	}
+	out := new(LambdaFunctionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LambdaFunctionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LambdaFunctionSpec) DeepCopyInto(out *LambdaFunctionSpec) {
+	*out = *in
+	if in.EventStructureVersion != nil {
+		in, out := &in.EventStructureVersion, &out.EventStructureVersion
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LambdaFunctionSpec.
+func (in *LambdaFunctionSpec) DeepCopy() *LambdaFunctionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LambdaFunctionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceExport) DeepCopyInto(out *ServiceExport) {
 	*out = *in
@@ -416,35 +823,188 @@ func (in *ServiceExportSpec) DeepCopy() *ServiceExportSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceExportSpec)
+	out := new(ServiceExportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceExportStatus) DeepCopyInto(out *ServiceExportStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ServiceExportCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceExportStatus.
+func (in *ServiceExportStatus) DeepCopy() *ServiceExportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceExportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceImport) DeepCopyInto(out *ServiceImport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceImport.
+func (in *ServiceImport) DeepCopy() *ServiceImport {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceImport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceImport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceImportList) DeepCopyInto(out *ServiceImportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceImport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceImportList.
+func (in *ServiceImportList) DeepCopy() *ServiceImportList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceImportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceImportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceImportSpec) DeepCopyInto(out *ServiceImportSpec) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]ServicePort, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IPs != nil {
+		in, out := &in.IPs, &out.IPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SessionAffinityConfig != nil {
+		in, out := &in.SessionAffinityConfig, &out.SessionAffinityConfig
+		*out = new(corev1.SessionAffinityConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceImportSpec.
+func (in *ServiceImportSpec) DeepCopy() *ServiceImportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceImportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceImportStatus) DeepCopyInto(out *ServiceImportStatus) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]ClusterStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceImportStatus.
+func (in *ServiceImportStatus) DeepCopy() *ServiceImportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceImportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceNetwork) DeepCopyInto(out *ServiceNetwork) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceNetwork.
+func (in *ServiceNetwork) DeepCopy() *ServiceNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceNetwork)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceNetwork) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceExportStatus) DeepCopyInto(out *ServiceExportStatus) {
+func (in *ServiceNetworkAccessLogSubscriptionStatus) DeepCopyInto(out *ServiceNetworkAccessLogSubscriptionStatus) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]ServiceExportCondition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceExportStatus.
-func (in *ServiceExportStatus) DeepCopy() *ServiceExportStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceNetworkAccessLogSubscriptionStatus.
+func (in *ServiceNetworkAccessLogSubscriptionStatus) DeepCopy() *ServiceNetworkAccessLogSubscriptionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceExportStatus)
+	out := new(ServiceNetworkAccessLogSubscriptionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceImport) DeepCopyInto(out *ServiceImport) {
+func (in *ServiceNetworkEndpoint) DeepCopyInto(out *ServiceNetworkEndpoint) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -452,18 +1012,18 @@ func (in *ServiceImport) DeepCopyInto(out *ServiceImport) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceImport.
-func (in *ServiceImport) DeepCopy() *ServiceImport {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceNetworkEndpoint.
+func (in *ServiceNetworkEndpoint) DeepCopy() *ServiceNetworkEndpoint {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceImport)
+	out := new(ServiceNetworkEndpoint)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ServiceImport) DeepCopyObject() runtime.Object {
+func (in *ServiceNetworkEndpoint) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -471,31 +1031,31 @@ func (in *ServiceImport) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceImportList) DeepCopyInto(out *ServiceImportList) {
+func (in *ServiceNetworkEndpointList) DeepCopyInto(out *ServiceNetworkEndpointList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ServiceImport, len(*in))
+		*out = make([]ServiceNetworkEndpoint, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceImportList.
-func (in *ServiceImportList) DeepCopy() *ServiceImportList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceNetworkEndpointList.
+func (in *ServiceNetworkEndpointList) DeepCopy() *ServiceNetworkEndpointList {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceImportList)
+	out := new(ServiceNetworkEndpointList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ServiceImportList) DeepCopyObject() runtime.Object {
+func (in *ServiceNetworkEndpointList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -503,84 +1063,77 @@ func (in *ServiceImportList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceImportSpec) DeepCopyInto(out *ServiceImportSpec) {
+func (in *ServiceNetworkEndpointServiceStatus) DeepCopyInto(out *ServiceNetworkEndpointServiceStatus) {
 	*out = *in
-	if in.Ports != nil {
-		in, out := &in.Ports, &out.Ports
-		*out = make([]ServicePort, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.IPs != nil {
-		in, out := &in.IPs, &out.IPs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.SessionAffinityConfig != nil {
-		in, out := &in.SessionAffinityConfig, &out.SessionAffinityConfig
-		*out = new(corev1.SessionAffinityConfig)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceImportSpec.
-func (in *ServiceImportSpec) DeepCopy() *ServiceImportSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceNetworkEndpointServiceStatus.
+func (in *ServiceNetworkEndpointServiceStatus) DeepCopy() *ServiceNetworkEndpointServiceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceImportSpec)
+	out := new(ServiceNetworkEndpointServiceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceImportStatus) DeepCopyInto(out *ServiceImportStatus) {
+func (in *ServiceNetworkEndpointSpec) DeepCopyInto(out *ServiceNetworkEndpointSpec) {
 	*out = *in
-	if in.Clusters != nil {
-		in, out := &in.Clusters, &out.Clusters
-		*out = make([]ClusterStatus, len(*in))
+	if in.VpcId != nil {
+		in, out := &in.VpcId, &out.VpcId
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetIds != nil {
+		in, out := &in.SubnetIds, &out.SubnetIds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupIds != nil {
+		in, out := &in.SecurityGroupIds, &out.SecurityGroupIds
+		*out = make([]SecurityGroupId, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceImportStatus.
-func (in *ServiceImportStatus) DeepCopy() *ServiceImportStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceNetworkEndpointSpec.
+func (in *ServiceNetworkEndpointSpec) DeepCopy() *ServiceNetworkEndpointSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceImportStatus)
+	out := new(ServiceNetworkEndpointSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceNetwork) DeepCopyInto(out *ServiceNetwork) {
+func (in *ServiceNetworkEndpointStatus) DeepCopyInto(out *ServiceNetworkEndpointStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]ServiceNetworkEndpointServiceStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceNetwork.
-func (in *ServiceNetwork) DeepCopy() *ServiceNetwork {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceNetworkEndpointStatus.
+func (in *ServiceNetworkEndpointStatus) DeepCopy() *ServiceNetworkEndpointStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceNetwork)
+	out := new(ServiceNetworkEndpointStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ServiceNetwork) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceNetworkList) DeepCopyInto(out *ServiceNetworkList) {
 	*out = *in
@@ -613,9 +1166,101 @@ func (in *ServiceNetworkList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceNetworkSharing) DeepCopyInto(out *ServiceNetworkSharing) {
+	*out = *in
+	if in.AccountIds != nil {
+		in, out := &in.AccountIds, &out.AccountIds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OrganizationalUnitArns != nil {
+		in, out := &in.OrganizationalUnitArns, &out.OrganizationalUnitArns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowExternalPrincipals != nil {
+		in, out := &in.AllowExternalPrincipals, &out.AllowExternalPrincipals
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceNetworkSharing.
+func (in *ServiceNetworkSharing) DeepCopy() *ServiceNetworkSharing {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceNetworkSharing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceNetworkSharingPrincipalStatus) DeepCopyInto(out *ServiceNetworkSharingPrincipalStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceNetworkSharingPrincipalStatus.
+func (in *ServiceNetworkSharingPrincipalStatus) DeepCopy() *ServiceNetworkSharingPrincipalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceNetworkSharingPrincipalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceNetworkSharingStatus) DeepCopyInto(out *ServiceNetworkSharingStatus) {
+	*out = *in
+	if in.Principals != nil {
+		in, out := &in.Principals, &out.Principals
+		*out = make([]ServiceNetworkSharingPrincipalStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceNetworkSharingStatus.
+func (in *ServiceNetworkSharingStatus) DeepCopy() *ServiceNetworkSharingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceNetworkSharingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceNetworkSpec) DeepCopyInto(out *ServiceNetworkSpec) {
 	*out = *in
+	if in.AuthType != nil {
+		in, out := &in.AuthType, &out.AuthType
+		*out = new(string)
+		**out = **in
+	}
+	if in.AuthPolicy != nil {
+		in, out := &in.AuthPolicy, &out.AuthPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.AccessLogDestinations != nil {
+		in, out := &in.AccessLogDestinations, &out.AccessLogDestinations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VpcAssociations != nil {
+		in, out := &in.VpcAssociations, &out.VpcAssociations
+		*out = make([]ServiceNetworkVpcAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Sharing != nil {
+		in, out := &in.Sharing, &out.Sharing
+		*out = new(ServiceNetworkSharing)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceNetworkSpec.
@@ -638,6 +1283,23 @@ func (in *ServiceNetworkStatus) DeepCopyInto(out *ServiceNetworkStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.AccessLogSubscriptions != nil {
+		in, out := &in.AccessLogSubscriptions, &out.AccessLogSubscriptions
+		*out = make([]ServiceNetworkAccessLogSubscriptionStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.VpcAssociations != nil {
+		in, out := &in.VpcAssociations, &out.VpcAssociations
+		*out = make([]ServiceNetworkVpcAssociationStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Sharing != nil {
+		in, out := &in.Sharing, &out.Sharing
+		*out = new(ServiceNetworkSharingStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceNetworkStatus.
@@ -650,6 +1312,46 @@ func (in *ServiceNetworkStatus) DeepCopy() *ServiceNetworkStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceNetworkVpcAssociation) DeepCopyInto(out *ServiceNetworkVpcAssociation) {
+	*out = *in
+	if in.SecurityGroupIds != nil {
+		in, out := &in.SecurityGroupIds, &out.SecurityGroupIds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceNetworkVpcAssociation.
+func (in *ServiceNetworkVpcAssociation) DeepCopy() *ServiceNetworkVpcAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceNetworkVpcAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceNetworkVpcAssociationStatus) DeepCopyInto(out *ServiceNetworkVpcAssociationStatus) {
+	*out = *in
+	if in.SecurityGroupIds != nil {
+		in, out := &in.SecurityGroupIds, &out.SecurityGroupIds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceNetworkVpcAssociationStatus.
+func (in *ServiceNetworkVpcAssociationStatus) DeepCopy() *ServiceNetworkVpcAssociationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceNetworkVpcAssociationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServicePort) DeepCopyInto(out *ServicePort) {
 	*out = *in
@@ -697,6 +1399,46 @@ func (in *TargetGroupPolicy) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupPolicyConfig) DeepCopyInto(out *TargetGroupPolicyConfig) {
+	*out = *in
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProtocolVersion != nil {
+		in, out := &in.ProtocolVersion, &out.ProtocolVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetType != nil {
+		in, out := &in.TargetType, &out.TargetType
+		*out = new(string)
+		**out = **in
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(HealthCheckConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DrainingTimeoutSeconds != nil {
+		in, out := &in.DrainingTimeoutSeconds, &out.DrainingTimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupPolicyConfig.
+func (in *TargetGroupPolicyConfig) DeepCopy() *TargetGroupPolicyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetGroupPolicyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetGroupPolicyList) DeepCopyInto(out *TargetGroupPolicyList) {
 	*out = *in
@@ -742,16 +1484,41 @@ func (in *TargetGroupPolicySpec) DeepCopyInto(out *TargetGroupPolicySpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.TargetType != nil {
+		in, out := &in.TargetType, &out.TargetType
+		*out = new(string)
+		**out = **in
+	}
 	if in.TargetRef != nil {
 		in, out := &in.TargetRef, &out.TargetRef
 		*out = new(v1alpha2.NamespacedPolicyTargetReference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TargetSelector != nil {
+		in, out := &in.TargetSelector, &out.TargetSelector
+		*out = new(TargetSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SectionName != nil {
+		in, out := &in.SectionName, &out.SectionName
+		*out = new(v1alpha2.SectionName)
+		**out = **in
+	}
 	if in.HealthCheck != nil {
 		in, out := &in.HealthCheck, &out.HealthCheck
 		*out = new(HealthCheckConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DrainingTimeoutSeconds != nil {
+		in, out := &in.DrainingTimeoutSeconds, &out.DrainingTimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = new(TargetGroupPolicyConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupPolicySpec.
@@ -774,6 +1541,16 @@ func (in *TargetGroupPolicyStatus) DeepCopyInto(out *TargetGroupPolicyStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TargetGroupArns != nil {
+		in, out := &in.TargetGroupArns, &out.TargetGroupArns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EffectiveConfig != nil {
+		in, out := &in.EffectiveConfig, &out.EffectiveConfig
+		*out = new(TargetGroupPolicyConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupPolicyStatus.
@@ -786,6 +1563,28 @@ func (in *TargetGroupPolicyStatus) DeepCopy() *TargetGroupPolicyStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetSelector) DeepCopyInto(out *TargetSelector) {
+	*out = *in
+	if in.MatchLabels != nil {
+		in, out := &in.MatchLabels, &out.MatchLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetSelector.
+func (in *TargetSelector) DeepCopy() *TargetSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VpcAssociationPolicy) DeepCopyInto(out *VpcAssociationPolicy) {
 	*out = *in
@@ -41,6 +41,8 @@ type Cloud interface {
 	Lattice() services.Lattice
 	Tagging() services.Tagging
 	ACM() services.ACM
+	RAM() services.RAM
+	EC2() services.EC2
 
 	// creates lattice tags with default values populated
 	DefaultTags() services.Tags
@@ -107,12 +109,16 @@ func NewCloud(log gwlog.Logger, cfg CloudConfig, metricsRegisterer prometheus.Re
 	}
 
 	acmClient := services.NewDefaultACM(awsCfg)
+	ramClient := services.NewDefaultRAM(awsCfg)
+	ec2Client := services.NewDefaultEC2(awsCfg)
 
 	return &defaultCloud{
 		cfg:          cfg,
 		lattice:      lattice,
 		tagging:      tagging,
 		acm:          acmClient,
+		ram:          ramClient,
+		ec2:          ec2Client,
 		managedByTag: getManagedByTag(cfg),
 	}, nil
 }
@@ -135,11 +141,31 @@ func NewDefaultCloudWithTagging(lattice services.Lattice, tagging services.Taggi
 	}
 }
 
+func NewDefaultCloudWithRAM(lattice services.Lattice, ram services.RAM, cfg CloudConfig) Cloud {
+	return &defaultCloud{
+		cfg:          cfg,
+		lattice:      lattice,
+		ram:          ram,
+		managedByTag: getManagedByTag(cfg),
+	}
+}
+
+func NewDefaultCloudWithEC2(lattice services.Lattice, ec2 services.EC2, cfg CloudConfig) Cloud {
+	return &defaultCloud{
+		cfg:          cfg,
+		lattice:      lattice,
+		ec2:          ec2,
+		managedByTag: getManagedByTag(cfg),
+	}
+}
+
 type defaultCloud struct {
 	cfg          CloudConfig
 	lattice      services.Lattice
 	tagging      services.Tagging
 	acm          services.ACM
+	ram          services.RAM
+	ec2          services.EC2
 	managedByTag string
 }
 
@@ -155,6 +181,14 @@ func (c *defaultCloud) ACM() services.ACM {
 	return c.acm
 }
 
+func (c *defaultCloud) RAM() services.RAM {
+	return c.ram
+}
+
+func (c *defaultCloud) EC2() services.EC2 {
+	return c.ec2
+}
+
 func (c *defaultCloud) Config() CloudConfig {
 	return c.cfg
 }
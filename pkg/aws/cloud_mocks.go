@@ -97,6 +97,20 @@ func (mr *MockCloudMockRecorder) DefaultTagsMergedWith(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultTagsMergedWith", reflect.TypeOf((*MockCloud)(nil).DefaultTagsMergedWith), arg0)
 }
 
+// EC2 mocks base method.
+func (m *MockCloud) EC2() services.EC2 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EC2")
+	ret0, _ := ret[0].(services.EC2)
+	return ret0
+}
+
+// EC2 indicates an expected call of EC2.
+func (mr *MockCloudMockRecorder) EC2() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EC2", reflect.TypeOf((*MockCloud)(nil).EC2))
+}
+
 // GetManagedByFromTags mocks base method.
 func (m *MockCloud) GetManagedByFromTags(tags services.Tags) string {
 	m.ctrl.T.Helper()
@@ -154,6 +168,20 @@ func (mr *MockCloudMockRecorder) MergeTags(baseTags, additionalTags any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeTags", reflect.TypeOf((*MockCloud)(nil).MergeTags), baseTags, additionalTags)
 }
 
+// RAM mocks base method.
+func (m *MockCloud) RAM() services.RAM {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RAM")
+	ret0, _ := ret[0].(services.RAM)
+	return ret0
+}
+
+// RAM indicates an expected call of RAM.
+func (mr *MockCloudMockRecorder) RAM() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RAM", reflect.TypeOf((*MockCloud)(nil).RAM))
+}
+
 // Tagging mocks base method.
 func (m *MockCloud) Tagging() services.Tagging {
 	m.ctrl.T.Helper()
@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+//go:generate mockgen -destination ec2_mocks.go -package services github.com/aws/aws-application-networking-k8s/pkg/aws/services EC2
+
+// EC2 defines the Amazon EC2 API methods used by this controller to manage VPC endpoints.
+type EC2 interface {
+	CreateVpcEndpoint(ctx context.Context, input *ec2.CreateVpcEndpointInput, optFns ...func(*ec2.Options)) (*ec2.CreateVpcEndpointOutput, error)
+	ModifyVpcEndpoint(ctx context.Context, input *ec2.ModifyVpcEndpointInput, optFns ...func(*ec2.Options)) (*ec2.ModifyVpcEndpointOutput, error)
+	DeleteVpcEndpoints(ctx context.Context, input *ec2.DeleteVpcEndpointsInput, optFns ...func(*ec2.Options)) (*ec2.DeleteVpcEndpointsOutput, error)
+
+	DescribeVpcEndpointsAsList(ctx context.Context, input *ec2.DescribeVpcEndpointsInput) ([]ec2types.VpcEndpoint, error)
+	DescribeVpcEndpointAssociationsAsList(ctx context.Context, input *ec2.DescribeVpcEndpointAssociationsInput) ([]ec2types.VpcEndpointAssociation, error)
+}
+
+type defaultEC2 struct {
+	client *ec2.Client
+}
+
+func NewDefaultEC2(cfg aws.Config) *defaultEC2 {
+	return &defaultEC2{
+		client: ec2.NewFromConfig(cfg, func(o *ec2.Options) {
+			o.RetryMaxAttempts = 20
+		}),
+	}
+}
+
+func (d *defaultEC2) CreateVpcEndpoint(ctx context.Context, input *ec2.CreateVpcEndpointInput, optFns ...func(*ec2.Options)) (*ec2.CreateVpcEndpointOutput, error) {
+	return d.client.CreateVpcEndpoint(ctx, input, optFns...)
+}
+
+func (d *defaultEC2) ModifyVpcEndpoint(ctx context.Context, input *ec2.ModifyVpcEndpointInput, optFns ...func(*ec2.Options)) (*ec2.ModifyVpcEndpointOutput, error) {
+	return d.client.ModifyVpcEndpoint(ctx, input, optFns...)
+}
+
+func (d *defaultEC2) DeleteVpcEndpoints(ctx context.Context, input *ec2.DeleteVpcEndpointsInput, optFns ...func(*ec2.Options)) (*ec2.DeleteVpcEndpointsOutput, error) {
+	return d.client.DeleteVpcEndpoints(ctx, input, optFns...)
+}
+
+func (d *defaultEC2) DescribeVpcEndpointsAsList(ctx context.Context, input *ec2.DescribeVpcEndpointsInput) ([]ec2types.VpcEndpoint, error) {
+	var result []ec2types.VpcEndpoint
+	paginator := ec2.NewDescribeVpcEndpointsPaginator(d.client, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page.VpcEndpoints...)
+	}
+	return result, nil
+}
+
+func (d *defaultEC2) DescribeVpcEndpointAssociationsAsList(ctx context.Context, input *ec2.DescribeVpcEndpointAssociationsInput) ([]ec2types.VpcEndpointAssociation, error) {
+	var result []ec2types.VpcEndpointAssociation
+	// DescribeVpcEndpointAssociations has no paginator
+	in := *input
+	for {
+		page, err := d.client.DescribeVpcEndpointAssociations(ctx, &in)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page.VpcEndpointAssociations...)
+		if aws.ToString(page.NextToken) == "" {
+			return result, nil
+		}
+		in.NextToken = page.NextToken
+	}
+}
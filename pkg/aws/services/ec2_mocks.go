@@ -0,0 +1,133 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/aws/aws-application-networking-k8s/pkg/aws/services (interfaces: EC2)
+//
+// Generated by this command:
+//
+//	mockgen -destination ec2_mocks.go -package services github.com/aws/aws-application-networking-k8s/pkg/aws/services EC2
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	context "context"
+	reflect "reflect"
+
+	ec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEC2 is a mock of EC2 interface.
+type MockEC2 struct {
+	ctrl     *gomock.Controller
+	recorder *MockEC2MockRecorder
+	isgomock struct{}
+}
+
+// MockEC2MockRecorder is the mock recorder for MockEC2.
+type MockEC2MockRecorder struct {
+	mock *MockEC2
+}
+
+// NewMockEC2 creates a new mock instance.
+func NewMockEC2(ctrl *gomock.Controller) *MockEC2 {
+	mock := &MockEC2{ctrl: ctrl}
+	mock.recorder = &MockEC2MockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEC2) EXPECT() *MockEC2MockRecorder {
+	return m.recorder
+}
+
+// CreateVpcEndpoint mocks base method.
+func (m *MockEC2) CreateVpcEndpoint(ctx context.Context, input *ec2.CreateVpcEndpointInput, optFns ...func(*ec2.Options)) (*ec2.CreateVpcEndpointOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateVpcEndpoint", varargs...)
+	ret0, _ := ret[0].(*ec2.CreateVpcEndpointOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVpcEndpoint indicates an expected call of CreateVpcEndpoint.
+func (mr *MockEC2MockRecorder) CreateVpcEndpoint(ctx, input any, optFns ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input}, optFns...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpcEndpoint", reflect.TypeOf((*MockEC2)(nil).CreateVpcEndpoint), varargs...)
+}
+
+// DeleteVpcEndpoints mocks base method.
+func (m *MockEC2) DeleteVpcEndpoints(ctx context.Context, input *ec2.DeleteVpcEndpointsInput, optFns ...func(*ec2.Options)) (*ec2.DeleteVpcEndpointsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteVpcEndpoints", varargs...)
+	ret0, _ := ret[0].(*ec2.DeleteVpcEndpointsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVpcEndpoints indicates an expected call of DeleteVpcEndpoints.
+func (mr *MockEC2MockRecorder) DeleteVpcEndpoints(ctx, input any, optFns ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input}, optFns...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpcEndpoints", reflect.TypeOf((*MockEC2)(nil).DeleteVpcEndpoints), varargs...)
+}
+
+// DescribeVpcEndpointAssociationsAsList mocks base method.
+func (m *MockEC2) DescribeVpcEndpointAssociationsAsList(ctx context.Context, input *ec2.DescribeVpcEndpointAssociationsInput) ([]types.VpcEndpointAssociation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeVpcEndpointAssociationsAsList", ctx, input)
+	ret0, _ := ret[0].([]types.VpcEndpointAssociation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVpcEndpointAssociationsAsList indicates an expected call of DescribeVpcEndpointAssociationsAsList.
+func (mr *MockEC2MockRecorder) DescribeVpcEndpointAssociationsAsList(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVpcEndpointAssociationsAsList", reflect.TypeOf((*MockEC2)(nil).DescribeVpcEndpointAssociationsAsList), ctx, input)
+}
+
+// DescribeVpcEndpointsAsList mocks base method.
+func (m *MockEC2) DescribeVpcEndpointsAsList(ctx context.Context, input *ec2.DescribeVpcEndpointsInput) ([]types.VpcEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeVpcEndpointsAsList", ctx, input)
+	ret0, _ := ret[0].([]types.VpcEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVpcEndpointsAsList indicates an expected call of DescribeVpcEndpointsAsList.
+func (mr *MockEC2MockRecorder) DescribeVpcEndpointsAsList(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVpcEndpointsAsList", reflect.TypeOf((*MockEC2)(nil).DescribeVpcEndpointsAsList), ctx, input)
+}
+
+// ModifyVpcEndpoint mocks base method.
+func (m *MockEC2) ModifyVpcEndpoint(ctx context.Context, input *ec2.ModifyVpcEndpointInput, optFns ...func(*ec2.Options)) (*ec2.ModifyVpcEndpointOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ModifyVpcEndpoint", varargs...)
+	ret0, _ := ret[0].(*ec2.ModifyVpcEndpointOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyVpcEndpoint indicates an expected call of ModifyVpcEndpoint.
+func (mr *MockEC2MockRecorder) ModifyVpcEndpoint(ctx, input any, optFns ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input}, optFns...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyVpcEndpoint", reflect.TypeOf((*MockEC2)(nil).ModifyVpcEndpoint), varargs...)
+}
@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ram"
+	ramtypes "github.com/aws/aws-sdk-go-v2/service/ram/types"
+)
+
+//go:generate mockgen -destination ram_mocks.go -package services github.com/aws/aws-application-networking-k8s/pkg/aws/services RAM
+
+// RAM defines the AWS Resource Access Manager API methods used by this controller.
+type RAM interface {
+	CreateResourceShare(ctx context.Context, input *ram.CreateResourceShareInput, optFns ...func(*ram.Options)) (*ram.CreateResourceShareOutput, error)
+	UpdateResourceShare(ctx context.Context, input *ram.UpdateResourceShareInput, optFns ...func(*ram.Options)) (*ram.UpdateResourceShareOutput, error)
+	DeleteResourceShare(ctx context.Context, input *ram.DeleteResourceShareInput, optFns ...func(*ram.Options)) (*ram.DeleteResourceShareOutput, error)
+	AssociateResourceShare(ctx context.Context, input *ram.AssociateResourceShareInput, optFns ...func(*ram.Options)) (*ram.AssociateResourceShareOutput, error)
+	DisassociateResourceShare(ctx context.Context, input *ram.DisassociateResourceShareInput, optFns ...func(*ram.Options)) (*ram.DisassociateResourceShareOutput, error)
+
+	GetResourceSharesAsList(ctx context.Context, input *ram.GetResourceSharesInput) ([]ramtypes.ResourceShare, error)
+	GetResourceShareAssociationsAsList(ctx context.Context, input *ram.GetResourceShareAssociationsInput) ([]ramtypes.ResourceShareAssociation, error)
+}
+
+type defaultRAM struct {
+	client *ram.Client
+}
+
+func NewDefaultRAM(cfg aws.Config) *defaultRAM {
+	return &defaultRAM{
+		client: ram.NewFromConfig(cfg, func(o *ram.Options) {
+			o.RetryMaxAttempts = 20
+		}),
+	}
+}
+
+func (d *defaultRAM) CreateResourceShare(ctx context.Context, input *ram.CreateResourceShareInput, optFns ...func(*ram.Options)) (*ram.CreateResourceShareOutput, error) {
+	return d.client.CreateResourceShare(ctx, input, optFns...)
+}
+
+func (d *defaultRAM) UpdateResourceShare(ctx context.Context, input *ram.UpdateResourceShareInput, optFns ...func(*ram.Options)) (*ram.UpdateResourceShareOutput, error) {
+	return d.client.UpdateResourceShare(ctx, input, optFns...)
+}
+
+func (d *defaultRAM) DeleteResourceShare(ctx context.Context, input *ram.DeleteResourceShareInput, optFns ...func(*ram.Options)) (*ram.DeleteResourceShareOutput, error) {
+	return d.client.DeleteResourceShare(ctx, input, optFns...)
+}
+
+func (d *defaultRAM) AssociateResourceShare(ctx context.Context, input *ram.AssociateResourceShareInput, optFns ...func(*ram.Options)) (*ram.AssociateResourceShareOutput, error) {
+	return d.client.AssociateResourceShare(ctx, input, optFns...)
+}
+
+func (d *defaultRAM) DisassociateResourceShare(ctx context.Context, input *ram.DisassociateResourceShareInput, optFns ...func(*ram.Options)) (*ram.DisassociateResourceShareOutput, error) {
+	return d.client.DisassociateResourceShare(ctx, input, optFns...)
+}
+
+func (d *defaultRAM) GetResourceSharesAsList(ctx context.Context, input *ram.GetResourceSharesInput) ([]ramtypes.ResourceShare, error) {
+	var result []ramtypes.ResourceShare
+	paginator := ram.NewGetResourceSharesPaginator(d.client, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page.ResourceShares...)
+	}
+	return result, nil
+}
+
+func (d *defaultRAM) GetResourceShareAssociationsAsList(ctx context.Context, input *ram.GetResourceShareAssociationsInput) ([]ramtypes.ResourceShareAssociation, error) {
+	var result []ramtypes.ResourceShareAssociation
+	paginator := ram.NewGetResourceShareAssociationsPaginator(d.client, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page.ResourceShareAssociations...)
+	}
+	return result, nil
+}
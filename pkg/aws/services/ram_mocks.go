@@ -0,0 +1,173 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/aws/aws-application-networking-k8s/pkg/aws/services (interfaces: RAM)
+//
+// Generated by this command:
+//
+//	mockgen -destination ram_mocks.go -package services github.com/aws/aws-application-networking-k8s/pkg/aws/services RAM
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	context "context"
+	reflect "reflect"
+
+	ram "github.com/aws/aws-sdk-go-v2/service/ram"
+	types "github.com/aws/aws-sdk-go-v2/service/ram/types"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRAM is a mock of RAM interface.
+type MockRAM struct {
+	ctrl     *gomock.Controller
+	recorder *MockRAMMockRecorder
+	isgomock struct{}
+}
+
+// MockRAMMockRecorder is the mock recorder for MockRAM.
+type MockRAMMockRecorder struct {
+	mock *MockRAM
+}
+
+// NewMockRAM creates a new mock instance.
+func NewMockRAM(ctrl *gomock.Controller) *MockRAM {
+	mock := &MockRAM{ctrl: ctrl}
+	mock.recorder = &MockRAMMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRAM) EXPECT() *MockRAMMockRecorder {
+	return m.recorder
+}
+
+// AssociateResourceShare mocks base method.
+func (m *MockRAM) AssociateResourceShare(ctx context.Context, input *ram.AssociateResourceShareInput, optFns ...func(*ram.Options)) (*ram.AssociateResourceShareOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AssociateResourceShare", varargs...)
+	ret0, _ := ret[0].(*ram.AssociateResourceShareOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssociateResourceShare indicates an expected call of AssociateResourceShare.
+func (mr *MockRAMMockRecorder) AssociateResourceShare(ctx, input any, optFns ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input}, optFns...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateResourceShare", reflect.TypeOf((*MockRAM)(nil).AssociateResourceShare), varargs...)
+}
+
+// CreateResourceShare mocks base method.
+func (m *MockRAM) CreateResourceShare(ctx context.Context, input *ram.CreateResourceShareInput, optFns ...func(*ram.Options)) (*ram.CreateResourceShareOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateResourceShare", varargs...)
+	ret0, _ := ret[0].(*ram.CreateResourceShareOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateResourceShare indicates an expected call of CreateResourceShare.
+func (mr *MockRAMMockRecorder) CreateResourceShare(ctx, input any, optFns ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input}, optFns...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResourceShare", reflect.TypeOf((*MockRAM)(nil).CreateResourceShare), varargs...)
+}
+
+// DeleteResourceShare mocks base method.
+func (m *MockRAM) DeleteResourceShare(ctx context.Context, input *ram.DeleteResourceShareInput, optFns ...func(*ram.Options)) (*ram.DeleteResourceShareOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteResourceShare", varargs...)
+	ret0, _ := ret[0].(*ram.DeleteResourceShareOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteResourceShare indicates an expected call of DeleteResourceShare.
+func (mr *MockRAMMockRecorder) DeleteResourceShare(ctx, input any, optFns ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input}, optFns...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceShare", reflect.TypeOf((*MockRAM)(nil).DeleteResourceShare), varargs...)
+}
+
+// DisassociateResourceShare mocks base method.
+func (m *MockRAM) DisassociateResourceShare(ctx context.Context, input *ram.DisassociateResourceShareInput, optFns ...func(*ram.Options)) (*ram.DisassociateResourceShareOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DisassociateResourceShare", varargs...)
+	ret0, _ := ret[0].(*ram.DisassociateResourceShareOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisassociateResourceShare indicates an expected call of DisassociateResourceShare.
+func (mr *MockRAMMockRecorder) DisassociateResourceShare(ctx, input any, optFns ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input}, optFns...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisassociateResourceShare", reflect.TypeOf((*MockRAM)(nil).DisassociateResourceShare), varargs...)
+}
+
+// GetResourceShareAssociationsAsList mocks base method.
+func (m *MockRAM) GetResourceShareAssociationsAsList(ctx context.Context, input *ram.GetResourceShareAssociationsInput) ([]types.ResourceShareAssociation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResourceShareAssociationsAsList", ctx, input)
+	ret0, _ := ret[0].([]types.ResourceShareAssociation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResourceShareAssociationsAsList indicates an expected call of GetResourceShareAssociationsAsList.
+func (mr *MockRAMMockRecorder) GetResourceShareAssociationsAsList(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceShareAssociationsAsList", reflect.TypeOf((*MockRAM)(nil).GetResourceShareAssociationsAsList), ctx, input)
+}
+
+// GetResourceSharesAsList mocks base method.
+func (m *MockRAM) GetResourceSharesAsList(ctx context.Context, input *ram.GetResourceSharesInput) ([]types.ResourceShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResourceSharesAsList", ctx, input)
+	ret0, _ := ret[0].([]types.ResourceShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResourceSharesAsList indicates an expected call of GetResourceSharesAsList.
+func (mr *MockRAMMockRecorder) GetResourceSharesAsList(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceSharesAsList", reflect.TypeOf((*MockRAM)(nil).GetResourceSharesAsList), ctx, input)
+}
+
+// UpdateResourceShare mocks base method.
+func (m *MockRAM) UpdateResourceShare(ctx context.Context, input *ram.UpdateResourceShareInput, optFns ...func(*ram.Options)) (*ram.UpdateResourceShareOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, input}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateResourceShare", varargs...)
+	ret0, _ := ret[0].(*ram.UpdateResourceShareOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateResourceShare indicates an expected call of UpdateResourceShare.
+func (mr *MockRAMMockRecorder) UpdateResourceShare(ctx, input any, optFns ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, input}, optFns...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResourceShare", reflect.TypeOf((*MockRAM)(nil).UpdateResourceShare), varargs...)
+}
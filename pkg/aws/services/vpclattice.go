@@ -481,7 +481,7 @@ func (d *defaultLattice) ListServiceNetworkServiceAssociationsAsList(ctx context
 
 // Helper methods
 
-func (d *defaultLattice) snSummaryToLog(snSum []types.ServiceNetworkSummary) string {
+func snSummaryToLog(snSum []types.ServiceNetworkSummary) string {
 	out := make([]string, len(snSum))
 	for i, s := range snSum {
 		out[i] = fmt.Sprintf("{name=%s, id=%s}", aws.ToString(s.Name), aws.ToString(s.Id))
@@ -489,10 +489,10 @@ func (d *defaultLattice) snSummaryToLog(snSum []types.ServiceNetworkSummary) str
 	return strings.Join(out, ",")
 }
 
-// Try find by name first, if there is no single match, continue with id match. Ideally name match
-// should work just fine, but in desperate scenario of shared SN when naming collision happens using
+// MatchServiceNetwork tries to find by name first, if there is no single match, continue with id match. Ideally
+// name match should work just fine, but in desperate scenario of shared SN when naming collision happens using
 // id can be an option
-func (d *defaultLattice) serviceNetworkMatch(allSn []types.ServiceNetworkSummary, nameOrId string) (*types.ServiceNetworkSummary, error) {
+func MatchServiceNetwork(allSn []types.ServiceNetworkSummary, nameOrId string) (*types.ServiceNetworkSummary, error) {
 	var snMatch *types.ServiceNetworkSummary
 	nameMatch := utils.SliceFilter(allSn, func(snSum types.ServiceNetworkSummary) bool {
 		return aws.ToString(snSum.Name) == nameOrId
@@ -506,7 +506,7 @@ func (d *defaultLattice) serviceNetworkMatch(allSn []types.ServiceNetworkSummary
 		return nil, NewNotFoundError("Service network", nameOrId)
 	case len(nameMatch)+len(idMatch) > 1:
 		return nil, fmt.Errorf("%w, multiple SN found: nameMatch=%s idMatch=%s",
-			ErrNameConflict, d.snSummaryToLog(nameMatch), d.snSummaryToLog(idMatch))
+			ErrNameConflict, snSummaryToLog(nameMatch), snSummaryToLog(idMatch))
 	case len(nameMatch) == 1:
 		snMatch = &nameMatch[0]
 	case len(idMatch) == 1:
@@ -539,7 +539,7 @@ func (d *defaultLattice) FindServiceNetwork(ctx context.Context, nameOrId string
 		return nil, err
 	}
 
-	snMatch, err := d.serviceNetworkMatch(allSn, nameOrId)
+	snMatch, err := MatchServiceNetwork(allSn, nameOrId)
 
 	// If found locally, return it with tags
 	if err == nil {
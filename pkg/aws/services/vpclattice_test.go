@@ -87,11 +87,9 @@ func TestServiceNetworkMatch(t *testing.T) {
 		},
 	}
 
-	d := defaultLattice{}
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			sn, err := d.serviceNetworkMatch(tt.inAllSn, tt.inNameOrId)
+			sn, err := MatchServiceNetwork(tt.inAllSn, tt.inNameOrId)
 			assert.ErrorIs(t, err, tt.outErrType)
 			if tt.outErrType == nil {
 				assert.Equal(t,
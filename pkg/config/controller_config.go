@@ -23,17 +23,24 @@ const (
 )
 
 const (
-	REGION                           = "REGION"
-	CLUSTER_VPC_ID                   = "CLUSTER_VPC_ID"
-	CLUSTER_NAME                     = "CLUSTER_NAME"
-	DEFAULT_SERVICE_NETWORK          = "DEFAULT_SERVICE_NETWORK"
-	DISABLE_TAGGING_SERVICE_API      = "DISABLE_TAGGING_SERVICE_API"
-	ENABLE_SERVICE_NETWORK_OVERRIDE  = "ENABLE_SERVICE_NETWORK_OVERRIDE"
-	AWS_ACCOUNT_ID                   = "AWS_ACCOUNT_ID"
-	DEV_MODE                         = "DEV_MODE"
-	WEBHOOK_ENABLED                  = "WEBHOOK_ENABLED"
-	ROUTE_MAX_CONCURRENT_RECONCILES  = "ROUTE_MAX_CONCURRENT_RECONCILES"
-	RECONCILE_DEFAULT_RESYNC_SECONDS = "RECONCILE_DEFAULT_RESYNC_SECONDS"
+	REGION                               = "REGION"
+	CLUSTER_VPC_ID                       = "CLUSTER_VPC_ID"
+	CLUSTER_NAME                         = "CLUSTER_NAME"
+	DEFAULT_SERVICE_NETWORK              = "DEFAULT_SERVICE_NETWORK"
+	DISABLE_TAGGING_SERVICE_API          = "DISABLE_TAGGING_SERVICE_API"
+	ENABLE_SERVICE_NETWORK_OVERRIDE      = "ENABLE_SERVICE_NETWORK_OVERRIDE"
+	AWS_ACCOUNT_ID                       = "AWS_ACCOUNT_ID"
+	DEV_MODE                             = "DEV_MODE"
+	WEBHOOK_ENABLED                      = "WEBHOOK_ENABLED"
+	ROUTE_MAX_CONCURRENT_RECONCILES      = "ROUTE_MAX_CONCURRENT_RECONCILES"
+	RECONCILE_DEFAULT_RESYNC_SECONDS     = "RECONCILE_DEFAULT_RESYNC_SECONDS"
+	ENABLE_PRECEDENCE_RULE_ORDERING      = "ENABLE_PRECEDENCE_RULE_ORDERING"
+	ENABLE_SERVICE_PER_HOSTNAME          = "ENABLE_SERVICE_PER_HOSTNAME"
+	ENABLE_RULE_SPLITTING                = "ENABLE_RULE_SPLITTING"
+	ENABLE_TARGETS_FAST_PATH             = "ENABLE_TARGETS_FAST_PATH"
+	TARGETS_COALESCE_WINDOW_SECONDS      = "TARGETS_COALESCE_WINDOW_SECONDS"
+	ENABLE_SHARED_TARGET_GROUPS          = "ENABLE_SHARED_TARGET_GROUPS"
+	ENABLE_READINESS_PROBE_HEALTH_CHECKS = "ENABLE_READINESS_PROBE_HEALTH_CHECKS"
 )
 
 var VpcID = ""
@@ -46,6 +53,13 @@ var WebhookEnabled = ""
 
 var DisableTaggingServiceAPI = false
 var ServiceNetworkOverrideMode = false
+var PrecedenceRuleOrdering = false
+var ServicePerHostname = false
+var RuleSplitting = false
+var TargetsFastPath = false
+var TargetsCoalesceWindow = 2 * time.Second
+var SharedTargetGroups = false
+var ReadinessProbeHealthChecks = false
 var RouteMaxConcurrentReconciles = 1
 var ReconcileDefaultResyncInterval time.Duration // 0 = disabled (current behavior)
 
@@ -101,6 +115,36 @@ func configInit(cfg aws.Config, metadata EC2Metadata) error {
 		DisableTaggingServiceAPI = true
 	}
 
+	precedenceRuleOrdering := os.Getenv(ENABLE_PRECEDENCE_RULE_ORDERING)
+	if strings.ToLower(precedenceRuleOrdering) == "true" {
+		PrecedenceRuleOrdering = true
+	}
+
+	servicePerHostname := os.Getenv(ENABLE_SERVICE_PER_HOSTNAME)
+	if strings.ToLower(servicePerHostname) == "true" {
+		ServicePerHostname = true
+	}
+
+	ruleSplitting := os.Getenv(ENABLE_RULE_SPLITTING)
+	if strings.ToLower(ruleSplitting) == "true" {
+		RuleSplitting = true
+	}
+
+	targetsFastPath := os.Getenv(ENABLE_TARGETS_FAST_PATH)
+	if strings.ToLower(targetsFastPath) == "true" {
+		TargetsFastPath = true
+	}
+
+	sharedTargetGroups := os.Getenv(ENABLE_SHARED_TARGET_GROUPS)
+	if strings.ToLower(sharedTargetGroups) == "true" {
+		SharedTargetGroups = true
+	}
+
+	readinessProbeHealthChecks := os.Getenv(ENABLE_READINESS_PROBE_HEALTH_CHECKS)
+	if strings.ToLower(readinessProbeHealthChecks) == "true" {
+		ReadinessProbeHealthChecks = true
+	}
+
 	ClusterName, err = getClusterName(cfg)
 	if err != nil {
 		return fmt.Errorf("cannot get cluster name: %s", err)
@@ -131,6 +175,18 @@ func configInit(cfg aws.Config, metadata EC2Metadata) error {
 		ReconcileDefaultResyncInterval = time.Duration(reconcileDefaultResyncIntervalInt) * time.Second
 	}
 
+	targetsCoalesceWindow := os.Getenv(TARGETS_COALESCE_WINDOW_SECONDS)
+	if targetsCoalesceWindow != "" {
+		targetsCoalesceWindowInt, err := strconv.Atoi(targetsCoalesceWindow)
+		if err != nil {
+			return fmt.Errorf("invalid value for TARGETS_COALESCE_WINDOW_SECONDS: %s", err)
+		}
+		if targetsCoalesceWindowInt < 1 || targetsCoalesceWindowInt > 60 {
+			return fmt.Errorf("TARGETS_COALESCE_WINDOW_SECONDS must be between 1 and 60 seconds, got %d", targetsCoalesceWindowInt)
+		}
+		TargetsCoalesceWindow = time.Duration(targetsCoalesceWindowInt) * time.Second
+	}
+
 	return nil
 }
 
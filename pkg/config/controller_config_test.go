@@ -4,6 +4,7 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/stretchr/testify/assert"
@@ -69,6 +70,13 @@ func Test_config_init_with_all_env_var(t *testing.T) {
 	os.Setenv(AWS_ACCOUNT_ID, testAwsAccountId)
 	os.Setenv(CLUSTER_NAME, testClusterName)
 	os.Setenv(ROUTE_MAX_CONCURRENT_RECONCILES, testMaxRouteReconciles)
+	os.Setenv(ENABLE_PRECEDENCE_RULE_ORDERING, "true")
+	os.Setenv(ENABLE_SERVICE_PER_HOSTNAME, "true")
+	os.Setenv(ENABLE_RULE_SPLITTING, "true")
+	os.Setenv(ENABLE_TARGETS_FAST_PATH, "true")
+	os.Setenv(TARGETS_COALESCE_WINDOW_SECONDS, "5")
+	os.Setenv(ENABLE_SHARED_TARGET_GROUPS, "true")
+	os.Setenv(ENABLE_READINESS_PROBE_HEALTH_CHECKS, "true")
 	err := configInit(aws.Config{}, ec2MetadataUnavailable())
 	assert.Nil(t, err)
 	assert.Equal(t, testRegion, Region)
@@ -77,6 +85,20 @@ func Test_config_init_with_all_env_var(t *testing.T) {
 	assert.Equal(t, testClusterLocalGateway, DefaultServiceNetwork)
 	assert.Equal(t, testClusterName, ClusterName)
 	assert.Equal(t, testMaxRouteReconcilesInt, RouteMaxConcurrentReconciles)
+	assert.True(t, PrecedenceRuleOrdering)
+	assert.True(t, ServicePerHostname)
+	assert.True(t, RuleSplitting)
+	assert.True(t, TargetsFastPath)
+	assert.Equal(t, 5*time.Second, TargetsCoalesceWindow)
+	assert.True(t, SharedTargetGroups)
+	assert.True(t, ReadinessProbeHealthChecks)
+}
+
+func Test_bad_targets_coalesce_window(t *testing.T) {
+	os.Setenv(TARGETS_COALESCE_WINDOW_SECONDS, "0")
+	defer os.Unsetenv(TARGETS_COALESCE_WINDOW_SECONDS)
+	err := configInit(aws.Config{}, ec2MetadataUnavailable())
+	assert.NotNil(t, err)
 }
 
 func Test_bad_reconcile_value(t *testing.T) {
@@ -0,0 +1,21 @@
+package controllers
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	labelServiceNetwork = "service_network"
+	labelDrift          = "drift"
+)
+
+var serviceNetworkDriftRepairsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "lattice",
+	Name:      "service_network_drift_repairs_total",
+	Help:      "Total number of out-of-band changes to service networks repaired by the controller",
+}, []string{labelServiceNetwork, labelDrift})
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(serviceNetworkDriftRepairsTotal)
+}
@@ -7,4 +7,6 @@ var (
 	KindError         = errors.New("target kind error")
 	TargetRefNotFound = errors.New("targetRef not found")
 	TargetRefConflict = errors.New("targetRef has conflict")
+
+	UnsupportedParametersRef = errors.New("unsupported parametersRef")
 )
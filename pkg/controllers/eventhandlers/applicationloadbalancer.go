@@ -0,0 +1,46 @@
+package eventhandlers
+
+import (
+	"context"
+
+	anv1alpha1 "github.com/aws/aws-application-networking-k8s/pkg/apis/applicationnetworking/v1alpha1"
+	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/aws/aws-application-networking-k8s/pkg/k8s"
+)
+
+type applicationLoadBalancerEventHandler struct {
+	log    gwlog.Logger
+	client client.Client
+	mapper *resourceMapper
+}
+
+func NewApplicationLoadBalancerEventHandler(log gwlog.Logger, client client.Client) *applicationLoadBalancerEventHandler {
+	return &applicationLoadBalancerEventHandler{
+		log:    log,
+		client: client,
+		mapper: &resourceMapper{log: log, client: client},
+	}
+}
+
+// MapToRoute maps ApplicationLoadBalancer changes to HTTPRoutes, the only route type supporting the backendRef kind
+func (h *applicationLoadBalancerEventHandler) MapToRoute() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(h.mapToRoute)
+}
+
+func (h *applicationLoadBalancerEventHandler) mapToRoute(ctx context.Context, obj client.Object) []reconcile.Request {
+	routes := h.mapper.ApplicationLoadBalancerToRoutes(ctx, obj.(*anv1alpha1.ApplicationLoadBalancer))
+
+	var requests []reconcile.Request
+	for _, route := range routes {
+		routeName := k8s.NamespacedName(route.K8sObject())
+		requests = append(requests, reconcile.Request{NamespacedName: routeName})
+		h.log.Infow(ctx, "ApplicationLoadBalancer resource change triggered Route update",
+			"applicationLoadBalancerName", obj.GetNamespace()+"/"+obj.GetName(), "routeName", routeName)
+	}
+	return requests
+}
@@ -54,6 +54,9 @@ func (h *enqueueRequestsForGatewayEvent) Update(ctx context.Context, e event.Upd
 		// initialize transition time
 		gwNew.Status.Conditions[0].LastTransitionTime = ZeroTransitionTime
 		h.enqueueImpactedRoutes(ctx, queue)
+	} else if gwOld.Annotations[k8s.ServiceNetworkArnAnnotation] != gwNew.Annotations[k8s.ServiceNetworkArnAnnotation] {
+		// the service network was recreated, services need to be associated again
+		h.enqueueImpactedRoutes(ctx, queue)
 	}
 }
 
@@ -3,6 +3,7 @@ package eventhandlers
 import (
 	"context"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -33,6 +34,12 @@ func (h *enqueueRequestsForGatewayClassEvent) Create(ctx context.Context, e even
 }
 
 func (h *enqueueRequestsForGatewayClassEvent) Update(ctx context.Context, e event.UpdateEvent, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	gwClassOld := e.ObjectOld.(*gwv1.GatewayClass)
+	gwClassNew := e.ObjectNew.(*gwv1.GatewayClass)
+	// the GatewayClassConfig of the gateways changed
+	if !equality.Semantic.DeepEqual(gwClassOld.Spec.ParametersRef, gwClassNew.Spec.ParametersRef) {
+		h.enqueueImpactedGateway(ctx, queue, gwClassNew)
+	}
 }
 
 func (h *enqueueRequestsForGatewayClassEvent) Delete(ctx context.Context, e event.DeleteEvent, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
@@ -0,0 +1,81 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/aws/aws-application-networking-k8s/pkg/apis/applicationnetworking/v1alpha1"
+	"github.com/aws/aws-application-networking-k8s/pkg/config"
+	"github.com/aws/aws-application-networking-k8s/pkg/k8s"
+	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+type gatewayClassConfigEventHandler struct {
+	log    gwlog.Logger
+	client client.Client
+}
+
+func NewGatewayClassConfigEventHandler(log gwlog.Logger, client client.Client) *gatewayClassConfigEventHandler {
+	return &gatewayClassConfigEventHandler{log: log, client: client}
+}
+
+func (h *gatewayClassConfigEventHandler) MapToGatewayClass() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		var requests []reconcile.Request
+		for _, gwClass := range h.referencingGatewayClasses(ctx, obj) {
+			requests = append(requests, reconcile.Request{NamespacedName: k8s.NamespacedName(&gwClass)})
+		}
+		return requests
+	})
+}
+
+func (h *gatewayClassConfigEventHandler) MapToGateway() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		gwClasses := h.referencingGatewayClasses(ctx, obj)
+		if len(gwClasses) == 0 {
+			return nil
+		}
+		gwList := &gwv1.GatewayList{}
+		if err := h.client.List(ctx, gwList); err != nil {
+			h.log.Errorf(ctx, "Error listing Gateways during GatewayClassConfig event %s", err)
+			return nil
+		}
+		var requests []reconcile.Request
+		for _, gw := range gwList.Items {
+			for _, gwClass := range gwClasses {
+				if string(gw.Spec.GatewayClassName) == gwClass.Name {
+					requests = append(requests, reconcile.Request{NamespacedName: k8s.NamespacedName(&gw)})
+				}
+			}
+		}
+		return requests
+	})
+}
+
+// referencingGatewayClasses returns the GatewayClasses of this controller referencing the GatewayClassConfig
+func (h *gatewayClassConfigEventHandler) referencingGatewayClasses(ctx context.Context, obj client.Object) []gwv1.GatewayClass {
+	gcc, ok := obj.(*v1alpha1.GatewayClassConfig)
+	if !ok {
+		return nil
+	}
+	gwClassList := &gwv1.GatewayClassList{}
+	if err := h.client.List(ctx, gwClassList); err != nil {
+		h.log.Errorf(ctx, "Error listing GatewayClasses during GatewayClassConfig event %s", err)
+		return nil
+	}
+	var gwClasses []gwv1.GatewayClass
+	for _, gwClass := range gwClassList.Items {
+		ref := gwClass.Spec.ParametersRef
+		if gwClass.Spec.ControllerName != config.LatticeGatewayControllerName || ref == nil {
+			continue
+		}
+		if string(ref.Group) == v1alpha1.GroupName && string(ref.Kind) == v1alpha1.GatewayClassConfigKind && ref.Name == gcc.Name {
+			gwClasses = append(gwClasses, gwClass)
+		}
+	}
+	return gwClasses
+}
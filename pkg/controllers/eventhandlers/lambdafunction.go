@@ -0,0 +1,46 @@
+package eventhandlers
+
+import (
+	"context"
+
+	anv1alpha1 "github.com/aws/aws-application-networking-k8s/pkg/apis/applicationnetworking/v1alpha1"
+	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/aws/aws-application-networking-k8s/pkg/k8s"
+)
+
+type lambdaFunctionEventHandler struct {
+	log    gwlog.Logger
+	client client.Client
+	mapper *resourceMapper
+}
+
+func NewLambdaFunctionEventHandler(log gwlog.Logger, client client.Client) *lambdaFunctionEventHandler {
+	return &lambdaFunctionEventHandler{
+		log:    log,
+		client: client,
+		mapper: &resourceMapper{log: log, client: client},
+	}
+}
+
+// MapToRoute maps LambdaFunction changes to HTTPRoutes, the only route type supporting the backendRef kind
+func (h *lambdaFunctionEventHandler) MapToRoute() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(h.mapToRoute)
+}
+
+func (h *lambdaFunctionEventHandler) mapToRoute(ctx context.Context, obj client.Object) []reconcile.Request {
+	routes := h.mapper.LambdaFunctionToRoutes(ctx, obj.(*anv1alpha1.LambdaFunction))
+
+	var requests []reconcile.Request
+	for _, route := range routes {
+		routeName := k8s.NamespacedName(route.K8sObject())
+		requests = append(requests, reconcile.Request{NamespacedName: routeName})
+		h.log.Infow(ctx, "LambdaFunction resource change triggered Route update",
+			"lambdaFunctionName", obj.GetNamespace()+"/"+obj.GetName(), "routeName", routeName)
+	}
+	return requests
+}
@@ -15,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
 type resourceMapper struct {
@@ -80,8 +81,127 @@ func (r *resourceMapper) EndpointSliceToService(ctx context.Context, epSlice *di
 	return nil
 }
 
+// TargetGroupPolicyToService returns the Service a policy applies to. The policy of a ServiceExport also applies to
+// the route target groups of the Service with the same name
 func (r *resourceMapper) TargetGroupPolicyToService(ctx context.Context, tgp *anv1alpha1.TargetGroupPolicy) *corev1.Service {
-	return policyToTargetRefObj(r, ctx, tgp, &corev1.Service{})
+	if svc := policyToTargetRefObj(r, ctx, tgp, &corev1.Service{}); svc != nil || tgp == nil {
+		return svc
+	}
+	targetRef := tgp.GetTargetRef()
+	if targetRef == nil || targetRef.Kind != "ServiceExport" || (targetRef.Group != "" && targetRef.Group != anv1alpha1.GroupName) {
+		return nil
+	}
+	svc := &corev1.Service{}
+	key := types.NamespacedName{Namespace: tgp.Namespace, Name: string(targetRef.Name)}
+	if err := r.client.Get(ctx, key, svc); err != nil {
+		return nil
+	}
+	return svc
+}
+
+// TargetGroupPolicyServices returns the Services a policy applies to through a targetSelector, the selected Services
+// or the Services of the selected ServiceExports, or as the defaults of its Namespace, all its Services
+func (r *resourceMapper) TargetGroupPolicyServices(ctx context.Context, tgp *anv1alpha1.TargetGroupPolicy) []*corev1.Service {
+	selector := tgp.GetTargetSelector()
+	if selector == nil && isNamespaceTargetGroupPolicy(tgp) {
+		selector = &anv1alpha1.TargetSelector{Kind: serviceKind}
+	}
+	if selector == nil {
+		return nil
+	}
+	listOpts := []client.ListOption{client.InNamespace(tgp.Namespace), client.MatchingLabels(selector.MatchLabels)}
+	var svcNames []string
+	switch {
+	case selector.Kind == serviceKind && (selector.Group == "" || selector.Group == corev1.GroupName):
+		svcList := &corev1.ServiceList{}
+		if err := r.client.List(ctx, svcList, listOpts...); err != nil {
+			r.log.Errorf(ctx, "Failed to list Services selected by TargetGroupPolicy %s/%s, %s", tgp.Namespace, tgp.Name, err)
+			return nil
+		}
+		svcs := make([]*corev1.Service, len(svcList.Items))
+		for i := range svcList.Items {
+			svcs[i] = &svcList.Items[i]
+		}
+		return svcs
+	case selector.Kind == "ServiceExport" && (selector.Group == "" || selector.Group == anv1alpha1.GroupName):
+		svcExportList := &anv1alpha1.ServiceExportList{}
+		if err := r.client.List(ctx, svcExportList, listOpts...); err != nil {
+			r.log.Errorf(ctx, "Failed to list ServiceExports selected by TargetGroupPolicy %s/%s, %s", tgp.Namespace, tgp.Name, err)
+			return nil
+		}
+		for i := range svcExportList.Items {
+			svcNames = append(svcNames, k8sutils.GetServiceNameFromServiceExport(&svcExportList.Items[i]))
+		}
+	}
+	var svcs []*corev1.Service
+	for _, svcName := range svcNames {
+		svc := &corev1.Service{}
+		if err := r.client.Get(ctx, types.NamespacedName{Namespace: tgp.Namespace, Name: svcName}, svc); err != nil {
+			continue
+		}
+		svcs = append(svcs, svc)
+	}
+	return svcs
+}
+
+// isServiceTargetGroupPolicy tells whether a policy applies to a single Service, through a targetRef to the Service
+// or to its ServiceExport
+func isServiceTargetGroupPolicy(tgp *anv1alpha1.TargetGroupPolicy) bool {
+	targetRef := tgp.GetTargetRef()
+	return tgp.GetTargetSelector() == nil && targetRef != nil &&
+		(targetRef.Kind == serviceKind || targetRef.Kind == "ServiceExport")
+}
+
+func isNamespaceTargetGroupPolicy(tgp *anv1alpha1.TargetGroupPolicy) bool {
+	targetRef := tgp.GetTargetRef()
+	return targetRef != nil && targetRef.Kind == "Namespace" && targetRef.Group == corev1.GroupName
+}
+
+// TargetGroupPolicyToRoutes returns the routes attached to the Gateway of a policy, which provides the defaults of
+// their target groups
+func (r *resourceMapper) TargetGroupPolicyToRoutes(ctx context.Context, tgp *anv1alpha1.TargetGroupPolicy, routeType core.RouteType) []core.Route {
+	targetRef := tgp.GetTargetRef()
+	if targetRef == nil || targetRef.Kind != gatewayKind || targetRef.Group != gwv1.GroupName {
+		return nil
+	}
+	var filteredRoutes []core.Route
+	for _, route := range r.listRoutes(ctx, routeType) {
+		for _, parentRef := range route.Spec().ParentRefs() {
+			gwNamespace := route.Namespace()
+			if parentRef.Namespace != nil {
+				gwNamespace = string(*parentRef.Namespace)
+			}
+			if parentRef.Kind != nil && *parentRef.Kind != gatewayKind {
+				continue
+			}
+			if string(parentRef.Name) == string(targetRef.Name) && gwNamespace == tgp.Namespace {
+				filteredRoutes = append(filteredRoutes, route)
+				break
+			}
+		}
+	}
+	return filteredRoutes
+}
+
+// NodeToTargetGroupPolicies returns the policies selecting INSTANCE targets, whose targets are the nodes
+// hosting the service endpoints. Any node change may add or remove one of those targets
+func (r *resourceMapper) NodeToTargetGroupPolicies(ctx context.Context, node *corev1.Node) []*anv1alpha1.TargetGroupPolicy {
+	if node == nil {
+		return nil
+	}
+	tgpList := &anv1alpha1.TargetGroupPolicyList{}
+	if err := r.client.List(ctx, tgpList); err != nil {
+		r.log.Errorf(ctx, "Failed to list TargetGroupPolicies for node %s, %s", node.Name, err)
+		return nil
+	}
+	var tgps []*anv1alpha1.TargetGroupPolicy
+	for i := range tgpList.Items {
+		targetType := tgpList.Items[i].Spec.TargetType
+		if targetType != nil && *targetType == "INSTANCE" {
+			tgps = append(tgps, &tgpList.Items[i])
+		}
+	}
+	return tgps
 }
 
 func (r *resourceMapper) VpcAssociationPolicyToGateway(ctx context.Context, vap *anv1alpha1.VpcAssociationPolicy) *gwv1.Gateway {
@@ -162,37 +282,155 @@ func (r *resourceMapper) backendRefToRoutes(ctx context.Context, obj client.Obje
 	if obj == nil {
 		return nil
 	}
+	var filteredRoutes []core.Route
+	for _, route := range r.listRoutes(ctx, routeType) {
+		if r.isBackendRefUsedByRoute(route, obj, group, kind) {
+			filteredRoutes = append(filteredRoutes, route)
+		}
+	}
+	return filteredRoutes
+}
+
+// ReferenceGrantToRoutes returns routes allowed by the grant's "from" entries that have
+// a backendRef into the grant namespace, whether or not the grant currently permits it
+func (r *resourceMapper) ReferenceGrantToRoutes(ctx context.Context, grant *gwv1beta1.ReferenceGrant, routeType core.RouteType) []core.Route {
+	if grant == nil {
+		return nil
+	}
+	var filteredRoutes []core.Route
+	for _, from := range grant.Spec.From {
+		if string(from.Group) != gwv1.GroupName {
+			continue
+		}
+		for _, route := range r.listRoutes(ctx, routeType, client.InNamespace(string(from.Namespace))) {
+			if string(from.Kind) == route.GroupKind().Kind && hasBackendRefInNamespace(route, grant.Namespace) {
+				filteredRoutes = append(filteredRoutes, route)
+			}
+		}
+	}
+	return filteredRoutes
+}
+
+// LambdaFunctionToRoutes returns HTTPRoutes with a backendRef referencing the LambdaFunction
+func (r *resourceMapper) LambdaFunctionToRoutes(ctx context.Context, lambdaFunction *anv1alpha1.LambdaFunction) []core.Route {
+	if lambdaFunction == nil {
+		return nil
+	}
+	return r.backendRefToRoutes(ctx, lambdaFunction, anv1alpha1.GroupName, anv1alpha1.LambdaFunctionKind, core.HttpRouteType)
+}
+
+// ApplicationLoadBalancerToRoutes returns HTTPRoutes with a backendRef referencing the ApplicationLoadBalancer
+func (r *resourceMapper) ApplicationLoadBalancerToRoutes(ctx context.Context, alb *anv1alpha1.ApplicationLoadBalancer) []core.Route {
+	if alb == nil {
+		return nil
+	}
+	return r.backendRefToRoutes(ctx, alb, anv1alpha1.GroupName, anv1alpha1.ApplicationLoadBalancerKind, core.HttpRouteType)
+}
+
+// FixedResponseToRoutes returns HTTPRoutes in the FixedResponse namespace with a rule filter referencing it
+func (r *resourceMapper) FixedResponseToRoutes(ctx context.Context, fixedResponse *anv1alpha1.FixedResponse) []core.Route {
+	if fixedResponse == nil {
+		return nil
+	}
+	routeList := &gwv1.HTTPRouteList{}
+	if err := r.client.List(ctx, routeList, client.InNamespace(fixedResponse.Namespace)); err != nil {
+		return nil
+	}
+	var filteredRoutes []core.Route
+	for _, k8sRoute := range routeList.Items {
+		if isFixedResponseUsedByRoute(&k8sRoute, fixedResponse.Name) {
+			filteredRoutes = append(filteredRoutes, core.NewHTTPRoute(k8sRoute))
+		}
+	}
+	return filteredRoutes
+}
+
+func isFixedResponseUsedByRoute(route *gwv1.HTTPRoute, name string) bool {
+	for _, rule := range route.Spec.Rules {
+		for _, filter := range rule.Filters {
+			ref := filter.ExtensionRef
+			if filter.Type == gwv1.HTTPRouteFilterExtensionRef && ref != nil &&
+				string(ref.Group) == anv1alpha1.GroupName && string(ref.Kind) == anv1alpha1.FixedResponseKind &&
+				string(ref.Name) == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// HeaderMatchFilterToRoutes returns routes of the given type in the HeaderMatchFilter namespace with a rule filter referencing it
+func (r *resourceMapper) HeaderMatchFilterToRoutes(ctx context.Context, headerMatchFilter *anv1alpha1.HeaderMatchFilter, routeType core.RouteType) []core.Route {
+	if headerMatchFilter == nil {
+		return nil
+	}
+	var filteredRoutes []core.Route
+	for _, route := range r.listRoutes(ctx, routeType, client.InNamespace(headerMatchFilter.Namespace)) {
+		if isHeaderMatchFilterUsedByRoute(route, headerMatchFilter.Name) {
+			filteredRoutes = append(filteredRoutes, route)
+		}
+	}
+	return filteredRoutes
+}
+
+func isHeaderMatchFilterUsedByRoute(route core.Route, name string) bool {
+	isHeaderMatchFilterRef := func(ref *gwv1.LocalObjectReference) bool {
+		return ref != nil && string(ref.Group) == anv1alpha1.GroupName &&
+			string(ref.Kind) == anv1alpha1.HeaderMatchFilterKind && string(ref.Name) == name
+	}
+	for _, rule := range route.Spec().Rules() {
+		switch r := rule.(type) {
+		case *core.HTTPRouteRule:
+			for _, filter := range r.Filters() {
+				if filter.Type == gwv1.HTTPRouteFilterExtensionRef && isHeaderMatchFilterRef(filter.ExtensionRef) {
+					return true
+				}
+			}
+		case *core.GRPCRouteRule:
+			for _, filter := range r.Filters() {
+				if filter.Type == gwv1.GRPCRouteFilterExtensionRef && isHeaderMatchFilterRef(filter.ExtensionRef) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func hasBackendRefInNamespace(route core.Route, namespace string) bool {
+	for _, rule := range route.Spec().Rules() {
+		for _, backendRef := range rule.BackendRefs() {
+			if backendRef.Namespace() != nil && string(*backendRef.Namespace()) == namespace {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r *resourceMapper) listRoutes(ctx context.Context, routeType core.RouteType, opts ...client.ListOption) []core.Route {
 	var routes []core.Route
 	switch routeType {
 	case core.HttpRouteType:
 		routeList := &gwv1.HTTPRouteList{}
-		r.client.List(ctx, routeList)
+		r.client.List(ctx, routeList, opts...)
 		for _, k8sRoute := range routeList.Items {
 			routes = append(routes, core.NewHTTPRoute(k8sRoute))
 		}
 	case core.GrpcRouteType:
 		routeList := &gwv1.GRPCRouteList{}
-		r.client.List(ctx, routeList)
+		r.client.List(ctx, routeList, opts...)
 		for _, k8sRoute := range routeList.Items {
 			routes = append(routes, core.NewGRPCRoute(k8sRoute))
 		}
 	case core.TlsRouteType:
 		routeList := &gwv1.TLSRouteList{}
-		r.client.List(ctx, routeList)
+		r.client.List(ctx, routeList, opts...)
 		for _, k8sRoute := range routeList.Items {
 			routes = append(routes, core.NewTLSRoute(k8sRoute))
 		}
-	default:
-		return nil
 	}
-
-	var filteredRoutes []core.Route
-	for _, route := range routes {
-		if r.isBackendRefUsedByRoute(route, obj, group, kind) {
-			filteredRoutes = append(filteredRoutes, route)
-		}
-	}
-	return filteredRoutes
+	return routes
 }
 
 func (r *resourceMapper) isBackendRefUsedByRoute(route core.Route, obj client.Object, group, kind string) bool {
@@ -13,6 +13,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
@@ -169,6 +170,20 @@ func TestTargetGroupPolicyToService(t *testing.T) {
 			serviceFound:    true,
 			success:         true,
 		},
+		{
+			namespace:       ns1,
+			targetKind:      "ServiceExport",
+			targetNamespace: nil,
+			serviceFound:    true,
+			success:         true,
+		},
+		{
+			namespace:       ns1,
+			targetKind:      "ServiceExport",
+			targetNamespace: nil,
+			serviceFound:    false,
+			success:         false,
+		},
 	}
 
 	for i, tt := range testCases {
@@ -203,6 +218,55 @@ func TestTargetGroupPolicyToService(t *testing.T) {
 	}
 }
 
+func TestTargetGroupPolicyServices(t *testing.T) {
+	k8sSchema := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sSchema)
+	anv1alpha1.Install(k8sSchema)
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).WithObjects(
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "svc1", Namespace: "ns1", Labels: map[string]string{"app": "a"}}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "svc2", Namespace: "ns1", Labels: map[string]string{"app": "b"}}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "svc3", Namespace: "ns2", Labels: map[string]string{"app": "a"}}},
+		&anv1alpha1.ServiceExport{ObjectMeta: metav1.ObjectMeta{Name: "svc2", Namespace: "ns1", Labels: map[string]string{"app": "a"}}},
+	).Build()
+	mapper := &resourceMapper{log: gwlog.FallbackLogger, client: k8sClient}
+
+	testCases := []struct {
+		selector *anv1alpha1.TargetSelector
+		expected []string
+	}{
+		{
+			selector: nil,
+			expected: nil,
+		},
+		{
+			selector: &anv1alpha1.TargetSelector{Kind: "Service", MatchLabels: map[string]string{"app": "a"}},
+			expected: []string{"svc1"},
+		},
+		{
+			selector: &anv1alpha1.TargetSelector{Group: anv1alpha1.GroupName, Kind: "ServiceExport", MatchLabels: map[string]string{"app": "a"}},
+			expected: []string{"svc2"},
+		},
+		{
+			selector: &anv1alpha1.TargetSelector{Kind: "Gateway", MatchLabels: map[string]string{"app": "a"}},
+			expected: nil,
+		},
+	}
+
+	for i, tt := range testCases {
+		t.Run(fmt.Sprintf("TGPolicySelectedServices_%d", i), func(t *testing.T) {
+			svcs := mapper.TargetGroupPolicyServices(context.Background(), &anv1alpha1.TargetGroupPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-policy", Namespace: "ns1"},
+				Spec:       anv1alpha1.TargetGroupPolicySpec{TargetSelector: tt.selector},
+			})
+			var names []string
+			for _, svc := range svcs {
+				names = append(names, svc.Name)
+			}
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+}
+
 func TestVpcAssociationPolicyToGateway(t *testing.T) {
 	c := gomock.NewController(t)
 	defer c.Finish()
@@ -454,3 +518,173 @@ func TestServiceToServiceExport(t *testing.T) {
 		})
 	}
 }
+
+func TestFixedResponseToRoutes(t *testing.T) {
+	ctx := context.Background()
+
+	k8sScheme := runtime.NewScheme()
+	gwv1.Install(k8sScheme)
+	anv1alpha1.Install(k8sScheme)
+
+	withFilter := func(route gwv1.HTTPRoute, group, kind, name string) gwv1.HTTPRoute {
+		route.Spec.Rules[0].Filters = []gwv1.HTTPRouteFilter{
+			{
+				Type: gwv1.HTTPRouteFilterExtensionRef,
+				ExtensionRef: &gwv1.LocalObjectReference{
+					Group: gwv1.Group(group),
+					Kind:  gwv1.Kind(kind),
+					Name:  gwv1.ObjectName(name),
+				},
+			},
+		}
+		return route
+	}
+	backendRef := gwv1.BackendObjectReference{Name: "test-service"}
+	routes := []gwv1.HTTPRoute{
+		withFilter(createHTTPRoute("valid", "ns1", backendRef), anv1alpha1.GroupName, anv1alpha1.FixedResponseKind, "maintenance"),
+		withFilter(createHTTPRoute("invalid-name", "ns1", backendRef), anv1alpha1.GroupName, anv1alpha1.FixedResponseKind, "other"),
+		withFilter(createHTTPRoute("invalid-kind", "ns1", backendRef), "example.com", "Other", "maintenance"),
+		withFilter(createHTTPRoute("invalid-namespace", "ns2", backendRef), anv1alpha1.GroupName, anv1alpha1.FixedResponseKind, "maintenance"),
+		createHTTPRoute("invalid-no-filter", "ns1", backendRef),
+	}
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sScheme).Build()
+	for i := range routes {
+		assert.NoError(t, k8sClient.Create(ctx, &routes[i]))
+	}
+
+	mapper := &resourceMapper{log: gwlog.FallbackLogger, client: k8sClient}
+	res := mapper.FixedResponseToRoutes(ctx, &anv1alpha1.FixedResponse{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "maintenance",
+			Namespace: "ns1",
+		},
+	})
+	assert.Len(t, res, 1)
+	assert.Equal(t, "valid", res[0].Name())
+}
+
+func TestLambdaFunctionToRoutes(t *testing.T) {
+	ctx := context.Background()
+
+	k8sScheme := runtime.NewScheme()
+	gwv1.Install(k8sScheme)
+	anv1alpha1.Install(k8sScheme)
+
+	lambdaRef := func(group, kind, name string, namespace *gwv1.Namespace) gwv1.BackendObjectReference {
+		g := gwv1.Group(group)
+		k := gwv1.Kind(kind)
+		return gwv1.BackendObjectReference{Group: &g, Kind: &k, Name: gwv1.ObjectName(name), Namespace: namespace}
+	}
+	ns1 := gwv1.Namespace("ns1")
+	routes := []gwv1.HTTPRoute{
+		createHTTPRoute("valid", "ns1", lambdaRef(anv1alpha1.GroupName, anv1alpha1.LambdaFunctionKind, "hello", nil)),
+		createHTTPRoute("valid-cross-namespace", "ns2", lambdaRef(anv1alpha1.GroupName, anv1alpha1.LambdaFunctionKind, "hello", &ns1)),
+		createHTTPRoute("invalid-name", "ns1", lambdaRef(anv1alpha1.GroupName, anv1alpha1.LambdaFunctionKind, "other", nil)),
+		createHTTPRoute("invalid-kind", "ns1", lambdaRef(anv1alpha1.GroupName, "ServiceImport", "hello", nil)),
+		createHTTPRoute("invalid-namespace", "ns2", lambdaRef(anv1alpha1.GroupName, anv1alpha1.LambdaFunctionKind, "hello", nil)),
+	}
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sScheme).Build()
+	for i := range routes {
+		assert.NoError(t, k8sClient.Create(ctx, &routes[i]))
+	}
+
+	mapper := &resourceMapper{log: gwlog.FallbackLogger, client: k8sClient}
+	res := mapper.LambdaFunctionToRoutes(ctx, &anv1alpha1.LambdaFunction{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hello",
+			Namespace: "ns1",
+		},
+	})
+	var names []string
+	for _, route := range res {
+		names = append(names, route.Name())
+	}
+	assert.ElementsMatch(t, []string{"valid", "valid-cross-namespace"}, names)
+}
+
+func TestHeaderMatchFilterToRoutes(t *testing.T) {
+	ctx := context.Background()
+
+	k8sScheme := runtime.NewScheme()
+	gwv1.Install(k8sScheme)
+	anv1alpha1.Install(k8sScheme)
+
+	filterRef := &gwv1.LocalObjectReference{
+		Group: anv1alpha1.GroupName,
+		Kind:  anv1alpha1.HeaderMatchFilterKind,
+		Name:  "case-insensitive",
+	}
+	backendRef := gwv1.BackendObjectReference{Name: "test-service"}
+	httpRoute := createHTTPRoute("http-valid", "ns1", backendRef)
+	httpRoute.Spec.Rules[0].Filters = []gwv1.HTTPRouteFilter{
+		{Type: gwv1.HTTPRouteFilterExtensionRef, ExtensionRef: filterRef},
+	}
+	grpcRoute := gwv1.GRPCRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "grpc-valid", Namespace: "ns1"},
+		Spec: gwv1.GRPCRouteSpec{
+			Rules: []gwv1.GRPCRouteRule{
+				{
+					Filters: []gwv1.GRPCRouteFilter{
+						{Type: gwv1.GRPCRouteFilterExtensionRef, ExtensionRef: filterRef},
+					},
+				},
+			},
+		},
+	}
+	otherNamespace := httpRoute.DeepCopy()
+	otherNamespace.Name = "http-invalid-namespace"
+	otherNamespace.Namespace = "ns2"
+	noFilter := createHTTPRoute("http-invalid-no-filter", "ns1", backendRef)
+
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sScheme).Build()
+	for _, route := range []client.Object{&httpRoute, &grpcRoute, otherNamespace, &noFilter} {
+		assert.NoError(t, k8sClient.Create(ctx, route))
+	}
+
+	mapper := &resourceMapper{log: gwlog.FallbackLogger, client: k8sClient}
+	headerMatchFilter := &anv1alpha1.HeaderMatchFilter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "case-insensitive",
+			Namespace: "ns1",
+		},
+	}
+
+	res := mapper.HeaderMatchFilterToRoutes(ctx, headerMatchFilter, core.HttpRouteType)
+	assert.Len(t, res, 1)
+	assert.Equal(t, "http-valid", res[0].Name())
+
+	res = mapper.HeaderMatchFilterToRoutes(ctx, headerMatchFilter, core.GrpcRouteType)
+	assert.Len(t, res, 1)
+	assert.Equal(t, "grpc-valid", res[0].Name())
+}
+
+func TestNodeToTargetGroupPolicies(t *testing.T) {
+	ctx := context.Background()
+
+	k8sScheme := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sScheme)
+	anv1alpha1.Install(k8sScheme)
+
+	tgp := func(name, namespace string, targetType *string) *anv1alpha1.TargetGroupPolicy {
+		return &anv1alpha1.TargetGroupPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: anv1alpha1.TargetGroupPolicySpec{
+				TargetRef:  &gwv1alpha2.NamespacedPolicyTargetReference{Kind: "Service", Name: gwv1.ObjectName(name)},
+				TargetType: targetType,
+			},
+		}
+	}
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sScheme).Build()
+	assert.NoError(t, k8sClient.Create(ctx, tgp("instance", "ns1", ptr.To("INSTANCE"))))
+	assert.NoError(t, k8sClient.Create(ctx, tgp("instance-other-namespace", "ns2", ptr.To("INSTANCE"))))
+	assert.NoError(t, k8sClient.Create(ctx, tgp("ip", "ns1", ptr.To("IP"))))
+	assert.NoError(t, k8sClient.Create(ctx, tgp("default", "ns1", nil)))
+
+	mapper := &resourceMapper{log: gwlog.FallbackLogger, client: k8sClient}
+	res := mapper.NodeToTargetGroupPolicies(ctx, &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}})
+	var names []string
+	for _, policy := range res {
+		names = append(names, policy.Name)
+	}
+	assert.ElementsMatch(t, []string{"instance", "instance-other-namespace"}, names)
+}
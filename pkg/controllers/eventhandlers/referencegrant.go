@@ -0,0 +1,48 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/aws/aws-application-networking-k8s/pkg/model/core"
+	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/aws/aws-application-networking-k8s/pkg/k8s"
+)
+
+type referenceGrantEventHandler struct {
+	log    gwlog.Logger
+	client client.Client
+	mapper *resourceMapper
+}
+
+func NewReferenceGrantEventHandler(log gwlog.Logger, client client.Client) *referenceGrantEventHandler {
+	return &referenceGrantEventHandler{
+		log:    log,
+		client: client,
+		mapper: &resourceMapper{log: log, client: client},
+	}
+}
+
+func (h *referenceGrantEventHandler) MapToRoute(routeType core.RouteType) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		return h.mapToRoute(ctx, obj, routeType)
+	})
+}
+
+func (h *referenceGrantEventHandler) mapToRoute(ctx context.Context, obj client.Object, routeType core.RouteType) []reconcile.Request {
+	routes := h.mapper.ReferenceGrantToRoutes(ctx, obj.(*gwv1beta1.ReferenceGrant), routeType)
+
+	var requests []reconcile.Request
+	for _, route := range routes {
+		routeName := k8s.NamespacedName(route.K8sObject())
+		requests = append(requests, reconcile.Request{NamespacedName: routeName})
+		h.log.Infow(ctx, "ReferenceGrant resource change triggered Route update",
+			"referenceGrantName", obj.GetNamespace()+"/"+obj.GetName(), "routeName", routeName, "routeType", routeType)
+	}
+	return requests
+}
@@ -0,0 +1,73 @@
+package eventhandlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/aws/aws-application-networking-k8s/pkg/model/core"
+	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
+)
+
+func TestReferenceGrantEventHandler_MapToRoute(t *testing.T) {
+	ctx := context.Background()
+
+	k8sScheme := runtime.NewScheme()
+	gwv1.Install(k8sScheme)
+	gwv1beta1.Install(k8sScheme)
+
+	routes := []gwv1.HTTPRoute{
+		createHTTPRoute("valid-cross-namespace", "ns1", gwv1.BackendObjectReference{
+			Kind:      (*gwv1.Kind)(ptr.To("Service")),
+			Namespace: (*gwv1.Namespace)(ptr.To("backend-ns")),
+			Name:      "test-service",
+		}),
+		createHTTPRoute("invalid-same-namespace", "ns1", gwv1.BackendObjectReference{
+			Kind: (*gwv1.Kind)(ptr.To("Service")),
+			Name: "test-service",
+		}),
+		createHTTPRoute("invalid-other-backend-namespace", "ns1", gwv1.BackendObjectReference{
+			Kind:      (*gwv1.Kind)(ptr.To("Service")),
+			Namespace: (*gwv1.Namespace)(ptr.To("other-ns")),
+			Name:      "test-service",
+		}),
+		createHTTPRoute("invalid-route-namespace", "ns2", gwv1.BackendObjectReference{
+			Kind:      (*gwv1.Kind)(ptr.To("Service")),
+			Namespace: (*gwv1.Namespace)(ptr.To("backend-ns")),
+			Name:      "test-service",
+		}),
+	}
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sScheme).Build()
+	for i := range routes {
+		assert.NoError(t, k8sClient.Create(ctx, &routes[i]))
+	}
+
+	h := NewReferenceGrantEventHandler(gwlog.FallbackLogger, k8sClient)
+	grant := &gwv1beta1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "grant",
+			Namespace: "backend-ns",
+		},
+		Spec: gwv1beta1.ReferenceGrantSpec{
+			From: []gwv1beta1.ReferenceGrantFrom{
+				{Group: gwv1.GroupName, Kind: "HTTPRoute", Namespace: "ns1"},
+				{Group: gwv1.GroupName, Kind: "GRPCRoute", Namespace: "ns2"},
+			},
+			To: []gwv1beta1.ReferenceGrantTo{
+				{Group: "", Kind: "Service"},
+			},
+		},
+	}
+
+	reqs := h.mapToRoute(ctx, grant, core.HttpRouteType)
+	assert.Len(t, reqs, 1)
+	assert.Equal(t, "valid-cross-namespace", reqs[0].Name)
+	assert.Equal(t, "ns1", reqs[0].Namespace)
+}
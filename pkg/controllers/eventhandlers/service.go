@@ -5,18 +5,28 @@ import (
 	"github.com/aws/aws-application-networking-k8s/pkg/apis/applicationnetworking/v1alpha1"
 	"github.com/aws/aws-application-networking-k8s/pkg/k8s"
 	"github.com/aws/aws-application-networking-k8s/pkg/model/core"
+	model "github.com/aws/aws-application-networking-k8s/pkg/model/lattice"
 	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
 	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
+// TargetsFastPath keeps the targets of services in sync with their endpoint changes, without full reconciles
+type TargetsFastPath interface {
+	// Enqueue takes the endpoint changes of a service for its target groups of the given source type,
+	// and reports whether it keeps any of them in sync
+	Enqueue(ctx context.Context, svc types.NamespacedName, sourceType model.K8SSourceType) bool
+}
+
 type serviceEventHandler struct {
-	log    gwlog.Logger
-	client client.Client
-	mapper *resourceMapper
+	log      gwlog.Logger
+	client   client.Client
+	mapper   *resourceMapper
+	fastPath TargetsFastPath
 }
 
 func NewServiceEventHandler(log gwlog.Logger, client client.Client) *serviceEventHandler {
@@ -24,6 +34,13 @@ func NewServiceEventHandler(log gwlog.Logger, client client.Client) *serviceEven
 		mapper: &resourceMapper{log: log, client: client}}
 }
 
+// WithTargetsFastPath hands the EndpointSlice changes of the services whose targets are kept in sync by
+// the fast path over to it, instead of mapping them to routes and service exports
+func (h *serviceEventHandler) WithTargetsFastPath(fastPath TargetsFastPath) *serviceEventHandler {
+	h.fastPath = fastPath
+	return h
+}
+
 func (h *serviceEventHandler) MapToRoute(routeType core.RouteType) handler.EventHandler {
 	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
 		return h.mapToRoute(ctx, obj, routeType)
@@ -39,8 +56,25 @@ func (h *serviceEventHandler) MapToServiceExport() handler.EventHandler {
 func (h *serviceEventHandler) mapToServiceExport(ctx context.Context, obj client.Object) []reconcile.Request {
 	var requests []reconcile.Request
 
+	if h.enqueueTargetsFastPath(ctx, obj, model.SourceTypeSvcExport) {
+		return requests
+	}
+
+	if node, ok := obj.(*corev1.Node); ok {
+		for _, tgp := range h.mapper.NodeToTargetGroupPolicies(ctx, node) {
+			requests = append(requests, h.mapToServiceExport(ctx, tgp)...)
+		}
+		return requests
+	}
+
 	// Handle TargetGroupPolicy changes more directly for ServiceExport
 	if tgp, ok := obj.(*v1alpha1.TargetGroupPolicy); ok {
+		if !isServiceTargetGroupPolicy(tgp) {
+			for _, svc := range h.mapper.TargetGroupPolicyServices(ctx, tgp) {
+				requests = append(requests, h.mapToServiceExport(ctx, svc)...)
+			}
+			return requests
+		}
 		requests = h.mapTargetGroupPolicyToServiceExport(ctx, tgp)
 		if len(requests) > 0 {
 			return requests
@@ -119,10 +153,32 @@ func (h *serviceEventHandler) mapTargetGroupPolicyToServiceExport(ctx context.Co
 }
 
 func (h *serviceEventHandler) mapToRoute(ctx context.Context, obj client.Object, routeType core.RouteType) []reconcile.Request {
+	var requests []reconcile.Request
+
+	if h.enqueueTargetsFastPath(ctx, obj, routeSourceType(routeType)) {
+		return requests
+	}
+
+	if node, ok := obj.(*corev1.Node); ok {
+		for _, tgp := range h.mapper.NodeToTargetGroupPolicies(ctx, node) {
+			requests = append(requests, h.mapToRoute(ctx, tgp, routeType)...)
+		}
+		return requests
+	}
+
+	if tgp, ok := obj.(*v1alpha1.TargetGroupPolicy); ok && !isServiceTargetGroupPolicy(tgp) {
+		for _, svc := range h.mapper.TargetGroupPolicyServices(ctx, tgp) {
+			requests = append(requests, h.mapToRoute(ctx, svc, routeType)...)
+		}
+		for _, route := range h.mapper.TargetGroupPolicyToRoutes(ctx, tgp, routeType) {
+			requests = append(requests, reconcile.Request{NamespacedName: k8s.NamespacedName(route.K8sObject())})
+		}
+		return requests
+	}
+
 	svc := h.mapToService(ctx, obj)
 	routes := h.mapper.ServiceToRoutes(ctx, svc, routeType)
 
-	var requests []reconcile.Request
 	for _, route := range routes {
 		routeName := k8s.NamespacedName(route.K8sObject())
 		requests = append(requests, reconcile.Request{NamespacedName: routeName})
@@ -131,3 +187,34 @@ func (h *serviceEventHandler) mapToRoute(ctx context.Context, obj client.Object,
 	}
 	return requests
 }
+
+// enqueueTargetsFastPath hands an EndpointSlice change over to the targets fast path, and reports whether the
+// fast path keeps the targets of its service in sync, leaving nothing to reconcile
+func (h *serviceEventHandler) enqueueTargetsFastPath(ctx context.Context, obj client.Object, sourceType model.K8SSourceType) bool {
+	epSlice, ok := obj.(*discoveryv1.EndpointSlice)
+	if !ok || h.fastPath == nil {
+		return false
+	}
+	svcName, ok := epSlice.Labels[discoveryv1.LabelServiceName]
+	if !ok {
+		return false
+	}
+	svc := types.NamespacedName{Namespace: epSlice.Namespace, Name: svcName}
+	if !h.fastPath.Enqueue(ctx, svc, sourceType) {
+		return false
+	}
+	h.log.Debugw(ctx, "EndpointSlice change handed over to targets fast path",
+		"serviceName", svc.String(), "sourceType", sourceType)
+	return true
+}
+
+func routeSourceType(routeType core.RouteType) model.K8SSourceType {
+	switch routeType {
+	case core.GrpcRouteType:
+		return model.SourceTypeGRPCRoute
+	case core.TlsRouteType:
+		return model.SourceTypeTLSRoute
+	default:
+		return model.SourceTypeHTTPRoute
+	}
+}
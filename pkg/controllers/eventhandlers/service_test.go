@@ -18,6 +18,7 @@ import (
 	mock_client "github.com/aws/aws-application-networking-k8s/mocks/controller-runtime/client"
 	anv1alpha1 "github.com/aws/aws-application-networking-k8s/pkg/apis/applicationnetworking/v1alpha1"
 	"github.com/aws/aws-application-networking-k8s/pkg/model/core"
+	model "github.com/aws/aws-application-networking-k8s/pkg/model/lattice"
 	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
 )
 
@@ -276,3 +277,65 @@ func TestServiceEventHandler_MapTargetGroupPolicyToServiceExport(t *testing.T) {
 		})
 	}
 }
+
+type testTargetsFastPath struct {
+	tracked  map[types.NamespacedName]model.K8SSourceType
+	enqueued []types.NamespacedName
+}
+
+func (f *testTargetsFastPath) Enqueue(ctx context.Context, svc types.NamespacedName, sourceType model.K8SSourceType) bool {
+	if f.tracked[svc] != sourceType {
+		return false
+	}
+	f.enqueued = append(f.enqueued, svc)
+	return true
+}
+
+func TestServiceEventHandler_TargetsFastPath(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+
+	mockClient := mock_client.NewMockClient(c)
+	mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, name types.NamespacedName, svcOrSvcExport client.Object, _ ...interface{}) error {
+			svcOrSvcExport.SetName(name.Name)
+			svcOrSvcExport.SetNamespace(name.Namespace)
+			return nil
+		},
+	).AnyTimes()
+
+	fastPath := &testTargetsFastPath{
+		tracked: map[types.NamespacedName]model.K8SSourceType{
+			{Namespace: "ns1", Name: "fast-service"}: model.SourceTypeSvcExport,
+		},
+	}
+	h := NewServiceEventHandler(gwlog.FallbackLogger, mockClient).WithTargetsFastPath(fastPath)
+
+	epSlice := func(svcName string) *discoveryv1.EndpointSlice {
+		return &discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      svcName + "-abc",
+				Namespace: "ns1",
+				Labels: map[string]string{
+					discoveryv1.LabelServiceName: svcName,
+				},
+			},
+		}
+	}
+
+	// endpoint changes of tracked services are handed over to the fast path
+	reqs := h.mapToServiceExport(context.Background(), epSlice("fast-service"))
+	assert.Empty(t, reqs)
+	assert.Equal(t, []types.NamespacedName{{Namespace: "ns1", Name: "fast-service"}}, fastPath.enqueued)
+
+	// the others still trigger a full reconcile
+	reqs = h.mapToServiceExport(context.Background(), epSlice("slow-service"))
+	assert.Len(t, reqs, 1)
+
+	// as do the changes of services themselves
+	reqs = h.mapToServiceExport(context.Background(), &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "fast-service", Namespace: "ns1"},
+	})
+	assert.Len(t, reqs, 1)
+	assert.Len(t, fastPath.enqueued, 1)
+}
@@ -20,6 +20,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"reflect"
+	"time"
 
 	anv1alpha1 "github.com/aws/aws-application-networking-k8s/pkg/apis/applicationnetworking/v1alpha1"
 	"github.com/aws/aws-application-networking-k8s/pkg/aws/services"
@@ -28,15 +31,22 @@ import (
 	"github.com/aws/aws-application-networking-k8s/pkg/aws"
 	"github.com/aws/aws-application-networking-k8s/pkg/config"
 	"github.com/aws/aws-application-networking-k8s/pkg/k8s"
+	policy "github.com/aws/aws-application-networking-k8s/pkg/k8s/policyhelper"
 	"github.com/aws/aws-application-networking-k8s/pkg/model/core"
 	lattice_runtime "github.com/aws/aws-application-networking-k8s/pkg/runtime"
 	"github.com/aws/aws-application-networking-k8s/pkg/utils"
 	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
 
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	vpclatticetypes "github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
 	pkgerrors "github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/cache"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -54,6 +64,12 @@ const (
 	defaultNamespace = "default"
 )
 
+// provisionedConfigCacheTTL bounds how long the VPC association applied to a provisioned service network is trusted
+// without listing the associations of the service network again. It only spares the bursts of Gateway reconciles
+// caused by status updates, and is shorter than the minimum periodic resync interval of 60 seconds, so that every
+// periodic resync repairs the association
+const provisionedConfigCacheTTL = 45 * time.Second
+
 type gatewayReconciler struct {
 	log              gwlog.Logger
 	client           client.Client
@@ -61,6 +77,11 @@ type gatewayReconciler struct {
 	finalizerManager k8s.FinalizerManager
 	eventRecorder    record.EventRecorder
 	cloud            aws.Cloud
+	snManager        deploy.ServiceNetworkManager
+	iamAuthPolicies  *policy.PolicyHandler[*IAP]
+	vpcAssociations  *policy.PolicyHandler[*VAP]
+	// appliedProvisionedConfigs holds the configuration last applied to each provisioned service network, by ARN
+	appliedProvisionedConfigs *cache.Expiring
 }
 
 func RegisterGatewayController(
@@ -80,12 +101,16 @@ func RegisterGatewayController(
 		finalizerManager: finalizerManager,
 		eventRecorder:    evtRec,
 		cloud:            cloud,
+		snManager:        deploy.NewDefaultServiceNetworkManager(log, cloud),
+		iamAuthPolicies:  policy.NewIAMAuthPolicyHandler(log, mgrClient),
+		vpcAssociations:  policy.NewVpcAssociationPolicyHandler(log, mgrClient),
+
+		appliedProvisionedConfigs: cache.NewExpiring(),
 	}
 
 	if config.DefaultServiceNetwork != "" {
 		// Attempt creation of default service network, move gracefully even if it fails.
-		snManager := deploy.NewDefaultServiceNetworkManager(log, cloud)
-		_, err := snManager.CreateOrUpdate(context.Background(), &model.ServiceNetwork{
+		_, err := r.snManager.CreateOrUpdate(context.Background(), &model.ServiceNetwork{
 			Spec: model.ServiceNetworkSpec{
 				Name: config.DefaultServiceNetwork,
 			},
@@ -112,12 +137,25 @@ func RegisterGatewayController(
 	} else {
 		log.Infof(context.TODO(), "VpcAssociationPolicy CRD is not installed, skipping watch")
 	}
+
+	//Watch GatewayClassConfig CRD if it is installed
+	ok, err = k8s.IsGVKSupported(mgr, anv1alpha1.GroupVersion.String(), anv1alpha1.GatewayClassConfigKind)
+	if err != nil {
+		return err
+	}
+	if ok {
+		gccEventHandler := eventhandlers.NewGatewayClassConfigEventHandler(log, mgrClient)
+		builder.Watches(&anv1alpha1.GatewayClassConfig{}, gccEventHandler.MapToGateway())
+	} else {
+		log.Infof(context.TODO(), "GatewayClassConfig CRD is not installed, skipping watch")
+	}
 	return builder.Complete(r)
 }
 
 //+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways/finalizers,verbs=update
+//+kubebuilder:rbac:groups=application-networking.k8s.aws,resources=gatewayclassconfigs,verbs=get;list;watch
 
 func (r *gatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	ctx = gwlog.StartReconcileTrace(ctx, r.log, "gateway", req.Name, req.Namespace)
@@ -185,6 +223,10 @@ func (r *gatewayReconciler) reconcileDelete(ctx context.Context, gw *gwv1.Gatewa
 		}
 	}
 
+	if err = r.deprovisionServiceNetwork(ctx, gw); err != nil {
+		return err
+	}
+
 	err = r.finalizerManager.RemoveFinalizers(ctx, gw, gatewayFinalizer)
 	if err != nil {
 		return err
@@ -213,8 +255,19 @@ func (r *gatewayReconciler) reconcileUpsert(ctx context.Context, gw *gwv1.Gatewa
 		return err
 	}
 
+	gcc, err := r.gatewayClassConfig(ctx, gw)
+	if err != nil {
+		return err
+	}
+
 	snInfo, err := r.cloud.Lattice().FindServiceNetwork(ctx, gw.Name)
 	if err != nil {
+		if services.IsNotFoundError(err) && autoProvisions(gcc) {
+			return r.provisionServiceNetwork(ctx, gw, gcc)
+		}
+		if services.IsNotFoundError(err) && gw.Annotations[k8s.ServiceNetworkArnAnnotation] != "" {
+			return r.recreateServiceNetwork(ctx, gw)
+		}
 		if services.IsNotFoundError(err) {
 			if err = r.updateGatewayProgrammedStatus(ctx, gw, gwv1.GatewayReasonPending, "VPC Lattice Service Network not found"); err != nil {
 				return lattice_runtime.NewRetryError()
@@ -230,6 +283,10 @@ func (r *gatewayReconciler) reconcileUpsert(ctx context.Context, gw *gwv1.Gatewa
 		return err
 	}
 
+	if err = r.repairServiceNetworkDrift(ctx, gw, gcc, snInfo); err != nil {
+		return err
+	}
+
 	err = r.updateGatewayProgrammedStatus(ctx, gw, gwv1.GatewayReasonProgrammed, fmt.Sprintf("aws-service-network-arn: %s", *snInfo.SvcNetwork.Arn))
 	if err != nil {
 		return err
@@ -238,6 +295,297 @@ func (r *gatewayReconciler) reconcileUpsert(ctx context.Context, gw *gwv1.Gatewa
 	return nil
 }
 
+// provisionServiceNetwork creates the service network of a Gateway whose GatewayClass auto-provisions them. Recording
+// its ARN makes the routes of the Gateway associate their services with it
+func (r *gatewayReconciler) provisionServiceNetwork(ctx context.Context, gw *gwv1.Gateway, gcc *anv1alpha1.GatewayClassConfig) error {
+	managed, err := r.hasServiceNetworkResource(ctx, gw)
+	if err != nil || managed {
+		// the ServiceNetwork controller creates the service networks it manages
+		return err
+	}
+
+	recreated := gw.Annotations[k8s.ServiceNetworkArnAnnotation] != ""
+	tags := services.Tags{}
+	maps.Copy(tags, gcc.Spec.Tags)
+	maps.Copy(tags, provisionedServiceNetworkTags(gw))
+	status, err := r.snManager.Upsert(ctx, gw.Name, tags)
+	if err != nil {
+		return fmt.Errorf("failed to provision ServiceNetwork %s: %w", gw.Name, err)
+	}
+	if err = r.recordServiceNetworkArn(ctx, gw, status.ServiceNetworkARN); err != nil {
+		return err
+	}
+	if recreated {
+		r.recordDriftRepair(gw, deploy.ServiceNetworkDriftRecreated)
+	} else {
+		r.eventRecorder.Event(gw, corev1.EventTypeNormal, k8s.GatewayEventReasonProvisioned,
+			fmt.Sprintf("Provisioned ServiceNetwork %s", gw.Name))
+	}
+	if err = r.updateGatewayProgrammedStatus(ctx, gw, gwv1.GatewayReasonPending, "VPC Lattice Service Network is being provisioned"); err != nil {
+		return err
+	}
+
+	// the auth type and VPC association are configured on the next reconcile
+	return lattice_runtime.NewRetryError()
+}
+
+// deprovisionServiceNetwork deletes the service network provisioned for a Gateway along with it, unless services
+// are still associated with it. The provisioning tags of the service network tell whether it was provisioned, so it is
+// cleaned up even when its GatewayClassConfig was deleted or stopped auto-provisioning since
+func (r *gatewayReconciler) deprovisionServiceNetwork(ctx context.Context, gw *gwv1.Gateway) error {
+	managed, err := r.hasServiceNetworkResource(ctx, gw)
+	if err != nil || managed {
+		return err
+	}
+
+	inUse, err := r.snManager.DeleteProvisioned(ctx, gw.Name, provisionedServiceNetworkTags(gw))
+	if err != nil {
+		return fmt.Errorf("failed to delete ServiceNetwork %s: %w", gw.Name, err)
+	}
+	if inUse {
+		r.log.Infof(ctx, "ServiceNetwork %s of Gateway %s/%s is still in use, leaving it in place",
+			gw.Name, gw.Namespace, gw.Name)
+		r.eventRecorder.Event(gw, corev1.EventTypeNormal, k8s.GatewayEventReasonRetained,
+			fmt.Sprintf("ServiceNetwork %s is still in use, leaving it in place", gw.Name))
+	}
+	return nil
+}
+
+// upsertProvisionedVpcAssociation associates the service network of a Gateway whose GatewayClass auto-provisions them
+// with the cluster VPC, unless a VpcAssociationPolicy manages that association. The configuration is only applied
+// when it differs from the one last applied, or that one expired
+func (r *gatewayReconciler) upsertProvisionedVpcAssociation(ctx context.Context, gw *gwv1.Gateway, gcc *anv1alpha1.GatewayClassConfig,
+	sn model.ServiceNetworkStatus) error {
+	vap, err := r.vpcAssociations.ObjResolvedPolicy(ctx, gw)
+	if err != nil || vap != nil {
+		return err
+	}
+
+	cfg := &model.ServiceNetworkConfig{
+		Name:           gw.Name,
+		AdditionalTags: gcc.Spec.Tags,
+	}
+	if gcc.Spec.AssociateWithVpc == nil || *gcc.Spec.AssociateWithVpc {
+		sgIds := make([]string, len(gcc.Spec.SecurityGroupIds))
+		for i, sgId := range gcc.Spec.SecurityGroupIds {
+			sgIds[i] = string(sgId)
+		}
+		cfg.VpcAssociations = []model.ServiceNetworkVpcAssociation{{VpcId: config.VpcID, SecurityGroupIds: sgIds}}
+	}
+	if applied, ok := r.appliedProvisionedConfigs.Get(sn.ServiceNetworkARN); ok && reflect.DeepEqual(applied, cfg) {
+		return nil
+	}
+	if _, err = r.snManager.UpsertConfig(ctx, sn, cfg); err != nil {
+		return err
+	}
+	r.appliedProvisionedConfigs.Set(sn.ServiceNetworkARN, cfg, provisionedConfigCacheTTL)
+	return nil
+}
+
+// gatewayClassConfig returns the GatewayClassConfig of the GatewayClass of the Gateway, or nil when it has none.
+// Invalid parametersRef are reported on the GatewayClass
+func (r *gatewayReconciler) gatewayClassConfig(ctx context.Context, gw *gwv1.Gateway) (*anv1alpha1.GatewayClassConfig, error) {
+	gwClass := &gwv1.GatewayClass{}
+	if err := r.client.Get(ctx, client.ObjectKey{Name: string(gw.Spec.GatewayClassName)}, gwClass); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	gcc, err := getGatewayClassConfig(ctx, r.client, gwClass)
+	if err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) || errors.Is(err, UnsupportedParametersRef) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return gcc, nil
+}
+
+func autoProvisions(gcc *anv1alpha1.GatewayClassConfig) bool {
+	return gcc != nil && gcc.Spec.AutoProvisionServiceNetwork
+}
+
+// provisionedServiceNetworkTags identifies the service network provisioned for a Gateway
+func provisionedServiceNetworkTags(gw *gwv1.Gateway) services.Tags {
+	return services.Tags{
+		model.K8SGatewayNameKey:      gw.Name,
+		model.K8SGatewayNamespaceKey: gw.Namespace,
+	}
+}
+
+// recreateServiceNetwork recreates the service network of the Gateway deleted out of band, along with its association
+// with the cluster VPC. Recording the new ARN makes the routes of the Gateway associate their services again
+func (r *gatewayReconciler) recreateServiceNetwork(ctx context.Context, gw *gwv1.Gateway) error {
+	managed, err := r.hasServiceNetworkResource(ctx, gw)
+	if err != nil || managed {
+		// the ServiceNetwork controller recreates the service networks it manages
+		return err
+	}
+
+	r.log.Infof(ctx, "ServiceNetwork %s of Gateway %s/%s was deleted out of band, recreating it",
+		gw.Name, gw.Namespace, gw.Name)
+	status, err := r.snManager.Upsert(ctx, gw.Name, nil)
+	if err != nil {
+		return fmt.Errorf("failed to recreate ServiceNetwork %s: %w", gw.Name, err)
+	}
+	if err = r.recreateVpcAssociation(ctx, gw); err != nil {
+		return fmt.Errorf("failed to recreate VPC association of ServiceNetwork %s: %w", gw.Name, err)
+	}
+	if err = r.recordServiceNetworkArn(ctx, gw, status.ServiceNetworkARN); err != nil {
+		return err
+	}
+	r.recordDriftRepair(gw, deploy.ServiceNetworkDriftRecreated)
+
+	// the desired auth type is restored on the next reconcile
+	return lattice_runtime.NewRetryError()
+}
+
+// recreateVpcAssociation associates the recreated service network of the Gateway with the cluster VPC, with the
+// security groups and tags of the VpcAssociationPolicy of the Gateway. Without policy, it has no security groups
+func (r *gatewayReconciler) recreateVpcAssociation(ctx context.Context, gw *gwv1.Gateway) error {
+	vap, err := r.vpcAssociations.ObjResolvedPolicy(ctx, gw)
+	if err != nil {
+		return err
+	}
+	var sgIds []string
+	var additionalTags services.Tags
+	if vap != nil {
+		if vap.Spec.AssociateWithVpc != nil && !*vap.Spec.AssociateWithVpc {
+			return nil
+		}
+		sgIds = utils.SliceMap(vap.Spec.SecurityGroupIds, func(sg anv1alpha1.SecurityGroupId) string {
+			return string(sg)
+		})
+		additionalTags = k8s.GetAdditionalTagsFromAnnotations(ctx, vap)
+	}
+	_, err = r.snManager.UpsertVpcAssociation(ctx, gw.Name, sgIds, additionalTags)
+	return err
+}
+
+// repairServiceNetworkDrift reverts the tags and auth type of the service network of the Gateway when owned by the
+// controller, and records its ARN to recreate it if deleted out of band
+func (r *gatewayReconciler) repairServiceNetworkDrift(ctx context.Context, gw *gwv1.Gateway, gcc *anv1alpha1.GatewayClassConfig,
+	snInfo *services.ServiceNetworkInfo) error {
+	snArn := awssdk.ToString(snInfo.SvcNetwork.Arn)
+	if parsedArn, err := arn.Parse(snArn); err != nil || parsedArn.AccountID != r.cloud.Config().AccountId {
+		// service networks shared from other accounts are not owned
+		return nil
+	}
+	managed, err := r.hasServiceNetworkResource(ctx, gw)
+	if err != nil || managed {
+		return err
+	}
+	owned, err := r.cloud.IsArnManaged(ctx, snArn)
+	if err != nil {
+		return err
+	}
+	// the ManagedBy tag itself may have been removed out of band
+	if !owned && gw.Annotations[k8s.ServiceNetworkArnAnnotation] != snArn {
+		return r.recordServiceNetworkArn(ctx, gw, "")
+	}
+	if err = r.recordServiceNetworkArn(ctx, gw, snArn); err != nil {
+		return err
+	}
+
+	authType, err := r.serviceNetworkAuthType(ctx, gw, gcc)
+	if err != nil {
+		return err
+	}
+	sn := model.ServiceNetworkStatus{
+		ServiceNetworkARN: snArn,
+		ServiceNetworkID:  awssdk.ToString(snInfo.SvcNetwork.Id),
+	}
+	repaired, err := r.snManager.RepairDrift(ctx, sn, authType)
+	for _, drift := range repaired {
+		r.recordDriftRepair(gw, drift)
+	}
+	if len(repaired) > 0 {
+		// the configuration is applied again to a service network changed out of band
+		r.appliedProvisionedConfigs.Delete(snArn)
+	}
+	if err != nil {
+		return err
+	}
+	if authType != "" {
+		if err = r.recordAnnotation(ctx, gw, k8s.ServiceNetworkAuthTypeAnnotation, authType); err != nil {
+			return err
+		}
+	}
+	if !autoProvisions(gcc) {
+		return nil
+	}
+	return r.upsertProvisionedVpcAssociation(ctx, gw, gcc, sn)
+}
+
+// serviceNetworkAuthType returns the auth type of the service network of the Gateway, or an empty string to leave it
+// as it is. AWS_IAM is only reverted to NONE when the controller set it and no IAMAuthPolicy targets the Gateway,
+// accepted or not
+func (r *gatewayReconciler) serviceNetworkAuthType(ctx context.Context, gw *gwv1.Gateway, gcc *anv1alpha1.GatewayClassConfig) (string, error) {
+	iap, err := r.iamAuthPolicies.ObjResolvedPolicy(ctx, gw)
+	if err != nil {
+		return "", err
+	}
+	if iap != nil {
+		return string(vpclatticetypes.AuthTypeAwsIam), nil
+	}
+	authType := string(vpclatticetypes.AuthTypeNone)
+	if autoProvisions(gcc) && gcc.Spec.AuthType != nil {
+		authType = *gcc.Spec.AuthType
+	}
+	if authType != string(vpclatticetypes.AuthTypeNone) {
+		return authType, nil
+	}
+	if gw.Annotations[k8s.ServiceNetworkAuthTypeAnnotation] != string(vpclatticetypes.AuthTypeAwsIam) {
+		return "", nil
+	}
+	iaps, err := r.iamAuthPolicies.ObjPolicies(ctx, gw)
+	if err != nil || len(iaps) > 0 {
+		return "", err
+	}
+	return authType, nil
+}
+
+// hasServiceNetworkResource tells whether the service network of the Gateway is managed by a ServiceNetwork resource
+func (r *gatewayReconciler) hasServiceNetworkResource(ctx context.Context, gw *gwv1.Gateway) (bool, error) {
+	sn := &anv1alpha1.ServiceNetwork{}
+	err := r.client.Get(ctx, client.ObjectKey{Name: gw.Name}, sn)
+	if err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *gatewayReconciler) recordServiceNetworkArn(ctx context.Context, gw *gwv1.Gateway, snArn string) error {
+	return r.recordAnnotation(ctx, gw, k8s.ServiceNetworkArnAnnotation, snArn)
+}
+
+// recordAnnotation sets an annotation of the Gateway, or removes it when value is empty
+func (r *gatewayReconciler) recordAnnotation(ctx context.Context, gw *gwv1.Gateway, key, value string) error {
+	if gw.Annotations[key] == value {
+		return nil
+	}
+	gwOld := gw.DeepCopy()
+	if value == "" {
+		delete(gw.Annotations, key)
+	} else {
+		if gw.Annotations == nil {
+			gw.Annotations = make(map[string]string)
+		}
+		gw.Annotations[key] = value
+	}
+	if err := r.client.Patch(ctx, gw, client.MergeFrom(gwOld)); err != nil {
+		return fmt.Errorf("failed to record annotation %s of gateway %s: %w", key, gw.Name, err)
+	}
+	return nil
+}
+
+func (r *gatewayReconciler) recordDriftRepair(gw *gwv1.Gateway, drift string) {
+	r.eventRecorder.Event(gw, corev1.EventTypeNormal, k8s.GatewayEventReasonDriftRepaired,
+		fmt.Sprintf("Repaired out-of-band change to ServiceNetwork %s: %s", gw.Name, drift))
+	serviceNetworkDriftRepairsTotal.WithLabelValues(gw.Name, drift).Inc()
+}
+
 func (r *gatewayReconciler) updateGatewayProgrammedStatus(
 	ctx context.Context,
 	gw *gwv1.Gateway,
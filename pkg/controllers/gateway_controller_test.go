@@ -4,16 +4,33 @@ import (
 	"context"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	vpclatticetypes "github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/cache"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
+	mock_client "github.com/aws/aws-application-networking-k8s/mocks/controller-runtime/client"
+	anv1alpha1 "github.com/aws/aws-application-networking-k8s/pkg/apis/applicationnetworking/v1alpha1"
+	pkg_aws "github.com/aws/aws-application-networking-k8s/pkg/aws"
+	"github.com/aws/aws-application-networking-k8s/pkg/aws/services"
+	"github.com/aws/aws-application-networking-k8s/pkg/config"
+	deploy "github.com/aws/aws-application-networking-k8s/pkg/deploy/lattice"
+	"github.com/aws/aws-application-networking-k8s/pkg/k8s"
+	policy "github.com/aws/aws-application-networking-k8s/pkg/k8s/policyhelper"
 	core "github.com/aws/aws-application-networking-k8s/pkg/model/core"
+	model "github.com/aws/aws-application-networking-k8s/pkg/model/lattice"
+	lattice_runtime "github.com/aws/aws-application-networking-k8s/pkg/runtime"
+	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
 )
 
 func TestUpdateGWListenerStatus_RemovesStaleListeners(t *testing.T) {
@@ -836,3 +853,668 @@ func TestUpdateGWListenerStatus_OverrideRoute(t *testing.T) {
 		})
 	}
 }
+
+func TestGatewayReconciler_RepairServiceNetworkDrift(t *testing.T) {
+	snArn := "arn:aws:vpc-lattice:us-west-2:123456789012:servicenetwork/sn-123"
+	iap := &anv1alpha1.IAMAuthPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "iap", Namespace: "default"},
+		Spec: anv1alpha1.IAMAuthPolicySpec{
+			TargetRef: &gwv1alpha2.NamespacedPolicyTargetReference{
+				Group: gwv1.GroupName,
+				Kind:  "Gateway",
+				Name:  "drift-gw",
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		gwAnnotations map[string]string
+		snArn         string
+		arnManaged    bool
+		expectRepair  bool
+	}{
+		{
+			name:         "drift of a service network created by the controller is repaired and its ARN recorded",
+			snArn:        snArn,
+			arnManaged:   true,
+			expectRepair: true,
+		},
+		{
+			name:          "once recorded, the service network is repaired even when its ManagedBy tag was removed",
+			gwAnnotations: map[string]string{k8s.ServiceNetworkArnAnnotation: snArn},
+			snArn:         snArn,
+			expectRepair:  true,
+		},
+		{
+			name:  "service networks not created by the controller are left alone",
+			snArn: snArn,
+		},
+		{
+			name:  "service networks shared from other accounts are left alone",
+			snArn: "arn:aws:vpc-lattice:us-west-2:111122223333:servicenetwork/sn-456",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			ctx := context.TODO()
+			gw := &gwv1.Gateway{ObjectMeta: metav1.ObjectMeta{
+				Name:        "drift-gw",
+				Namespace:   "default",
+				Annotations: tt.gwAnnotations,
+			}}
+			snInfo := &services.ServiceNetworkInfo{
+				SvcNetwork: vpclatticetypes.ServiceNetworkSummary{Arn: aws.String(tt.snArn), Id: aws.String("sn-123")},
+			}
+			k8sScheme := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sScheme)
+			gwv1.Install(k8sScheme)
+			anv1alpha1.Install(k8sScheme)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sScheme).WithRuntimeObjects(gw.DeepCopy(), iap).Build()
+			mockCloud := pkg_aws.NewMockCloud(c)
+			mockCloud.EXPECT().Config().Return(pkg_aws.CloudConfig{AccountId: "123456789012"}).AnyTimes()
+			mockSNManager := deploy.NewMockServiceNetworkManager(c)
+			mockEventRecorder := mock_client.NewMockEventRecorder(c)
+			mockEventRecorder.EXPECT().Event(gomock.Any(), corev1.EventTypeNormal, k8s.GatewayEventReasonDriftRepaired, gomock.Any()).AnyTimes()
+			r := &gatewayReconciler{
+				log:             gwlog.FallbackLogger,
+				client:          k8sClient,
+				eventRecorder:   mockEventRecorder,
+				cloud:           mockCloud,
+				snManager:       mockSNManager,
+				iamAuthPolicies: policy.NewIAMAuthPolicyHandler(gwlog.FallbackLogger, k8sClient),
+				vpcAssociations: policy.NewVpcAssociationPolicyHandler(gwlog.FallbackLogger, k8sClient),
+
+				appliedProvisionedConfigs: cache.NewExpiring(),
+			}
+			repairs := testutil.ToFloat64(serviceNetworkDriftRepairsTotal.WithLabelValues("drift-gw", deploy.ServiceNetworkDriftAuthType))
+
+			if tt.snArn == snArn {
+				mockCloud.EXPECT().IsArnManaged(gomock.Any(), snArn).Return(tt.arnManaged, nil)
+			}
+			if tt.expectRepair {
+				mockSNManager.EXPECT().RepairDrift(gomock.Any(), model.ServiceNetworkStatus{
+					ServiceNetworkARN: snArn,
+					ServiceNetworkID:  "sn-123",
+				}, string(vpclatticetypes.AuthTypeAwsIam)).Return([]string{deploy.ServiceNetworkDriftAuthType}, nil)
+			}
+
+			assert.NoError(t, r.repairServiceNetworkDrift(ctx, gw, nil, snInfo))
+			if !tt.expectRepair {
+				return
+			}
+			assert.Equal(t, repairs+1, testutil.ToFloat64(serviceNetworkDriftRepairsTotal.WithLabelValues("drift-gw", deploy.ServiceNetworkDriftAuthType)))
+			updated := &gwv1.Gateway{}
+			assert.NoError(t, r.client.Get(ctx, types.NamespacedName{Name: "drift-gw", Namespace: "default"}, updated))
+			assert.Equal(t, snArn, updated.Annotations[k8s.ServiceNetworkArnAnnotation])
+		})
+	}
+}
+
+func TestGatewayReconciler_RepairServiceNetworkDrift_AuthType(t *testing.T) {
+	snArn := "arn:aws:vpc-lattice:us-west-2:123456789012:servicenetwork/sn-123"
+	notAcceptedIap := &anv1alpha1.IAMAuthPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "iap", Namespace: "default"},
+		Spec: anv1alpha1.IAMAuthPolicySpec{
+			TargetRef: &gwv1alpha2.NamespacedPolicyTargetReference{
+				Group: gwv1.GroupName,
+				Kind:  "Gateway",
+				Name:  "drift-gw",
+			},
+		},
+		Status: anv1alpha1.IAMAuthPolicyStatus{Conditions: []metav1.Condition{{
+			Type:   string(policy.ConditionTypeAccepted),
+			Status: metav1.ConditionFalse,
+			Reason: string(policy.ReasonInvalid),
+		}}},
+	}
+
+	tests := []struct {
+		name     string
+		authType vpclatticetypes.AuthType
+		iap      *anv1alpha1.IAMAuthPolicy
+		// expectedAuthType is the auth type the service network is repaired to, empty to leave it alone
+		expectedAuthType   string
+		expectedAnnotation vpclatticetypes.AuthType
+	}{
+		{
+			name:               "AWS_IAM set by the controller is kept while a policy not accepted yet targets the Gateway",
+			authType:           vpclatticetypes.AuthTypeAwsIam,
+			iap:                notAcceptedIap,
+			expectedAnnotation: vpclatticetypes.AuthTypeAwsIam,
+		},
+		{
+			name:               "AWS_IAM set by the controller is reverted once no policy targets the Gateway",
+			authType:           vpclatticetypes.AuthTypeAwsIam,
+			expectedAuthType:   string(vpclatticetypes.AuthTypeNone),
+			expectedAnnotation: vpclatticetypes.AuthTypeNone,
+		},
+		{
+			name:               "AWS_IAM not set by the controller is left in place",
+			authType:           vpclatticetypes.AuthTypeNone,
+			expectedAnnotation: vpclatticetypes.AuthTypeNone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			ctx := context.TODO()
+			gw := &gwv1.Gateway{ObjectMeta: metav1.ObjectMeta{
+				Name:      "drift-gw",
+				Namespace: "default",
+				Annotations: map[string]string{
+					k8s.ServiceNetworkArnAnnotation:      snArn,
+					k8s.ServiceNetworkAuthTypeAnnotation: string(tt.authType),
+				},
+			}}
+			snInfo := &services.ServiceNetworkInfo{
+				SvcNetwork: vpclatticetypes.ServiceNetworkSummary{Arn: aws.String(snArn), Id: aws.String("sn-123")},
+			}
+			objs := []runtime.Object{gw.DeepCopy()}
+			if tt.iap != nil {
+				objs = append(objs, tt.iap)
+			}
+			k8sScheme := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sScheme)
+			gwv1.Install(k8sScheme)
+			anv1alpha1.Install(k8sScheme)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sScheme).WithRuntimeObjects(objs...).Build()
+			mockCloud := pkg_aws.NewMockCloud(c)
+			mockCloud.EXPECT().Config().Return(pkg_aws.CloudConfig{AccountId: "123456789012"}).AnyTimes()
+			mockCloud.EXPECT().IsArnManaged(gomock.Any(), snArn).Return(true, nil).AnyTimes()
+			mockSNManager := deploy.NewMockServiceNetworkManager(c)
+			mockEventRecorder := mock_client.NewMockEventRecorder(c)
+			mockEventRecorder.EXPECT().Event(gomock.Any(), corev1.EventTypeNormal, k8s.GatewayEventReasonDriftRepaired, gomock.Any()).AnyTimes()
+			r := &gatewayReconciler{
+				log:             gwlog.FallbackLogger,
+				client:          k8sClient,
+				eventRecorder:   mockEventRecorder,
+				cloud:           mockCloud,
+				snManager:       mockSNManager,
+				iamAuthPolicies: policy.NewIAMAuthPolicyHandler(gwlog.FallbackLogger, k8sClient),
+				vpcAssociations: policy.NewVpcAssociationPolicyHandler(gwlog.FallbackLogger, k8sClient),
+
+				appliedProvisionedConfigs: cache.NewExpiring(),
+			}
+
+			mockSNManager.EXPECT().RepairDrift(gomock.Any(), gomock.Any(), tt.expectedAuthType).Return(nil, nil)
+
+			assert.NoError(t, r.repairServiceNetworkDrift(ctx, gw, nil, snInfo))
+			updated := &gwv1.Gateway{}
+			assert.NoError(t, r.client.Get(ctx, types.NamespacedName{Name: "drift-gw", Namespace: "default"}, updated))
+			assert.Equal(t, string(tt.expectedAnnotation), updated.Annotations[k8s.ServiceNetworkAuthTypeAnnotation])
+		})
+	}
+}
+
+func TestGatewayReconciler_RecreateServiceNetwork(t *testing.T) {
+	newArn := "arn:aws:vpc-lattice:us-west-2:123456789012:servicenetwork/sn-new"
+	vapTargetRef := &gwv1alpha2.NamespacedPolicyTargetReference{
+		Group: gwv1.GroupName,
+		Kind:  "Gateway",
+		Name:  "drift-gw",
+	}
+	tests := []struct {
+		name                string
+		vap                 *anv1alpha1.VpcAssociationPolicy
+		serviceNetwork      *anv1alpha1.ServiceNetwork
+		expectRecreate      bool
+		expectAssociation   bool
+		expectedSgIds       []string
+		expectedVapTagValue string
+	}{
+		{
+			name:              "without VpcAssociationPolicy, the association has no security groups",
+			expectRecreate:    true,
+			expectAssociation: true,
+		},
+		{
+			name: "the association gets the security groups and tags of the VpcAssociationPolicy",
+			vap: &anv1alpha1.VpcAssociationPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "vap",
+					Namespace:   "default",
+					Annotations: map[string]string{k8s.TagsAnnotationKey: "team=drift"},
+				},
+				Spec: anv1alpha1.VpcAssociationPolicySpec{
+					TargetRef:        vapTargetRef,
+					SecurityGroupIds: []anv1alpha1.SecurityGroupId{"sg-1", "sg-2"},
+				},
+			},
+			expectRecreate:      true,
+			expectAssociation:   true,
+			expectedSgIds:       []string{"sg-1", "sg-2"},
+			expectedVapTagValue: "drift",
+		},
+		{
+			name: "no association when the VpcAssociationPolicy disables it",
+			vap: &anv1alpha1.VpcAssociationPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "vap", Namespace: "default"},
+				Spec: anv1alpha1.VpcAssociationPolicySpec{
+					TargetRef:        vapTargetRef,
+					AssociateWithVpc: aws.Bool(false),
+				},
+			},
+			expectRecreate: true,
+		},
+		{
+			name:           "service networks of ServiceNetwork resources are recreated by their own controller",
+			serviceNetwork: &anv1alpha1.ServiceNetwork{ObjectMeta: metav1.ObjectMeta{Name: "drift-gw"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			ctx := context.TODO()
+			gw := &gwv1.Gateway{ObjectMeta: metav1.ObjectMeta{
+				Name:        "drift-gw",
+				Namespace:   "default",
+				Annotations: map[string]string{k8s.ServiceNetworkArnAnnotation: "arn:aws:vpc-lattice:us-west-2:123456789012:servicenetwork/sn-old"},
+			}}
+			objs := []runtime.Object{gw.DeepCopy()}
+			if tt.vap != nil {
+				objs = append(objs, tt.vap)
+			}
+			if tt.serviceNetwork != nil {
+				objs = append(objs, tt.serviceNetwork)
+			}
+			k8sScheme := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sScheme)
+			gwv1.Install(k8sScheme)
+			anv1alpha1.Install(k8sScheme)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sScheme).WithRuntimeObjects(objs...).Build()
+			mockSNManager := deploy.NewMockServiceNetworkManager(c)
+			mockEventRecorder := mock_client.NewMockEventRecorder(c)
+			mockEventRecorder.EXPECT().Event(gomock.Any(), corev1.EventTypeNormal, k8s.GatewayEventReasonDriftRepaired, gomock.Any()).AnyTimes()
+			r := &gatewayReconciler{
+				log:             gwlog.FallbackLogger,
+				client:          k8sClient,
+				eventRecorder:   mockEventRecorder,
+				snManager:       mockSNManager,
+				vpcAssociations: policy.NewVpcAssociationPolicyHandler(gwlog.FallbackLogger, k8sClient),
+			}
+
+			if tt.expectRecreate {
+				mockSNManager.EXPECT().Upsert(gomock.Any(), "drift-gw", gomock.Nil()).
+					Return(model.ServiceNetworkStatus{ServiceNetworkARN: newArn, ServiceNetworkID: "sn-new"}, nil)
+			}
+			if tt.expectAssociation {
+				mockSNManager.EXPECT().UpsertVpcAssociation(gomock.Any(), "drift-gw", gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, snName string, sgIds []string, additionalTags services.Tags) (string, error) {
+						assert.Equal(t, tt.expectedSgIds, sgIds)
+						assert.Equal(t, tt.expectedVapTagValue, additionalTags["team"])
+						return "snva-arn", nil
+					})
+			}
+
+			err := r.recreateServiceNetwork(ctx, gw)
+			if !tt.expectRecreate {
+				assert.NoError(t, err)
+				return
+			}
+			requeue := &lattice_runtime.RequeueNeededAfter{}
+			assert.ErrorAs(t, err, &requeue)
+			updated := &gwv1.Gateway{}
+			assert.NoError(t, r.client.Get(ctx, types.NamespacedName{Name: "drift-gw", Namespace: "default"}, updated))
+			assert.Equal(t, newArn, updated.Annotations[k8s.ServiceNetworkArnAnnotation])
+		})
+	}
+}
+
+func TestGatewayReconciler_GatewayClassConfig(t *testing.T) {
+	gw := &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "auto-gw", Namespace: "default"},
+		Spec:       gwv1.GatewaySpec{GatewayClassName: "amazon-vpc-lattice"},
+	}
+	gcc := &anv1alpha1.GatewayClassConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "auto-provision"},
+		Spec:       anv1alpha1.GatewayClassConfigSpec{AutoProvisionServiceNetwork: true},
+	}
+
+	// missing and unsupported parameters are reported on the GatewayClass only
+	tests := []struct {
+		name              string
+		parametersRefKind gwv1.Kind
+		withConfig        bool
+		expectConfig      bool
+		expectedErr       error
+	}{
+		{
+			name:              "parametersRef to a GatewayClassConfig",
+			parametersRefKind: anv1alpha1.GatewayClassConfigKind,
+			withConfig:        true,
+			expectConfig:      true,
+		},
+		{
+			name:              "missing GatewayClassConfig",
+			parametersRefKind: anv1alpha1.GatewayClassConfigKind,
+		},
+		{
+			name:              "unsupported parametersRef kind",
+			parametersRefKind: "ConfigMap",
+			withConfig:        true,
+			expectedErr:       UnsupportedParametersRef,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			gwClass := &gwv1.GatewayClass{
+				ObjectMeta: metav1.ObjectMeta{Name: "amazon-vpc-lattice"},
+				Spec: gwv1.GatewayClassSpec{
+					ControllerName: config.LatticeGatewayControllerName,
+					ParametersRef: &gwv1.ParametersReference{
+						Group: anv1alpha1.GroupName,
+						Kind:  tt.parametersRefKind,
+						Name:  "auto-provision",
+					},
+				},
+			}
+			objs := []runtime.Object{gwClass}
+			if tt.withConfig {
+				objs = append(objs, gcc.DeepCopy())
+			}
+			k8sScheme := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sScheme)
+			gwv1.Install(k8sScheme)
+			anv1alpha1.Install(k8sScheme)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sScheme).WithRuntimeObjects(objs...).Build()
+			r := &gatewayReconciler{log: gwlog.FallbackLogger, client: k8sClient}
+
+			if tt.expectedErr != nil {
+				_, err := getGatewayClassConfig(ctx, k8sClient, gwClass)
+				assert.ErrorIs(t, err, tt.expectedErr)
+			}
+			found, err := r.gatewayClassConfig(ctx, gw)
+			assert.NoError(t, err)
+			if tt.expectConfig {
+				assert.Equal(t, "auto-provision", found.Name)
+			} else {
+				assert.Nil(t, found)
+			}
+		})
+	}
+}
+
+func TestGatewayReconciler_ProvisionServiceNetwork(t *testing.T) {
+	snArn := "arn:aws:vpc-lattice:us-west-2:123456789012:servicenetwork/sn-123"
+	gcc := &anv1alpha1.GatewayClassConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "auto-provision"},
+		Spec: anv1alpha1.GatewayClassConfigSpec{
+			AutoProvisionServiceNetwork: true,
+			Tags:                        map[string]string{"team": "platform"},
+		},
+	}
+
+	tests := []struct {
+		name              string
+		serviceNetwork    *anv1alpha1.ServiceNetwork
+		expectProvisioned bool
+	}{
+		{
+			name:              "provisions the service network and records its ARN",
+			expectProvisioned: true,
+		},
+		{
+			name:           "service networks of ServiceNetwork resources are created by their own controller",
+			serviceNetwork: &anv1alpha1.ServiceNetwork{ObjectMeta: metav1.ObjectMeta{Name: "auto-gw"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			ctx := context.TODO()
+			gw := &gwv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Name: "auto-gw", Namespace: "default"},
+				Spec:       gwv1.GatewaySpec{GatewayClassName: "amazon-vpc-lattice"},
+			}
+			objs := []runtime.Object{gw.DeepCopy()}
+			if tt.serviceNetwork != nil {
+				objs = append(objs, tt.serviceNetwork)
+			}
+			k8sScheme := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sScheme)
+			gwv1.Install(k8sScheme)
+			anv1alpha1.Install(k8sScheme)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sScheme).WithRuntimeObjects(objs...).
+				WithStatusSubresource(&gwv1.Gateway{}).Build()
+			mockSNManager := deploy.NewMockServiceNetworkManager(c)
+			mockEventRecorder := mock_client.NewMockEventRecorder(c)
+			mockEventRecorder.EXPECT().Event(gomock.Any(), corev1.EventTypeNormal, k8s.GatewayEventReasonProvisioned, gomock.Any()).AnyTimes()
+			r := &gatewayReconciler{
+				log:           gwlog.FallbackLogger,
+				client:        k8sClient,
+				eventRecorder: mockEventRecorder,
+				snManager:     mockSNManager,
+			}
+
+			if tt.expectProvisioned {
+				mockSNManager.EXPECT().Upsert(gomock.Any(), "auto-gw", services.Tags{
+					"team":                       "platform",
+					model.K8SGatewayNameKey:      "auto-gw",
+					model.K8SGatewayNamespaceKey: "default",
+				}).Return(model.ServiceNetworkStatus{ServiceNetworkARN: snArn, ServiceNetworkID: "sn-123"}, nil)
+			}
+
+			err := r.provisionServiceNetwork(ctx, gw, gcc)
+			if !tt.expectProvisioned {
+				assert.NoError(t, err)
+				return
+			}
+			requeue := &lattice_runtime.RequeueNeededAfter{}
+			assert.ErrorAs(t, err, &requeue)
+			updated := &gwv1.Gateway{}
+			assert.NoError(t, r.client.Get(ctx, types.NamespacedName{Name: "auto-gw", Namespace: "default"}, updated))
+			assert.Equal(t, snArn, updated.Annotations[k8s.ServiceNetworkArnAnnotation])
+		})
+	}
+}
+
+func TestGatewayReconciler_RepairProvisionedServiceNetwork(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+	ctx := context.TODO()
+	snArn := "arn:aws:vpc-lattice:us-west-2:123456789012:servicenetwork/sn-123"
+	gcc := &anv1alpha1.GatewayClassConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "auto-provision"},
+		Spec: anv1alpha1.GatewayClassConfigSpec{
+			AutoProvisionServiceNetwork: true,
+			Tags:                        map[string]string{"team": "platform"},
+			AuthType:                    aws.String(string(vpclatticetypes.AuthTypeAwsIam)),
+			SecurityGroupIds:            []anv1alpha1.SecurityGroupId{"sg-123"},
+		},
+	}
+	gw := &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "auto-gw",
+			Namespace:   "default",
+			Annotations: map[string]string{k8s.ServiceNetworkArnAnnotation: snArn},
+		},
+		Spec: gwv1.GatewaySpec{GatewayClassName: "amazon-vpc-lattice"},
+	}
+	snInfo := &services.ServiceNetworkInfo{
+		SvcNetwork: vpclatticetypes.ServiceNetworkSummary{Arn: aws.String(snArn), Id: aws.String("sn-123")},
+	}
+	snStatus := model.ServiceNetworkStatus{ServiceNetworkARN: snArn, ServiceNetworkID: "sn-123"}
+	k8sScheme := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sScheme)
+	gwv1.Install(k8sScheme)
+	anv1alpha1.Install(k8sScheme)
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sScheme).WithRuntimeObjects(gw.DeepCopy()).Build()
+	mockCloud := pkg_aws.NewMockCloud(c)
+	mockCloud.EXPECT().Config().Return(pkg_aws.CloudConfig{AccountId: "123456789012"}).AnyTimes()
+	mockSNManager := deploy.NewMockServiceNetworkManager(c)
+	mockEventRecorder := mock_client.NewMockEventRecorder(c)
+	mockEventRecorder.EXPECT().Event(gomock.Any(), corev1.EventTypeNormal, k8s.GatewayEventReasonDriftRepaired, gomock.Any()).AnyTimes()
+	r := &gatewayReconciler{
+		log:             gwlog.FallbackLogger,
+		client:          k8sClient,
+		eventRecorder:   mockEventRecorder,
+		cloud:           mockCloud,
+		snManager:       mockSNManager,
+		iamAuthPolicies: policy.NewIAMAuthPolicyHandler(gwlog.FallbackLogger, k8sClient),
+		vpcAssociations: policy.NewVpcAssociationPolicyHandler(gwlog.FallbackLogger, k8sClient),
+
+		appliedProvisionedConfigs: cache.NewExpiring(),
+	}
+
+	// the auth type of the GatewayClassConfig is applied and the network is associated with the cluster VPC
+	mockCloud.EXPECT().IsArnManaged(gomock.Any(), snArn).Return(true, nil).AnyTimes()
+	mockSNManager.EXPECT().RepairDrift(gomock.Any(), snStatus, string(vpclatticetypes.AuthTypeAwsIam)).Return(nil, nil)
+	mockSNManager.EXPECT().UpsertConfig(gomock.Any(), snStatus, &model.ServiceNetworkConfig{
+		Name:            "auto-gw",
+		AdditionalTags:  services.Tags{"team": "platform"},
+		VpcAssociations: []model.ServiceNetworkVpcAssociation{{VpcId: config.VpcID, SecurityGroupIds: []string{"sg-123"}}},
+	}).Return(model.ServiceNetworkConfigStatus{}, nil)
+	assert.NoError(t, r.repairServiceNetworkDrift(ctx, gw, gcc, snInfo))
+
+	// the association is removed when disabled
+	noVpcGcc := gcc.DeepCopy()
+	noVpcGcc.Spec.AssociateWithVpc = aws.Bool(false)
+	mockSNManager.EXPECT().RepairDrift(gomock.Any(), snStatus, string(vpclatticetypes.AuthTypeAwsIam)).Return(nil, nil)
+	mockSNManager.EXPECT().UpsertConfig(gomock.Any(), snStatus, &model.ServiceNetworkConfig{
+		Name:           "auto-gw",
+		AdditionalTags: services.Tags{"team": "platform"},
+	}).Return(model.ServiceNetworkConfigStatus{}, nil)
+	assert.NoError(t, r.repairServiceNetworkDrift(ctx, gw, noVpcGcc, snInfo))
+
+	// the configuration applied before is not applied again until the service network drifts
+	mockSNManager.EXPECT().RepairDrift(gomock.Any(), snStatus, string(vpclatticetypes.AuthTypeAwsIam)).Return(nil, nil)
+	assert.NoError(t, r.repairServiceNetworkDrift(ctx, gw, noVpcGcc, snInfo))
+
+	mockSNManager.EXPECT().RepairDrift(gomock.Any(), snStatus, string(vpclatticetypes.AuthTypeAwsIam)).
+		Return([]string{deploy.ServiceNetworkDriftTags}, nil)
+	mockSNManager.EXPECT().UpsertConfig(gomock.Any(), snStatus, gomock.Any()).Return(model.ServiceNetworkConfigStatus{}, nil)
+	assert.NoError(t, r.repairServiceNetworkDrift(ctx, gw, noVpcGcc, snInfo))
+}
+
+func TestGatewayReconciler_DeprovisionServiceNetwork(t *testing.T) {
+	gatewayTags := services.Tags{
+		model.K8SGatewayNameKey:      "auto-gw",
+		model.K8SGatewayNamespaceKey: "default",
+	}
+
+	tests := []struct {
+		name string
+		// gcc is the GatewayClassConfig of the GatewayClass of the Gateway, if any
+		gcc            *anv1alpha1.GatewayClassConfig
+		serviceNetwork *anv1alpha1.ServiceNetwork
+		expectDelete   bool
+		deleteInUse    bool
+		deleteErr      error
+		expectRequeue  bool
+	}{
+		{
+			name: "deletes the provisioned service network",
+			gcc: &anv1alpha1.GatewayClassConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "auto-provision"},
+				Spec:       anv1alpha1.GatewayClassConfigSpec{AutoProvisionServiceNetwork: true},
+			},
+			expectDelete: true,
+		},
+		{
+			name: "service networks still in use do not hold back the deletion of the Gateway",
+			gcc: &anv1alpha1.GatewayClassConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "auto-provision"},
+				Spec:       anv1alpha1.GatewayClassConfigSpec{AutoProvisionServiceNetwork: true},
+			},
+			expectDelete: true,
+			deleteInUse:  true,
+		},
+		{
+			name: "failures are retried",
+			gcc: &anv1alpha1.GatewayClassConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "auto-provision"},
+				Spec:       anv1alpha1.GatewayClassConfigSpec{AutoProvisionServiceNetwork: true},
+			},
+			expectDelete:  true,
+			deleteErr:     lattice_runtime.NewRetryError(),
+			expectRequeue: true,
+		},
+		{
+			// service networks provisioned before are deleted even when the GatewayClass no longer auto-provisions them
+			name: "GatewayClass no longer auto-provisions",
+			gcc: &anv1alpha1.GatewayClassConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "auto-provision"},
+			},
+			expectDelete: true,
+		},
+		{
+			name:         "GatewayClassConfig is gone",
+			expectDelete: true,
+		},
+		{
+			name: "service networks of ServiceNetwork resources are deleted by their own controller",
+			gcc: &anv1alpha1.GatewayClassConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "auto-provision"},
+				Spec:       anv1alpha1.GatewayClassConfigSpec{AutoProvisionServiceNetwork: true},
+			},
+			serviceNetwork: &anv1alpha1.ServiceNetwork{ObjectMeta: metav1.ObjectMeta{Name: "auto-gw"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			ctx := context.TODO()
+			gw := &gwv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Name: "auto-gw", Namespace: "default"},
+				Spec:       gwv1.GatewaySpec{GatewayClassName: "amazon-vpc-lattice"},
+			}
+			objs := []runtime.Object{&gwv1.GatewayClass{
+				ObjectMeta: metav1.ObjectMeta{Name: "amazon-vpc-lattice"},
+				Spec: gwv1.GatewayClassSpec{
+					ControllerName: config.LatticeGatewayControllerName,
+					ParametersRef: &gwv1.ParametersReference{
+						Group: anv1alpha1.GroupName,
+						Kind:  anv1alpha1.GatewayClassConfigKind,
+						Name:  "auto-provision",
+					},
+				},
+			}}
+			if tt.gcc != nil {
+				objs = append(objs, tt.gcc)
+			}
+			if tt.serviceNetwork != nil {
+				objs = append(objs, tt.serviceNetwork)
+			}
+			k8sScheme := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sScheme)
+			gwv1.Install(k8sScheme)
+			anv1alpha1.Install(k8sScheme)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sScheme).WithRuntimeObjects(objs...).Build()
+			mockSNManager := deploy.NewMockServiceNetworkManager(c)
+			mockEventRecorder := mock_client.NewMockEventRecorder(c)
+			mockEventRecorder.EXPECT().Event(gomock.Any(), corev1.EventTypeNormal, k8s.GatewayEventReasonRetained, gomock.Any()).AnyTimes()
+			r := &gatewayReconciler{
+				log:           gwlog.FallbackLogger,
+				client:        k8sClient,
+				eventRecorder: mockEventRecorder,
+				snManager:     mockSNManager,
+			}
+
+			if tt.expectDelete {
+				mockSNManager.EXPECT().DeleteProvisioned(gomock.Any(), "auto-gw", gatewayTags).Return(tt.deleteInUse, tt.deleteErr)
+			}
+
+			err := r.deprovisionServiceNetwork(ctx, gw)
+			if tt.expectRequeue {
+				requeue := &lattice_runtime.RequeueNeededAfter{}
+				assert.ErrorAs(t, err, &requeue)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
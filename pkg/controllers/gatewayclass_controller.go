@@ -18,9 +18,13 @@ package controllers
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	anv1alpha1 "github.com/aws/aws-application-networking-k8s/pkg/apis/applicationnetworking/v1alpha1"
 	"github.com/aws/aws-application-networking-k8s/pkg/config"
+	"github.com/aws/aws-application-networking-k8s/pkg/controllers/eventhandlers"
+	"github.com/aws/aws-application-networking-k8s/pkg/k8s"
 	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
 	"github.com/pkg/errors"
 
@@ -45,14 +49,27 @@ func RegisterGatewayClassController(log gwlog.Logger, mgr ctrl.Manager) error {
 		scheme:                   mgr.GetScheme(),
 		latticeControllerEnabled: false,
 	}
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&gwv1.GatewayClass{}).
-		Complete(r)
+	builder := ctrl.NewControllerManagedBy(mgr).
+		For(&gwv1.GatewayClass{})
+
+	//Watch GatewayClassConfig CRD if it is installed
+	ok, err := k8s.IsGVKSupported(mgr, anv1alpha1.GroupVersion.String(), anv1alpha1.GatewayClassConfigKind)
+	if err != nil {
+		return err
+	}
+	if ok {
+		gccEventHandler := eventhandlers.NewGatewayClassConfigEventHandler(log, mgr.GetClient())
+		builder.Watches(&anv1alpha1.GatewayClassConfig{}, gccEventHandler.MapToGatewayClass())
+	} else {
+		log.Infof(context.TODO(), "GatewayClassConfig CRD is not installed, skipping watch")
+	}
+	return builder.Complete(r)
 }
 
 //+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gatewayclasses,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gatewayclasses/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gatewayclasses/finalizers,verbs=update
+//+kubebuilder:rbac:groups=application-networking.k8s.aws,resources=gatewayclassconfigs,verbs=get;list;watch
 
 func (r *gatewayClassReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	ctx = gwlog.StartReconcileTrace(ctx, r.log, "gatewayclass", req.Name, req.Namespace)
@@ -80,9 +97,15 @@ func (r *gatewayClassReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	gwClassOld := gwClass.DeepCopy()
 	gwClass.Status.Conditions[0].LastTransitionTime = metav1.NewTime(time.Now())
 	gwClass.Status.Conditions[0].ObservedGeneration = gwClass.Generation
-	gwClass.Status.Conditions[0].Status = "True"
-	gwClass.Status.Conditions[0].Message = string(gwv1.GatewayClassReasonAccepted)
-	gwClass.Status.Conditions[0].Reason = string(gwv1.GatewayClassReasonAccepted)
+	if _, err := getGatewayClassConfig(ctx, r.client, gwClass); err != nil {
+		gwClass.Status.Conditions[0].Status = "False"
+		gwClass.Status.Conditions[0].Message = err.Error()
+		gwClass.Status.Conditions[0].Reason = string(gwv1.GatewayClassReasonInvalidParameters)
+	} else {
+		gwClass.Status.Conditions[0].Status = "True"
+		gwClass.Status.Conditions[0].Message = string(gwv1.GatewayClassReasonAccepted)
+		gwClass.Status.Conditions[0].Reason = string(gwv1.GatewayClassReasonAccepted)
+	}
 
 	if err := r.client.Status().Patch(ctx, gwClass, client.MergeFrom(gwClassOld)); err != nil {
 		return ctrl.Result{}, errors.Wrapf(err, "failed to update gatewayclass status")
@@ -91,3 +114,21 @@ func (r *gatewayClassReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	r.log.Infow(ctx, "reconciled", "name", gwClass.Name, "status", gwClass.Status)
 	return ctrl.Result{}, nil
 }
+
+// getGatewayClassConfig returns the GatewayClassConfig referenced by the parametersRef of a GatewayClass, or nil when
+// it references none
+func getGatewayClassConfig(ctx context.Context, c client.Client, gwClass *gwv1.GatewayClass) (*anv1alpha1.GatewayClassConfig, error) {
+	ref := gwClass.Spec.ParametersRef
+	if ref == nil {
+		return nil, nil
+	}
+	if string(ref.Group) != anv1alpha1.GroupName || string(ref.Kind) != anv1alpha1.GatewayClassConfigKind {
+		return nil, fmt.Errorf("%w %s/%s, expected %s/%s", UnsupportedParametersRef,
+			ref.Group, ref.Kind, anv1alpha1.GroupName, anv1alpha1.GatewayClassConfigKind)
+	}
+	gcc := &anv1alpha1.GatewayClassConfig{}
+	if err := c.Get(ctx, client.ObjectKey{Name: ref.Name}, gcc); err != nil {
+		return nil, fmt.Errorf("failed to get GatewayClassConfig %s: %w", ref.Name, err)
+	}
+	return gcc, nil
+}
@@ -18,7 +18,9 @@ package controllers
 
 import (
 	"context"
+	"time"
 
+	"github.com/aws/aws-application-networking-k8s/pkg/k8s"
 	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -54,5 +56,15 @@ func (r *podReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 
 	}
-	return ctrl.Result{}, nil
+	if pod.DeletionTimestamp.IsZero() || !k8s.HasFinalizer(pod, k8s.PodDrainingFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	// route and service export reconciles release the pod as soon as its targets are drained,
+	// the draining timeout bounds the wait when they do not, e.g. when the route is gone
+	if wait := time.Until(k8s.DrainingDeadline(pod)); wait > 0 {
+		return ctrl.Result{RequeueAfter: wait}, nil
+	}
+	r.log.Infof(ctx, "Releasing deletion of pod %s, draining timeout has passed", req.NamespacedName)
+	return ctrl.Result{}, k8s.ReleasePodDeletion(ctx, r.client, pod)
 }
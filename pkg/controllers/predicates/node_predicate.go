@@ -0,0 +1,26 @@
+package predicates
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// NodeProviderIdChangedPredicate passes node creation and deletion, and updates changing the provider ID,
+// which holds the EC2 instance ID registered in INSTANCE target groups
+var NodeProviderIdChangedPredicate = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldNode, ok := e.ObjectOld.(*corev1.Node)
+		if !ok {
+			return false
+		}
+		newNode, ok := e.ObjectNew.(*corev1.Node)
+		if !ok {
+			return false
+		}
+		return oldNode.Spec.ProviderID != newNode.Spec.ProviderID
+	},
+	GenericFunc: func(e event.GenericEvent) bool {
+		return false
+	},
+}
@@ -0,0 +1,32 @@
+package predicates
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+func TestNodeProviderIdChangedPredicate(t *testing.T) {
+	node := func(providerId string, labels map[string]string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: labels},
+			Spec:       corev1.NodeSpec{ProviderID: providerId},
+		}
+	}
+
+	predicate := NodeProviderIdChangedPredicate
+
+	assert.True(t, predicate.Create(event.CreateEvent{Object: node("aws:///us-west-2a/i-1", nil)}))
+	assert.True(t, predicate.Delete(event.DeleteEvent{Object: node("aws:///us-west-2a/i-1", nil)}))
+	assert.True(t, predicate.Update(event.UpdateEvent{
+		ObjectOld: node("", nil),
+		ObjectNew: node("aws:///us-west-2a/i-1", nil),
+	}))
+	assert.False(t, predicate.Update(event.UpdateEvent{
+		ObjectOld: node("aws:///us-west-2a/i-1", nil),
+		ObjectNew: node("aws:///us-west-2a/i-1", map[string]string{"foo": "bar"}),
+	}))
+}
@@ -0,0 +1,47 @@
+package predicates
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	anv1alpha1 "github.com/aws/aws-application-networking-k8s/pkg/apis/applicationnetworking/v1alpha1"
+)
+
+// TargetGroupPolicyPrecedenceChangedPredicate passes the changes of a TargetGroupPolicy that affect the other
+// policies of its namespace: its creation and deletion, spec updates, which change its precedence and defaults, and
+// the acceptance and effective config updates of namespace policies, which provide the defaults of the others
+var TargetGroupPolicyPrecedenceChangedPredicate = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldTgp, ok := e.ObjectOld.(*anv1alpha1.TargetGroupPolicy)
+		if !ok {
+			return false
+		}
+		newTgp, ok := e.ObjectNew.(*anv1alpha1.TargetGroupPolicy)
+		if !ok {
+			return false
+		}
+		if oldTgp.Generation != newTgp.Generation {
+			return true
+		}
+		if !isNamespaceTargetGroupPolicy(newTgp) {
+			return false
+		}
+		return isAccepted(oldTgp) != isAccepted(newTgp) ||
+			!equality.Semantic.DeepEqual(oldTgp.Status.EffectiveConfig, newTgp.Status.EffectiveConfig)
+	},
+	GenericFunc: func(e event.GenericEvent) bool {
+		return false
+	},
+}
+
+func isNamespaceTargetGroupPolicy(tgp *anv1alpha1.TargetGroupPolicy) bool {
+	targetRef := tgp.GetTargetRef()
+	return targetRef != nil && targetRef.Kind == "Namespace" && targetRef.Group == corev1.GroupName
+}
+
+func isAccepted(tgp *anv1alpha1.TargetGroupPolicy) bool {
+	return meta.IsStatusConditionTrue(tgp.Status.Conditions, "Accepted")
+}
@@ -0,0 +1,57 @@
+package predicates
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	anv1alpha1 "github.com/aws/aws-application-networking-k8s/pkg/apis/applicationnetworking/v1alpha1"
+)
+
+func TestTargetGroupPolicyPrecedenceChangedPredicate(t *testing.T) {
+	tgp := func(kind string, generation int64, accepted metav1.ConditionStatus, protocol string) *anv1alpha1.TargetGroupPolicy {
+		return &anv1alpha1.TargetGroupPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "tgp", Namespace: "ns", Generation: generation},
+			Spec: anv1alpha1.TargetGroupPolicySpec{
+				TargetRef: &gwv1alpha2.NamespacedPolicyTargetReference{Group: corev1.GroupName, Kind: gwv1alpha2.Kind(kind)},
+			},
+			Status: anv1alpha1.TargetGroupPolicyStatus{
+				Conditions:      []metav1.Condition{{Type: "Accepted", Status: accepted}},
+				EffectiveConfig: &anv1alpha1.TargetGroupPolicyConfig{Protocol: &protocol},
+			},
+		}
+	}
+
+	predicate := TargetGroupPolicyPrecedenceChangedPredicate
+
+	assert.True(t, predicate.Create(event.CreateEvent{Object: tgp("Service", 1, metav1.ConditionTrue, "HTTP")}))
+	assert.True(t, predicate.Delete(event.DeleteEvent{Object: tgp("Service", 1, metav1.ConditionTrue, "HTTP")}))
+	assert.True(t, predicate.Update(event.UpdateEvent{
+		ObjectOld: tgp("Service", 1, metav1.ConditionTrue, "HTTP"),
+		ObjectNew: tgp("Service", 2, metav1.ConditionTrue, "HTTP"),
+	}))
+
+	// status updates of service policies affect no other policy
+	assert.False(t, predicate.Update(event.UpdateEvent{
+		ObjectOld: tgp("Service", 1, metav1.ConditionFalse, "HTTP"),
+		ObjectNew: tgp("Service", 1, metav1.ConditionTrue, "HTTPS"),
+	}))
+
+	// the defaults of namespace policies apply once accepted
+	assert.True(t, predicate.Update(event.UpdateEvent{
+		ObjectOld: tgp("Namespace", 1, metav1.ConditionFalse, "HTTP"),
+		ObjectNew: tgp("Namespace", 1, metav1.ConditionTrue, "HTTP"),
+	}))
+	assert.True(t, predicate.Update(event.UpdateEvent{
+		ObjectOld: tgp("Namespace", 1, metav1.ConditionTrue, "HTTP"),
+		ObjectNew: tgp("Namespace", 1, metav1.ConditionTrue, "HTTPS"),
+	}))
+	assert.False(t, predicate.Update(event.UpdateEvent{
+		ObjectOld: tgp("Namespace", 1, metav1.ConditionTrue, "HTTP"),
+		ObjectNew: tgp("Namespace", 1, metav1.ConditionTrue, "HTTP"),
+	}))
+}
@@ -18,6 +18,7 @@ package controllers
 
 import (
 	"context"
+	"encoding/json"
 	stderrors "errors"
 	"fmt"
 	"time"
@@ -38,6 +39,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/external-dns/endpoint"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	discoveryv1 "k8s.io/api/discovery/v1"
 
@@ -48,6 +50,7 @@ import (
 	"github.com/aws/aws-application-networking-k8s/pkg/controllers/eventhandlers"
 	"github.com/aws/aws-application-networking-k8s/pkg/controllers/predicates"
 	"github.com/aws/aws-application-networking-k8s/pkg/deploy"
+	"github.com/aws/aws-application-networking-k8s/pkg/deploy/lattice"
 	"github.com/aws/aws-application-networking-k8s/pkg/gateway"
 	"github.com/aws/aws-application-networking-k8s/pkg/k8s"
 	"github.com/aws/aws-application-networking-k8s/pkg/model/core"
@@ -79,6 +82,7 @@ type routeReconciler struct {
 const (
 	LatticeAssignedDomainName = "application-networking.k8s.aws/lattice-assigned-domain-name"
 	LatticeServiceArn         = "application-networking.k8s.aws/lattice-service-arn"
+	LatticeRuleArns           = "application-networking.k8s.aws/lattice-rule-arns"
 )
 
 func RegisterAllRouteControllers(
@@ -86,10 +90,19 @@ func RegisterAllRouteControllers(
 	cloud aws.Cloud,
 	finalizerManager k8s.FinalizerManager,
 	mgr ctrl.Manager,
+	targetsFastPath *lattice.TargetsFastPath,
 ) error {
 	mgrClient := mgr.GetClient()
 	gwEventHandler := eventhandlers.NewEnqueueRequestGatewayEvent(log, mgrClient)
 	svcEventHandler := eventhandlers.NewServiceEventHandler(log, mgrClient)
+	if targetsFastPath != nil {
+		svcEventHandler.WithTargetsFastPath(targetsFastPath)
+	}
+	refGrantEventHandler := eventhandlers.NewReferenceGrantEventHandler(log, mgrClient)
+	fixedResponseEventHandler := eventhandlers.NewFixedResponseEventHandler(log, mgrClient)
+	headerMatchFilterEventHandler := eventhandlers.NewHeaderMatchFilterEventHandler(log, mgrClient)
+	lambdaFunctionEventHandler := eventhandlers.NewLambdaFunctionEventHandler(log, mgrClient)
+	albEventHandler := eventhandlers.NewApplicationLoadBalancerEventHandler(log, mgrClient)
 
 	routeInfos := []struct {
 		routeType      core.RouteType
@@ -112,12 +125,13 @@ func RegisterAllRouteControllers(
 			finalizerManager: finalizerManager,
 			eventRecorder:    mgr.GetEventRecorderFor(string(routeInfo.routeType) + "route"),
 			modelBuilder:     gateway.NewLatticeServiceBuilder(log, mgrClient, brTgBuilder, certDiscovery, cloud.Lattice()),
-			stackDeployer:    deploy.NewLatticeServiceStackDeploy(log, cloud, mgrClient),
+			stackDeployer:    deploy.NewLatticeServiceStackDeploy(log, cloud, mgrClient, targetsFastPath),
 			stackMarshaller:  deploy.NewDefaultStackMarshaller(),
 			cloud:            cloud,
 		}
 
 		svcImportEventHandler := eventhandlers.NewServiceImportEventHandler(log, mgrClient)
+		nodePredicate := builder.WithPredicates(predicates.NodeProviderIdChangedPredicate)
 
 		builder := ctrl.NewControllerManagedBy(mgr).
 			For(routeInfo.gatewayApiType, builder.WithPredicates(predicate.Or(predicates.NewRouteChangedPredicate(), predicates.AdditionalTagsAnnotationChangedPredicate, predicates.AllowTakeoverFromAnnotationChangedPredicate))).
@@ -131,6 +145,8 @@ func RegisterAllRouteControllers(
 
 		if ok, err := k8s.IsGVKSupported(mgr, anv1alpha1.GroupVersion.String(), anv1alpha1.TargetGroupPolicyKind); ok {
 			builder.Watches(&anv1alpha1.TargetGroupPolicy{}, svcEventHandler.MapToRoute(routeInfo.routeType))
+			// nodes are the targets of INSTANCE target groups, which are selected by a TargetGroupPolicy
+			builder.Watches(&corev1.Node{}, svcEventHandler.MapToRoute(routeInfo.routeType), nodePredicate)
 		} else {
 			if err != nil {
 				return err
@@ -138,6 +154,55 @@ func RegisterAllRouteControllers(
 			log.Infof(context.TODO(), "TargetGroupPolicy CRD is not installed, skipping watch")
 		}
 
+		if routeInfo.routeType == core.HttpRouteType {
+			if ok, err := k8s.IsGVKSupported(mgr, anv1alpha1.GroupVersion.String(), anv1alpha1.FixedResponseKind); ok {
+				builder.Watches(&anv1alpha1.FixedResponse{}, fixedResponseEventHandler.MapToRoute())
+			} else {
+				if err != nil {
+					return err
+				}
+				log.Infof(context.TODO(), "FixedResponse CRD is not installed, skipping watch")
+			}
+
+			if ok, err := k8s.IsGVKSupported(mgr, anv1alpha1.GroupVersion.String(), anv1alpha1.LambdaFunctionKind); ok {
+				builder.Watches(&anv1alpha1.LambdaFunction{}, lambdaFunctionEventHandler.MapToRoute())
+			} else {
+				if err != nil {
+					return err
+				}
+				log.Infof(context.TODO(), "LambdaFunction CRD is not installed, skipping watch")
+			}
+
+			if ok, err := k8s.IsGVKSupported(mgr, anv1alpha1.GroupVersion.String(), anv1alpha1.ApplicationLoadBalancerKind); ok {
+				builder.Watches(&anv1alpha1.ApplicationLoadBalancer{}, albEventHandler.MapToRoute())
+			} else {
+				if err != nil {
+					return err
+				}
+				log.Infof(context.TODO(), "ApplicationLoadBalancer CRD is not installed, skipping watch")
+			}
+		}
+
+		if routeInfo.routeType == core.HttpRouteType || routeInfo.routeType == core.GrpcRouteType {
+			if ok, err := k8s.IsGVKSupported(mgr, anv1alpha1.GroupVersion.String(), anv1alpha1.HeaderMatchFilterKind); ok {
+				builder.Watches(&anv1alpha1.HeaderMatchFilter{}, headerMatchFilterEventHandler.MapToRoute(routeInfo.routeType))
+			} else {
+				if err != nil {
+					return err
+				}
+				log.Infof(context.TODO(), "HeaderMatchFilter CRD is not installed, skipping watch")
+			}
+		}
+
+		if ok, err := k8s.IsGVKSupported(mgr, gwv1beta1.GroupVersion.String(), "ReferenceGrant"); ok {
+			builder.Watches(&gwv1beta1.ReferenceGrant{}, refGrantEventHandler.MapToRoute(routeInfo.routeType))
+		} else {
+			if err != nil {
+				return err
+			}
+			log.Infof(context.TODO(), "ReferenceGrant CRD is not installed, skipping watch")
+		}
+
 		if ok, err := k8s.IsGVKSupported(mgr, "externaldns.k8s.io/v1alpha1", "DNSEndpoint"); ok {
 			builder.Owns(&endpoint.DNSEndpoint{})
 		} else {
@@ -285,20 +350,70 @@ func (r *routeReconciler) buildAndDeployModel(
 }
 
 // serviceStatusFromStack extracts the ServiceStatus from the deployed stack.
-// Each route produces exactly one Service in the stack, so we return the first with a non-nil Status.
+// Each route produces one Service for its first hostname, so we return the first with a non-nil Status
+// that was not built for an additional hostname or rule part.
 func serviceStatusFromStack(stack core.Stack) *latticemodel.ServiceStatus {
 	var resServices []*latticemodel.Service
 	if err := stack.ListResources(&resServices); err != nil {
 		return nil
 	}
 	for _, resSvc := range resServices {
-		if resSvc.Status != nil {
+		if resSvc.Status != nil && !resSvc.Spec.AdditionalHostname && resSvc.Spec.RulePart == 0 {
 			return resSvc.Status
 		}
 	}
 	return nil
 }
 
+// hostnameServiceStatusesFromStack returns the ServiceStatus of each deployed service with a custom
+// domain, keyed by hostname. Deleted services have no status and are left out.
+func hostnameServiceStatusesFromStack(stack core.Stack) map[string]latticemodel.ServiceStatus {
+	var resServices []*latticemodel.Service
+	if err := stack.ListResources(&resServices); err != nil {
+		return nil
+	}
+	statuses := make(map[string]latticemodel.ServiceStatus)
+	for _, resSvc := range resServices {
+		if resSvc.Status != nil && !resSvc.IsDeleted && resSvc.Spec.CustomerDomainName != "" && resSvc.Spec.RulePart == 0 {
+			statuses[resSvc.Spec.CustomerDomainName] = *resSvc.Status
+		}
+	}
+	return statuses
+}
+
+// rulePartServiceStatusesFromStack returns the ServiceStatus of each deployed service built for a rule part
+// of a split route, keyed by part. Deleted services have no status and are left out.
+func rulePartServiceStatusesFromStack(stack core.Stack) map[int]latticemodel.ServiceStatus {
+	var resServices []*latticemodel.Service
+	if err := stack.ListResources(&resServices); err != nil {
+		return nil
+	}
+	statuses := make(map[int]latticemodel.ServiceStatus)
+	for _, resSvc := range resServices {
+		if resSvc.Status != nil && !resSvc.IsDeleted && resSvc.Spec.RulePart > 0 {
+			statuses[resSvc.Spec.RulePart] = *resSvc.Status
+		}
+	}
+	return statuses
+}
+
+// ruleArnsFromStack groups the ARNs of deployed lattice rules by the index of the route rule they
+// were built from. A route rule with multiple matches produces one lattice rule per match and listener.
+func ruleArnsFromStack(stack core.Stack) map[int][]string {
+	var resRules []*latticemodel.Rule
+	if err := stack.ListResources(&resRules); err != nil {
+		return nil
+	}
+	ruleArns := make(map[int][]string)
+	for _, resRule := range resRules {
+		if resRule.Status == nil || resRule.Status.Arn == "" {
+			continue
+		}
+		ruleArns[resRule.Spec.RouteRuleIndex] = append(ruleArns[resRule.Spec.RouteRuleIndex], resRule.Status.Arn)
+	}
+	return ruleArns
+}
+
 func (r *routeReconciler) findControlledParentRef(ctx context.Context, route core.Route) (gwv1.ParentReference, error) {
 	gws, err := k8s.FindControlledParents(ctx, r.client, route)
 	if len(gws) <= 0 {
@@ -328,6 +443,20 @@ func (r *routeReconciler) setResolvedRefsFalse(ctx context.Context, route core.R
 	return nil
 }
 
+func (r *routeReconciler) setAcceptedFalse(ctx context.Context, route core.Route, reason, msg string) error {
+	parentRef, err := r.findControlledParentRef(ctx, route)
+	if err != nil {
+		return err
+	}
+	route.Status().UpdateParentRefs(parentRef, config.LatticeGatewayControllerName)
+	route.Status().UpdateRouteCondition(parentRef,
+		r.newCondition(route, gwv1.RouteConditionAccepted, gwv1.RouteConditionReason(reason), msg))
+	if err := r.client.Status().Update(ctx, route.K8sObject()); err != nil {
+		return fmt.Errorf("failed to update route status for %s: %w", reason, err)
+	}
+	return nil
+}
+
 func (r *routeReconciler) reconcileUpsert(ctx context.Context, req ctrl.Request, route core.Route) error {
 	r.log.Infow(ctx, "reconcile, adding or updating", "name", req.Name)
 	r.eventRecorder.Event(route.K8sObject(), corev1.EventTypeNormal,
@@ -411,6 +540,11 @@ func (r *routeReconciler) reconcileUpsert(ctx context.Context, req ctrl.Request,
 			return lattice_runtime.NewRequeueNeededAfter("certificate not found, retrying", 1*time.Minute)
 		}
 		switch {
+		case stderrors.Is(err, gateway.ErrUnsupportedMatch):
+			// the route spec has to change before it can be built, which triggers a new reconcile
+			return r.setAcceptedFalse(ctx, route, string(gwv1.RouteReasonUnsupportedValue), err.Error())
+		case stderrors.Is(err, gateway.ErrRuleQuotaExceeded):
+			return r.setAcceptedFalse(ctx, route, "RuleQuotaExceeded", err.Error())
 		case k8s.IsInvalidExternalTargetGroupError(err):
 			if statusErr := r.setResolvedRefsFalse(ctx, route, "InvalidExternalTargetGroup", err.Error()); statusErr != nil {
 				return statusErr
@@ -438,6 +572,18 @@ func (r *routeReconciler) reconcileUpsert(ctx context.Context, req ctrl.Request,
 		return err
 	}
 
+	if err := r.updateRouteStatusWithRuleInfo(ctx, route, ruleArnsFromStack(stack)); err != nil {
+		return err
+	}
+
+	if err := r.updateRouteStatusWithHostnameServices(ctx, route, hostnameServiceStatusesFromStack(stack)); err != nil {
+		return err
+	}
+
+	if err := r.updateRouteStatusWithRulePartServices(ctx, route, rulePartServiceStatusesFromStack(stack)); err != nil {
+		return err
+	}
+
 	// TODO: UpdateGWListenerStatus calls ListAllRoutes() (3 List API calls). With concurrent
 	// reconciles, this can cause transient count inaccuracies that self-correct on next reconcile.
 	// Consider debouncing gateway status updates or using an informer cache.
@@ -479,6 +625,105 @@ func (r *routeReconciler) updateRouteStatusWithServiceInfo(ctx context.Context,
 	return nil
 }
 
+func (r *routeReconciler) updateRouteStatusWithRuleInfo(ctx context.Context, route core.Route, ruleArns map[int][]string) error {
+	if len(ruleArns) == 0 {
+		return nil
+	}
+
+	// keys are marshalled in sorted order, so the annotation only changes when the rules do
+	ruleArnsJson, err := json.Marshal(ruleArns)
+	if err != nil {
+		return fmt.Errorf("failed to marshal rule arns due to err %w", err)
+	}
+	if route.K8sObject().GetAnnotations()[LatticeRuleArns] == string(ruleArnsJson) {
+		return nil
+	}
+
+	routeOld := route.DeepCopy()
+	if len(route.K8sObject().GetAnnotations()) == 0 {
+		route.K8sObject().SetAnnotations(make(map[string]string))
+	}
+	route.K8sObject().GetAnnotations()[LatticeRuleArns] = string(ruleArnsJson)
+
+	if err := r.client.Patch(ctx, route.K8sObject(), client.MergeFrom(routeOld.K8sObject())); err != nil {
+		return fmt.Errorf("failed to update route rule annotations due to err %w", err)
+	}
+
+	r.log.Debugf(ctx, "Updated route %s-%s with rule ARNs %s", route.Name(), route.Namespace(), ruleArnsJson)
+	return nil
+}
+
+// updateRouteStatusWithHostnameServices lists the service of every hostname on a route with one service per
+// hostname. The list is also used to find services of removed hostnames, so it is dropped only once the
+// mode is disabled and those services have been deleted.
+func (r *routeReconciler) updateRouteStatusWithHostnameServices(ctx context.Context, route core.Route, svcStatuses map[string]latticemodel.ServiceStatus) error {
+	current, exists := route.K8sObject().GetAnnotations()[k8s.LatticeServicesAnnotation]
+	enabled := k8s.IsServicePerHostnameEnabled(route)
+	if !enabled && !exists {
+		return nil
+	}
+
+	routeOld := route.DeepCopy()
+	if len(route.K8sObject().GetAnnotations()) == 0 {
+		route.K8sObject().SetAnnotations(make(map[string]string))
+	}
+	if enabled {
+		// keys are marshalled in sorted order, so the annotation only changes when the services do
+		svcStatusesJson, err := json.Marshal(svcStatuses)
+		if err != nil {
+			return fmt.Errorf("failed to marshal service statuses due to err %w", err)
+		}
+		if current == string(svcStatusesJson) {
+			return nil
+		}
+		route.K8sObject().GetAnnotations()[k8s.LatticeServicesAnnotation] = string(svcStatusesJson)
+	} else {
+		delete(route.K8sObject().GetAnnotations(), k8s.LatticeServicesAnnotation)
+	}
+
+	if err := r.client.Patch(ctx, route.K8sObject(), client.MergeFrom(routeOld.K8sObject())); err != nil {
+		return fmt.Errorf("failed to update route service annotations due to err %w", err)
+	}
+
+	r.log.Debugf(ctx, "Updated route %s-%s with hostname services", route.Name(), route.Namespace())
+	return nil
+}
+
+// updateRouteStatusWithRulePartServices lists the service of every rule part after the first on a split route.
+// The list is also used to find services of parts no longer needed, so it is dropped only once the route is
+// no longer split and those services have been deleted.
+func (r *routeReconciler) updateRouteStatusWithRulePartServices(ctx context.Context, route core.Route, svcStatuses map[int]latticemodel.ServiceStatus) error {
+	current, exists := route.K8sObject().GetAnnotations()[k8s.LatticeServicePartsAnnotation]
+	if len(svcStatuses) == 0 && !exists {
+		return nil
+	}
+
+	routeOld := route.DeepCopy()
+	if len(route.K8sObject().GetAnnotations()) == 0 {
+		route.K8sObject().SetAnnotations(make(map[string]string))
+	}
+	if len(svcStatuses) > 0 {
+		// keys are marshalled in sorted order, so the annotation only changes when the services do
+		svcStatusesJson, err := json.Marshal(svcStatuses)
+		if err != nil {
+			return fmt.Errorf("failed to marshal service statuses due to err %w", err)
+		}
+		if current == string(svcStatusesJson) {
+			return nil
+		}
+		route.K8sObject().GetAnnotations()[k8s.LatticeServicePartsAnnotation] = string(svcStatusesJson)
+	} else {
+		delete(route.K8sObject().GetAnnotations(), k8s.LatticeServicePartsAnnotation)
+	}
+
+	if err := r.client.Patch(ctx, route.K8sObject(), client.MergeFrom(routeOld.K8sObject())); err != nil {
+		return fmt.Errorf("failed to update route service annotations due to err %w", err)
+	}
+
+	r.log.Debugf(ctx, "Updated route %s-%s with rule part services", route.Name(), route.Namespace())
+	return nil
+}
+
 func (r *routeReconciler) validateBackendRefsIpFamilies(ctx context.Context, route core.Route) error {
 	rules := route.Spec().Rules()
 
@@ -486,8 +731,8 @@ func (r *routeReconciler) validateBackendRefsIpFamilies(ctx context.Context, rou
 		backendRefs := rule.BackendRefs()
 
 		for _, backendRef := range backendRefs {
-			// For now we skip checking service import
-			if *backendRef.Kind() == "ServiceImport" {
+			// For now we skip checking service import, lambda functions and load balancers are not services
+			if *backendRef.Kind() == "ServiceImport" || httpRouteOnlyBackendKinds.Contains(string(*backendRef.Kind())) {
 				continue
 			}
 
@@ -654,7 +899,10 @@ func (r *routeReconciler) validateRouteParentRefs(ctx context.Context, route cor
 }
 
 // set of valid Kinds for Route Backend References
-var validBackendKinds = k8sutils.NewSet("Service", "ServiceImport")
+var validBackendKinds = k8sutils.NewSet("Service", "ServiceImport", anv1alpha1.LambdaFunctionKind, anv1alpha1.ApplicationLoadBalancerKind)
+
+// set of Kinds for Route Backend References only supported by HTTPRoute
+var httpRouteOnlyBackendKinds = k8sutils.NewSet(anv1alpha1.LambdaFunctionKind, anv1alpha1.ApplicationLoadBalancerKind)
 
 // validate route's backed references, will return non-accepted
 // condition if at least one backendRef not in a valid state
@@ -669,11 +917,24 @@ func (r *routeReconciler) validateBackedRefs(ctx context.Context, route core.Rou
 			if !validBackendKinds.Contains(kind) {
 				return r.newCondition(route, gwv1.RouteConditionResolvedRefs, gwv1.RouteReasonInvalidKind, kind), nil
 			}
+			if _, ok := route.(*core.HTTPRoute); httpRouteOnlyBackendKinds.Contains(kind) && !ok {
+				msg := fmt.Sprintf("%s backendRefs are only supported by HTTPRoute", kind)
+				return r.newCondition(route, gwv1.RouteConditionResolvedRefs, gwv1.RouteReasonInvalidKind, msg), nil
+			}
 
 			namespace := route.Namespace()
 			if ref.Namespace() != nil {
 				namespace = string(*ref.Namespace())
 			}
+			permitted, err := k8s.IsBackendRefPermitted(ctx, r.client, route, gateway.BackendRefGrantTarget(route, ref))
+			if err != nil {
+				return empty, err
+			}
+			if !permitted {
+				msg := fmt.Sprintf("backendRef %s/%s is not permitted by any ReferenceGrant", namespace, ref.Name())
+				return r.newCondition(route, gwv1.RouteConditionResolvedRefs, gwv1.RouteReasonRefNotPermitted, msg), nil
+			}
+
 			objKey := types.NamespacedName{
 				Namespace: namespace,
 				Name:      string(ref.Name()),
@@ -685,10 +946,14 @@ func (r *routeReconciler) validateBackedRefs(ctx context.Context, route core.Rou
 				obj = &corev1.Service{}
 			case "ServiceImport":
 				obj = &anv1alpha1.ServiceImport{}
+			case anv1alpha1.LambdaFunctionKind:
+				obj = &anv1alpha1.LambdaFunction{}
+			case anv1alpha1.ApplicationLoadBalancerKind:
+				obj = &anv1alpha1.ApplicationLoadBalancer{}
 			default:
 				return empty, fmt.Errorf("invalid backed end ref kind, must be validated before, kind=%s", kind)
 			}
-			err := r.client.Get(ctx, objKey, obj)
+			err = r.client.Get(ctx, objKey, obj)
 			if err != nil {
 				if apierrors.IsNotFound(err) {
 					msg := fmt.Sprintf("backendRef name: %s", ref.Name())
@@ -37,6 +37,7 @@ import (
 	"sigs.k8s.io/external-dns/endpoint"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
 func TestRouteReconciler_ReconcileCreates(t *testing.T) {
@@ -315,7 +316,7 @@ func TestRouteReconciler_ReconcileCreates(t *testing.T) {
 		finalizerManager: mockFinalizer,
 		eventRecorder:    mockEventRecorder,
 		modelBuilder:     gateway.NewLatticeServiceBuilder(gwlog.FallbackLogger, k8sClient, brTgBuilder, nil, nil),
-		stackDeployer:    deploy.NewLatticeServiceStackDeploy(gwlog.FallbackLogger, mockCloud, k8sClient),
+		stackDeployer:    deploy.NewLatticeServiceStackDeploy(gwlog.FallbackLogger, mockCloud, k8sClient, nil),
 		stackMarshaller:  deploy.NewDefaultStackMarshaller(),
 		cloud:            mockCloud,
 	}
@@ -402,6 +403,289 @@ func TestRouteReconciler_UpdateRouteStatusWithServiceInfo(t *testing.T) {
 	})
 }
 
+func TestRouteReconciler_UpdateRouteStatusWithRuleInfo(t *testing.T) {
+	ctx := context.TODO()
+
+	k8sScheme := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sScheme)
+	gwv1.Install(k8sScheme)
+
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sScheme).Build()
+	route := &gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-route",
+			Namespace: "test-ns",
+		},
+		Spec: gwv1.HTTPRouteSpec{},
+	}
+	k8sClient.Create(ctx, route)
+
+	rc := routeReconciler{
+		routeType: core.HttpRouteType,
+		log:       gwlog.FallbackLogger,
+		client:    k8sClient,
+	}
+
+	stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(route)))
+	for i, spec := range []latticemodel.RuleSpec{
+		{Priority: 1, RouteRuleIndex: 0, MatchIndex: 0},
+		{Priority: 2, RouteRuleIndex: 0, MatchIndex: 1},
+		{Priority: 3, RouteRuleIndex: 1, MatchIndex: 0},
+	} {
+		rule, err := latticemodel.NewRule(stack, spec)
+		assert.Nil(t, err)
+		rule.Status = &latticemodel.RuleStatus{Arn: fmt.Sprintf("rule-arn-%d", i)}
+	}
+
+	coreRoute, _ := core.GetHTTPRoute(ctx, k8sClient, k8s.NamespacedName(route))
+	err := rc.updateRouteStatusWithRuleInfo(ctx, coreRoute, ruleArnsFromStack(stack))
+	assert.Nil(t, err)
+
+	updatedRoute := &gwv1.HTTPRoute{}
+	k8sClient.Get(ctx, k8s.NamespacedName(route), updatedRoute)
+	assert.Equal(t, `{"0":["rule-arn-0","rule-arn-1"],"1":["rule-arn-2"]}`, updatedRoute.GetAnnotations()[LatticeRuleArns])
+}
+
+func TestRouteReconciler_UpdateRouteStatusWithHostnameServices(t *testing.T) {
+	ctx := context.TODO()
+
+	k8sScheme := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sScheme)
+	gwv1.Install(k8sScheme)
+
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sScheme).Build()
+	route := &gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-route",
+			Namespace: "test-ns",
+			Annotations: map[string]string{
+				k8s.ServicePerHostnameAnnotation: "true",
+			},
+		},
+		Spec: gwv1.HTTPRouteSpec{},
+	}
+	k8sClient.Create(ctx, route)
+
+	rc := routeReconciler{
+		routeType: core.HttpRouteType,
+		log:       gwlog.FallbackLogger,
+		client:    k8sClient,
+	}
+
+	stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(route)))
+	for _, spec := range []latticemodel.ServiceSpec{
+		{CustomerDomainName: "legacy.example.com"},
+		{CustomerDomainName: "new.example.com", AdditionalHostname: true},
+		{CustomerDomainName: "old.example.com", AdditionalHostname: true},
+	} {
+		spec.ServiceTagFields = latticemodel.ServiceTagFields{RouteName: "test-route", RouteNamespace: "test-ns"}
+		svc, err := latticemodel.NewLatticeService(stack, spec)
+		assert.Nil(t, err)
+		if spec.CustomerDomainName == "old.example.com" {
+			svc.IsDeleted = true
+			continue
+		}
+		svc.Status = &latticemodel.ServiceStatus{
+			Arn: "arn-" + spec.CustomerDomainName,
+			Id:  "id-" + spec.CustomerDomainName,
+			Dns: "dns-" + spec.CustomerDomainName,
+		}
+	}
+	assert.Equal(t, "arn-legacy.example.com", serviceStatusFromStack(stack).Arn)
+
+	coreRoute, _ := core.GetHTTPRoute(ctx, k8sClient, k8s.NamespacedName(route))
+	err := rc.updateRouteStatusWithHostnameServices(ctx, coreRoute, hostnameServiceStatusesFromStack(stack))
+	assert.Nil(t, err)
+
+	updatedRoute := &gwv1.HTTPRoute{}
+	k8sClient.Get(ctx, k8s.NamespacedName(route), updatedRoute)
+	assert.Equal(t,
+		`{"legacy.example.com":{"arn":"arn-legacy.example.com","id":"id-legacy.example.com","dns":"dns-legacy.example.com"},`+
+			`"new.example.com":{"arn":"arn-new.example.com","id":"id-new.example.com","dns":"dns-new.example.com"}}`,
+		updatedRoute.GetAnnotations()[k8s.LatticeServicesAnnotation])
+
+	// disabling the mode drops the annotation
+	updatedRoute.Annotations[k8s.ServicePerHostnameAnnotation] = "false"
+	assert.Nil(t, k8sClient.Update(ctx, updatedRoute))
+	coreRoute, _ = core.GetHTTPRoute(ctx, k8sClient, k8s.NamespacedName(route))
+	err = rc.updateRouteStatusWithHostnameServices(ctx, coreRoute, nil)
+	assert.Nil(t, err)
+
+	k8sClient.Get(ctx, k8s.NamespacedName(route), updatedRoute)
+	assert.NotContains(t, updatedRoute.GetAnnotations(), k8s.LatticeServicesAnnotation)
+}
+
+func TestRouteReconciler_UpdateRouteStatusWithRulePartServices(t *testing.T) {
+	ctx := context.TODO()
+
+	k8sScheme := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sScheme)
+	gwv1.Install(k8sScheme)
+
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sScheme).Build()
+	route := &gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-route",
+			Namespace: "test-ns",
+		},
+		Spec: gwv1.HTTPRouteSpec{},
+	}
+	k8sClient.Create(ctx, route)
+
+	rc := routeReconciler{
+		routeType: core.HttpRouteType,
+		log:       gwlog.FallbackLogger,
+		client:    k8sClient,
+	}
+
+	stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(route)))
+	for _, spec := range []latticemodel.ServiceSpec{
+		{CustomerDomainName: "api.example.com"},
+		{CustomerDomainName: "part1.api.example.com", RulePart: 1},
+		{CustomerDomainName: "part2.api.example.com", RulePart: 2},
+	} {
+		spec.ServiceTagFields = latticemodel.ServiceTagFields{RouteName: "test-route", RouteNamespace: "test-ns"}
+		svc, err := latticemodel.NewLatticeService(stack, spec)
+		assert.Nil(t, err)
+		if spec.RulePart == 2 {
+			svc.IsDeleted = true
+			continue
+		}
+		svc.Status = &latticemodel.ServiceStatus{
+			Arn: "arn-" + spec.CustomerDomainName,
+			Id:  "id-" + spec.CustomerDomainName,
+			Dns: "dns-" + spec.CustomerDomainName,
+		}
+	}
+	assert.Equal(t, "arn-api.example.com", serviceStatusFromStack(stack).Arn)
+	assert.NotContains(t, hostnameServiceStatusesFromStack(stack), "part1.api.example.com")
+
+	coreRoute, _ := core.GetHTTPRoute(ctx, k8sClient, k8s.NamespacedName(route))
+	err := rc.updateRouteStatusWithRulePartServices(ctx, coreRoute, rulePartServiceStatusesFromStack(stack))
+	assert.Nil(t, err)
+
+	updatedRoute := &gwv1.HTTPRoute{}
+	k8sClient.Get(ctx, k8s.NamespacedName(route), updatedRoute)
+	assert.Equal(t,
+		`{"1":{"arn":"arn-part1.api.example.com","id":"id-part1.api.example.com","dns":"dns-part1.api.example.com"}}`,
+		updatedRoute.GetAnnotations()[k8s.LatticeServicePartsAnnotation])
+
+	// a route that is no longer split drops the annotation
+	coreRoute, _ = core.GetHTTPRoute(ctx, k8sClient, k8s.NamespacedName(route))
+	err = rc.updateRouteStatusWithRulePartServices(ctx, coreRoute, nil)
+	assert.Nil(t, err)
+
+	k8sClient.Get(ctx, k8s.NamespacedName(route), updatedRoute)
+	assert.NotContains(t, updatedRoute.GetAnnotations(), k8s.LatticeServicePartsAnnotation)
+}
+
+func TestRouteReconciler_ValidateBackendRefs_ReferenceGrant(t *testing.T) {
+	ctx := context.TODO()
+
+	k8sScheme := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sScheme)
+	gwv1.Install(k8sScheme)
+	gwv1beta1.Install(k8sScheme)
+
+	backendNs := gwv1.Namespace("backend-ns")
+	route := &gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-route",
+			Namespace: "route-ns",
+		},
+		Spec: gwv1.HTTPRouteSpec{
+			Rules: []gwv1.HTTPRouteRule{
+				{
+					BackendRefs: []gwv1.HTTPBackendRef{
+						{
+							BackendRef: gwv1.BackendRef{
+								BackendObjectReference: gwv1.BackendObjectReference{
+									Name:      "backend-svc",
+									Namespace: &backendNs,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "backend-svc",
+			Namespace: string(backendNs),
+		},
+	}
+	grantFor := func(fromNamespace string, toName *gwv1.ObjectName) *gwv1beta1.ReferenceGrant {
+		return &gwv1beta1.ReferenceGrant{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "grant",
+				Namespace: string(backendNs),
+			},
+			Spec: gwv1beta1.ReferenceGrantSpec{
+				From: []gwv1beta1.ReferenceGrantFrom{
+					{Group: gwv1.GroupName, Kind: "HTTPRoute", Namespace: gwv1.Namespace(fromNamespace)},
+				},
+				To: []gwv1beta1.ReferenceGrantTo{
+					{Group: "", Kind: "Service", Name: toName},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		grant          *gwv1beta1.ReferenceGrant
+		expectedReason gwv1.RouteConditionReason
+	}{
+		{
+			name:           "no grant",
+			expectedReason: gwv1.RouteReasonRefNotPermitted,
+		},
+		{
+			name:           "grant for another namespace",
+			grant:          grantFor("other-ns", nil),
+			expectedReason: gwv1.RouteReasonRefNotPermitted,
+		},
+		{
+			name:           "grant for another service",
+			grant:          grantFor("route-ns", (*gwv1.ObjectName)(aws.String("other-svc"))),
+			expectedReason: gwv1.RouteReasonRefNotPermitted,
+		},
+		{
+			name:           "grant for all services",
+			grant:          grantFor("route-ns", nil),
+			expectedReason: gwv1.RouteReasonResolvedRefs,
+		},
+		{
+			name:           "grant for named service",
+			grant:          grantFor("route-ns", (*gwv1.ObjectName)(aws.String("backend-svc"))),
+			expectedReason: gwv1.RouteReasonResolvedRefs,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sScheme).Build()
+			assert.NoError(t, k8sClient.Create(ctx, svc.DeepCopy()))
+			if tt.grant != nil {
+				assert.NoError(t, k8sClient.Create(ctx, tt.grant))
+			}
+
+			rc := routeReconciler{
+				routeType: core.HttpRouteType,
+				log:       gwlog.FallbackLogger,
+				client:    k8sClient,
+			}
+
+			cnd, err := rc.validateBackedRefs(ctx, core.NewHTTPRoute(*route))
+			assert.NoError(t, err)
+			assert.Equal(t, string(gwv1.RouteConditionResolvedRefs), cnd.Type)
+			assert.Equal(t, string(tt.expectedReason), cnd.Reason)
+		})
+	}
+}
+
 func TestUpdateRouteListenerStatus_UpdatesGatewayAttachedRoutes(t *testing.T) {
 	ctx := context.Background()
 
@@ -603,6 +887,137 @@ func TestRouteReconciler_CertificateNotFound(t *testing.T) {
 	assert.Contains(t, acceptedCond.Message, "no matching ACM certificate found")
 }
 
+func TestRouteReconciler_AcceptedFalse(t *testing.T) {
+	tests := []struct {
+		name            string
+		buildErr        error
+		expectedReason  string
+		expectedMessage string
+	}{
+		{
+			name:            "unsupported match",
+			buildErr:        fmt.Errorf("%w in rules[1].matches[0]: unsupported regular expression", gateway.ErrUnsupportedMatch),
+			expectedReason:  string(gwv1.RouteReasonUnsupportedValue),
+			expectedMessage: "rules[1].matches[0]",
+		},
+		{
+			name:            "rule quota exceeded",
+			buildErr:        fmt.Errorf("%w: route ns1/my-route needs 140 rules, listener allows 100", gateway.ErrRuleQuotaExceeded),
+			expectedReason:  "RuleQuotaExceeded",
+			expectedMessage: "needs 140 rules, listener allows 100",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testRouteReconcilerAcceptedFalse(t, tt.buildErr, tt.expectedReason, tt.expectedMessage)
+		})
+	}
+}
+
+func testRouteReconcilerAcceptedFalse(t *testing.T, buildErr error, expectedReason, expectedMessage string) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+	ctx := context.TODO()
+
+	k8sScheme := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sScheme)
+	gwv1.Install(k8sScheme)
+	gwv1alpha2.Install(k8sScheme)
+	discoveryv1.AddToScheme(k8sScheme)
+	addOptionalCRDs(k8sScheme)
+
+	gwClass := &gwv1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "amazon-vpc-lattice",
+		},
+		Spec: gwv1.GatewayClassSpec{
+			ControllerName: config.LatticeGatewayControllerName,
+		},
+	}
+
+	gw := &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-gateway",
+			Namespace: "ns1",
+		},
+		Spec: gwv1.GatewaySpec{
+			GatewayClassName: "amazon-vpc-lattice",
+			Listeners: []gwv1.Listener{
+				{
+					Name:     "http",
+					Protocol: "HTTP",
+					Port:     80,
+				},
+			},
+		},
+	}
+
+	route := &gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-route",
+			Namespace: "ns1",
+		},
+		Spec: gwv1.HTTPRouteSpec{
+			CommonRouteSpec: gwv1.CommonRouteSpec{
+				ParentRefs: []gwv1.ParentReference{
+					{
+						Name: "my-gateway",
+					},
+				},
+			},
+		},
+	}
+
+	k8sClient := testclient.NewClientBuilder().
+		WithScheme(k8sScheme).
+		WithObjects(gwClass, gw, route).
+		WithStatusSubresource(&gwv1.HTTPRoute{}).
+		Build()
+
+	mockBuilder := gateway.NewMockLatticeServiceBuilder(c)
+	mockBuilder.EXPECT().Build(gomock.Any(), gomock.Any()).Return(nil, buildErr)
+
+	mockEventRecorder := mock_client.NewMockEventRecorder(c)
+	mockEventRecorder.EXPECT().Event(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	mockFinalizer := k8s.NewMockFinalizerManager(c)
+	mockFinalizer.EXPECT().AddFinalizers(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	rc := routeReconciler{
+		routeType:        core.HttpRouteType,
+		log:              gwlog.FallbackLogger,
+		client:           k8sClient,
+		scheme:           k8sScheme,
+		finalizerManager: mockFinalizer,
+		eventRecorder:    mockEventRecorder,
+		modelBuilder:     mockBuilder,
+	}
+
+	routeName := k8s.NamespacedName(route)
+	result, err := rc.Reconcile(ctx, reconcile.Request{NamespacedName: routeName})
+	assert.Nil(t, err)
+	assert.Equal(t, time.Duration(0), result.RequeueAfter)
+
+	// Verify route status was updated with Accepted: False
+	updatedRoute := &gwv1.HTTPRoute{}
+	k8sClient.Get(ctx, routeName, updatedRoute)
+
+	var acceptedCond *metav1.Condition
+	for _, parent := range updatedRoute.Status.Parents {
+		for i, cond := range parent.Conditions {
+			if cond.Type == string(gwv1.RouteConditionAccepted) {
+				acceptedCond = &parent.Conditions[i]
+				break
+			}
+		}
+	}
+	assert.NotNil(t, acceptedCond)
+	assert.Equal(t, metav1.ConditionFalse, acceptedCond.Status)
+	assert.Equal(t, expectedReason, acceptedCond.Reason)
+	assert.Contains(t, acceptedCond.Message, expectedMessage)
+}
+
 func TestRouteReconciler_ExternalTargetGroupStatusSurfacing(t *testing.T) {
 	const arn = "arn:aws:vpc-lattice:us-west-2:123456789012:targetgroup/tg-0df85aff983932f06"
 
@@ -18,16 +18,30 @@ package controllers
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sync"
 
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	pkg_builder "sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/aws/aws-application-networking-k8s/pkg/aws"
+	"github.com/aws/aws-application-networking-k8s/pkg/config"
+	"github.com/aws/aws-application-networking-k8s/pkg/gateway"
 	"github.com/aws/aws-application-networking-k8s/pkg/k8s"
+	policy "github.com/aws/aws-application-networking-k8s/pkg/k8s/policyhelper"
+	model "github.com/aws/aws-application-networking-k8s/pkg/model/lattice"
 	lattice_runtime "github.com/aws/aws-application-networking-k8s/pkg/runtime"
+	"github.com/aws/aws-application-networking-k8s/pkg/utils"
 	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
 )
 
@@ -41,6 +55,11 @@ type serviceReconciler struct {
 	scheme           *runtime.Scheme
 	finalizerManager k8s.FinalizerManager
 	eventRecorder    record.EventRecorder
+	tgp              *policy.PolicyHandler[*TGP]
+
+	// service ports whose pods disagree on their readiness probe, reported once until they agree again
+	probeConflictsLock sync.Mutex
+	probeConflicts     utils.Set[string]
 }
 
 func RegisterServiceController(
@@ -58,11 +77,50 @@ func RegisterServiceController(
 		scheme:           scheme,
 		finalizerManager: finalizerManager,
 		eventRecorder:    evtRec,
+		tgp:              policy.NewTargetGroupPolicyHandler(log, client),
+		probeConflicts:   utils.NewSet[string](),
+	}
+	builder := ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.Service{})
+	if config.ReadinessProbeHealthChecks {
+		// pods rolling out change the endpoints of their service, which is when their readiness probes may disagree
+		builder.Watches(&discoveryv1.EndpointSlice{}, handler.EnqueueRequestsFromMapFunc(endpointSliceToService),
+			pkg_builder.WithPredicates(predicate.NewPredicateFuncs(sr.usesReadinessProbes)))
+	}
+	return builder.Complete(sr)
+}
+
+func endpointSliceToService(ctx context.Context, obj client.Object) []reconcile.Request {
+	svcName, ok := obj.GetLabels()[discoveryv1.LabelServiceName]
+	if !ok {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: svcName}}}
+}
+
+// usesReadinessProbes tells whether the health check of a port of the service of an EndpointSlice is derived from
+// readiness probes
+func (r *serviceReconciler) usesReadinessProbes(obj client.Object) bool {
+	ctx := context.TODO()
+	svcName, ok := obj.GetLabels()[discoveryv1.LabelServiceName]
+	if !ok {
+		return false
+	}
+	svc := &corev1.Service{}
+	if err := r.client.Get(ctx, types.NamespacedName{Namespace: obj.GetNamespace(), Name: svcName}, svc); err != nil {
+		return false
 	}
-	err := ctrl.NewControllerManagedBy(mgr).
-		For(&corev1.Service{}).
-		Complete(sr)
-	return err
+	for _, port := range svc.Spec.Ports {
+		tgp, err := policy.ResolveTargetGroupPolicy(ctx, r.tgp, svc, port.Name, nil)
+		if err != nil {
+			// the service reconcile reports the error
+			return true
+		}
+		if gateway.ReadinessProbeHealthCheckEnabled(tgp) {
+			return true
+		}
+	}
+	return false
 }
 
 //+kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
@@ -96,8 +154,64 @@ func (r *serviceReconciler) reconcile(ctx context.Context, req ctrl.Request) err
 	}
 	if !svc.DeletionTimestamp.IsZero() {
 		r.finalizerManager.RemoveFinalizers(ctx, svc, serviceFinalizer)
+	} else if err := r.reportReadinessProbeConflicts(ctx, svc); err != nil {
+		return err
 	}
 
 	r.log.Infow(ctx, "reconciled", "name", req.Name)
 	return nil
 }
+
+// reportReadinessProbeConflicts records an event on the service for each port whose pods start disagreeing on their
+// readiness probe, when its health check is derived from readiness probes. The target groups of the port then keep
+// the health check the pods last agreed on. Conflicts are only reported with readiness probe health checks enabled
+// for the controller
+func (r *serviceReconciler) reportReadinessProbeConflicts(ctx context.Context, svc *corev1.Service) error {
+	if !config.ReadinessProbeHealthChecks {
+		return nil
+	}
+	for _, port := range svc.Spec.Ports {
+		tgp, err := policy.ResolveTargetGroupPolicy(ctx, r.tgp, svc, port.Name, nil)
+		if err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%s/%s:%d", svc.Namespace, svc.Name, port.Port)
+		if !gateway.ReadinessProbeHealthCheckEnabled(tgp) {
+			r.setReadinessProbeConflict(key, false)
+			continue
+		}
+		tgType := model.TargetGroupTypeIP
+		if tgp != nil && tgp.Spec.TargetType != nil && *tgp.Spec.TargetType == string(model.TargetGroupTypeInstance) {
+			tgType = model.TargetGroupTypeInstance
+		}
+		_, err = gateway.BuildReadinessProbeHealthCheckConfig(ctx, r.client, svc, port.Port, tgType)
+		conflict := &gateway.ReadinessProbeConflictError{}
+		if errors.As(err, &conflict) {
+			if r.setReadinessProbeConflict(key, true) {
+				r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonReadinessProbeConflict,
+					conflict.Error()+", keeping the current health check until they agree")
+			}
+		} else if err != nil {
+			return err
+		} else {
+			r.setReadinessProbeConflict(key, false)
+		}
+	}
+	return nil
+}
+
+// setReadinessProbeConflict records whether the pods behind a service port disagree on their readiness probe, and
+// reports whether they just started to
+func (r *serviceReconciler) setReadinessProbeConflict(key string, conflict bool) bool {
+	r.probeConflictsLock.Lock()
+	defer r.probeConflictsLock.Unlock()
+	if !conflict {
+		r.probeConflicts.Delete(key)
+		return false
+	}
+	if r.probeConflicts.Contains(key) {
+		return false
+	}
+	r.probeConflicts.Put(key)
+	return true
+}
@@ -22,16 +22,19 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-application-networking-k8s/pkg/controllers/eventhandlers"
+	"github.com/aws/aws-application-networking-k8s/pkg/controllers/predicates"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	anv1alpha1 "github.com/aws/aws-application-networking-k8s/pkg/apis/applicationnetworking/v1alpha1"
 	"github.com/aws/aws-application-networking-k8s/pkg/aws"
 	"github.com/aws/aws-application-networking-k8s/pkg/deploy"
+	"github.com/aws/aws-application-networking-k8s/pkg/deploy/lattice"
 	"github.com/aws/aws-application-networking-k8s/pkg/gateway"
 	"github.com/aws/aws-application-networking-k8s/pkg/k8s"
 	lattice_runtime "github.com/aws/aws-application-networking-k8s/pkg/runtime"
@@ -59,13 +62,14 @@ func RegisterServiceExportController(
 	cloud aws.Cloud,
 	finalizerManager k8s.FinalizerManager,
 	mgr ctrl.Manager,
+	targetsFastPath *lattice.TargetsFastPath,
 ) error {
 	mgrClient := mgr.GetClient()
 	scheme := mgr.GetScheme()
 	eventRecorder := mgr.GetEventRecorderFor("serviceExport")
 
 	modelBuilder := gateway.NewSvcExportTargetGroupBuilder(log, mgrClient)
-	stackDeploy := deploy.NewTargetGroupStackDeploy(log, cloud, mgrClient)
+	stackDeploy := deploy.NewTargetGroupStackDeploy(log, cloud, mgrClient, targetsFastPath)
 	stackMarshaller := deploy.NewDefaultStackMarshaller()
 
 	r := &serviceExportReconciler{
@@ -80,6 +84,10 @@ func RegisterServiceExportController(
 	}
 
 	svcEventHandler := eventhandlers.NewServiceEventHandler(log, r.client)
+	if targetsFastPath != nil {
+		svcEventHandler.WithTargetsFastPath(targetsFastPath)
+	}
+	nodePredicate := builder.WithPredicates(predicates.NodeProviderIdChangedPredicate)
 
 	builder := ctrl.NewControllerManagedBy(mgr).
 		For(&anv1alpha1.ServiceExport{}).
@@ -88,6 +96,8 @@ func RegisterServiceExportController(
 
 	if ok, err := k8s.IsGVKSupported(mgr, anv1alpha1.GroupVersion.String(), anv1alpha1.TargetGroupPolicyKind); ok {
 		builder.Watches(&anv1alpha1.TargetGroupPolicy{}, svcEventHandler.MapToServiceExport())
+		// nodes are the targets of INSTANCE target groups, which are selected by a TargetGroupPolicy
+		builder.Watches(&corev1.Node{}, svcEventHandler.MapToServiceExport(), nodePredicate)
 	} else {
 		if err != nil {
 			return err
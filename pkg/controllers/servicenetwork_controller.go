@@ -36,6 +36,7 @@ import (
 	"github.com/aws/aws-application-networking-k8s/pkg/controllers/predicates"
 	deploy "github.com/aws/aws-application-networking-k8s/pkg/deploy/lattice"
 	"github.com/aws/aws-application-networking-k8s/pkg/k8s"
+	model "github.com/aws/aws-application-networking-k8s/pkg/model/lattice"
 	lattice_runtime "github.com/aws/aws-application-networking-k8s/pkg/runtime"
 	"github.com/aws/aws-application-networking-k8s/pkg/utils"
 	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
@@ -132,11 +133,23 @@ func (r *serviceNetworkReconciler) reconcileUpsert(ctx context.Context, sn *anv1
 
 	status, err := r.snManager.Upsert(ctx, sn.Name, additionalTags)
 	if err != nil {
-		r.updateStatus(ctx, sn, metav1.ConditionFalse, "ReconcileError", err.Error(), sn.Status.ServiceNetworkARN, sn.Status.ServiceNetworkID)
+		r.updateStatus(ctx, sn, metav1.ConditionFalse, "ReconcileError", err.Error(), sn.Status.ServiceNetworkARN, sn.Status.ServiceNetworkID, nil)
 		return err
 	}
 
-	r.updateStatus(ctx, sn, metav1.ConditionTrue, "Programmed", "ServiceNetwork is programmed", status.ServiceNetworkARN, status.ServiceNetworkID)
+	cfgStatus, err := r.snManager.UpsertConfig(ctx, status, model.NewServiceNetworkConfig(sn, additionalTags))
+	if err != nil {
+		// the sub-resource status is only complete when waiting on VPC associations
+		var requeueNeededAfter *lattice_runtime.RequeueNeededAfter
+		if errors.As(err, &requeueNeededAfter) {
+			r.updateStatus(ctx, sn, metav1.ConditionFalse, "Pending", err.Error(), status.ServiceNetworkARN, status.ServiceNetworkID, &cfgStatus)
+		} else {
+			r.updateStatus(ctx, sn, metav1.ConditionFalse, "ReconcileError", err.Error(), status.ServiceNetworkARN, status.ServiceNetworkID, nil)
+		}
+		return err
+	}
+
+	r.updateStatus(ctx, sn, metav1.ConditionTrue, "Programmed", "ServiceNetwork is programmed", status.ServiceNetworkARN, status.ServiceNetworkID, &cfgStatus)
 	return nil
 }
 
@@ -151,20 +164,22 @@ func (r *serviceNetworkReconciler) reconcileDelete(ctx context.Context, sn *anv1
 		if gw.Name == sn.Name && gw.DeletionTimestamp.IsZero() &&
 			k8s.IsControlledByLatticeGatewayController(ctx, r.client, gw) {
 			msg := fmt.Sprintf("Cannot delete: Gateway %s/%s still references this service network", gw.Namespace, gw.Name)
-			r.updateStatus(ctx, sn, metav1.ConditionFalse, "DeleteBlocked", msg, sn.Status.ServiceNetworkARN, sn.Status.ServiceNetworkID)
+			r.updateStatus(ctx, sn, metav1.ConditionFalse, "DeleteBlocked", msg, sn.Status.ServiceNetworkARN, sn.Status.ServiceNetworkID, nil)
 			return errors.New(msg)
 		}
 	}
 
-	if err := r.snManager.Delete(ctx, sn.Name); err != nil {
-		r.updateStatus(ctx, sn, metav1.ConditionFalse, "DeleteError", err.Error(), sn.Status.ServiceNetworkARN, sn.Status.ServiceNetworkID)
+	shared := sn.Spec.Sharing != nil || sn.Status.Sharing != nil
+	if err := r.snManager.Delete(ctx, sn.Name, shared); err != nil {
+		r.updateStatus(ctx, sn, metav1.ConditionFalse, "DeleteError", err.Error(), sn.Status.ServiceNetworkARN, sn.Status.ServiceNetworkID, nil)
 		return err
 	}
 
 	return r.finalizerManager.RemoveFinalizers(ctx, sn, serviceNetworkFinalizer)
 }
 
-func (r *serviceNetworkReconciler) updateStatus(ctx context.Context, sn *anv1alpha1.ServiceNetwork, programmedStatus metav1.ConditionStatus, reason, message, arn, id string) {
+// updateStatus sets the conditions of the ServiceNetwork, along with the status of its sub-resources when cfgStatus is not nil
+func (r *serviceNetworkReconciler) updateStatus(ctx context.Context, sn *anv1alpha1.ServiceNetwork, programmedStatus metav1.ConditionStatus, reason, message, arn, id string, cfgStatus *model.ServiceNetworkConfigStatus) {
 	snOld := sn.DeepCopy()
 
 	sn.Status.Conditions = utils.GetNewConditions(sn.Status.Conditions, metav1.Condition{
@@ -183,8 +198,47 @@ func (r *serviceNetworkReconciler) updateStatus(ctx context.Context, sn *anv1alp
 	})
 	sn.Status.ServiceNetworkARN = arn
 	sn.Status.ServiceNetworkID = id
+	if cfgStatus != nil {
+		setConfigStatus(sn, cfgStatus)
+	}
 
 	if err := r.client.Status().Patch(ctx, sn, client.MergeFrom(snOld)); err != nil {
 		r.log.Errorf(ctx, "Failed to update ServiceNetwork status: %s", err)
 	}
 }
+
+func setConfigStatus(sn *anv1alpha1.ServiceNetwork, cfgStatus *model.ServiceNetworkConfigStatus) {
+	sn.Status.AuthType = cfgStatus.AuthType
+	sn.Status.AuthPolicyState = cfgStatus.AuthPolicyState
+	sn.Status.AccessLogSubscriptions = nil
+	for _, als := range cfgStatus.AccessLogSubscriptions {
+		sn.Status.AccessLogSubscriptions = append(sn.Status.AccessLogSubscriptions, anv1alpha1.ServiceNetworkAccessLogSubscriptionStatus{
+			DestinationArn: als.DestinationArn,
+			Arn:            als.Arn,
+		})
+	}
+	sn.Status.VpcAssociations = nil
+	for _, snva := range cfgStatus.VpcAssociations {
+		sn.Status.VpcAssociations = append(sn.Status.VpcAssociations, anv1alpha1.ServiceNetworkVpcAssociationStatus{
+			VpcId:            snva.VpcId,
+			Arn:              snva.Arn,
+			Status:           snva.Status,
+			SecurityGroupIds: snva.SecurityGroupIds,
+		})
+	}
+	sn.Status.Sharing = nil
+	if sharing := cfgStatus.Sharing; sharing != nil {
+		sn.Status.Sharing = &anv1alpha1.ServiceNetworkSharingStatus{
+			ResourceShareArn: sharing.ResourceShareArn,
+			Status:           sharing.Status,
+		}
+		for _, principal := range sharing.Principals {
+			sn.Status.Sharing.Principals = append(sn.Status.Sharing.Principals, anv1alpha1.ServiceNetworkSharingPrincipalStatus{
+				Principal:         principal.Principal,
+				AssociationStatus: principal.AssociationStatus,
+				InvitationStatus:  principal.InvitationStatus,
+				Message:           principal.Message,
+			})
+		}
+	}
+}
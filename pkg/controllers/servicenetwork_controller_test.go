@@ -12,9 +12,12 @@ import (
 	deploy "github.com/aws/aws-application-networking-k8s/pkg/deploy/lattice"
 	"github.com/aws/aws-application-networking-k8s/pkg/k8s"
 	model "github.com/aws/aws-application-networking-k8s/pkg/model/lattice"
+	lattice_runtime "github.com/aws/aws-application-networking-k8s/pkg/runtime"
 	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -35,7 +38,8 @@ func newSchemeForSNTest() *runtime.Scheme {
 	gwv1.Install(s)
 
 	gv := schema.GroupVersion{Group: anv1alpha1.GroupName, Version: "v1alpha1"}
-	s.AddKnownTypes(gv, &anv1alpha1.ServiceNetwork{}, &anv1alpha1.ServiceNetworkList{})
+	s.AddKnownTypes(gv, &anv1alpha1.ServiceNetwork{}, &anv1alpha1.ServiceNetworkList{},
+		&anv1alpha1.ServiceNetworkEndpoint{}, &anv1alpha1.ServiceNetworkEndpointList{})
 	metav1.AddToGroupVersion(s, gv)
 	return s
 }
@@ -61,6 +65,8 @@ func TestServiceNetworkReconciler_UpsertCreatesNew(t *testing.T) {
 			ServiceNetworkARN: "arn:aws:vpc-lattice:us-west-2:123456789:servicenetwork/sn-123",
 			ServiceNetworkID:  "sn-123",
 		}, nil)
+	mockSNManager.EXPECT().UpsertConfig(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(model.ServiceNetworkConfigStatus{AuthType: "NONE"}, nil)
 
 	mockFinalizer := k8s.NewMockFinalizerManager(c)
 	mockFinalizer.EXPECT().AddFinalizers(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
@@ -85,6 +91,7 @@ func TestServiceNetworkReconciler_UpsertCreatesNew(t *testing.T) {
 	k8sClient.Get(ctx, types.NamespacedName{Name: "my-network"}, updated)
 	assert.Equal(t, "arn:aws:vpc-lattice:us-west-2:123456789:servicenetwork/sn-123", updated.Status.ServiceNetworkARN)
 	assert.Equal(t, "sn-123", updated.Status.ServiceNetworkID)
+	assert.Equal(t, "NONE", updated.Status.AuthType)
 }
 
 func TestServiceNetworkReconciler_UpsertError(t *testing.T) {
@@ -126,6 +133,90 @@ func TestServiceNetworkReconciler_UpsertError(t *testing.T) {
 	assert.Equal(t, time.Duration(0), result.RequeueAfter)
 }
 
+func TestServiceNetworkReconciler_UpsertConfig(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+	ctx := context.TODO()
+
+	k8sScheme := newSchemeForSNTest()
+	sn := &anv1alpha1.ServiceNetwork{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-network"},
+		Spec: anv1alpha1.ServiceNetworkSpec{
+			AuthType:              aws.String("AWS_IAM"),
+			AuthPolicy:            aws.String(`{"Version":"2012-10-17","Statement":[]}`),
+			AccessLogDestinations: []string{"arn:aws:s3:::my-bucket"},
+			VpcAssociations: []anv1alpha1.ServiceNetworkVpcAssociation{
+				{VpcId: "vpc-12345678", SecurityGroupIds: []string{"sg-12345678"}},
+			},
+		},
+		Status: anv1alpha1.ServiceNetworkStatus{AuthPolicyState: "Active"},
+	}
+	k8sClient := testclient.NewClientBuilder().
+		WithScheme(k8sScheme).
+		WithObjects(sn).
+		WithStatusSubresource(&anv1alpha1.ServiceNetwork{}).
+		Build()
+
+	snStatus := model.ServiceNetworkStatus{
+		ServiceNetworkARN: "arn:aws:vpc-lattice:us-west-2:123456789:servicenetwork/sn-123",
+		ServiceNetworkID:  "sn-123",
+	}
+	mockSNManager := deploy.NewMockServiceNetworkManager(c)
+	mockSNManager.EXPECT().Upsert(gomock.Any(), "my-network", gomock.Any()).Return(snStatus, nil)
+	mockSNManager.EXPECT().UpsertConfig(gomock.Any(), snStatus, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ model.ServiceNetworkStatus, cfg *model.ServiceNetworkConfig) (model.ServiceNetworkConfigStatus, error) {
+			assert.Equal(t, "AWS_IAM", *cfg.AuthType)
+			assert.True(t, cfg.AuthPolicyManaged)
+			assert.Equal(t, []string{"arn:aws:s3:::my-bucket"}, cfg.AccessLogDestinations)
+			assert.Equal(t, []model.ServiceNetworkVpcAssociation{
+				{VpcId: "vpc-12345678", SecurityGroupIds: []string{"sg-12345678"}},
+			}, cfg.VpcAssociations)
+			return model.ServiceNetworkConfigStatus{
+				AuthType:        "AWS_IAM",
+				AuthPolicyState: "Active",
+				AccessLogSubscriptions: []model.ServiceNetworkAccessLogSubscriptionStatus{
+					{DestinationArn: "arn:aws:s3:::my-bucket", Arn: "als-arn"},
+				},
+				VpcAssociations: []model.ServiceNetworkVpcAssociationStatus{
+					{VpcId: "vpc-12345678", Arn: "snva-arn", Status: "CREATE_IN_PROGRESS"},
+				},
+			}, fmt.Errorf("%w, vpc associations in progress", lattice_runtime.NewRetryError())
+		})
+
+	mockFinalizer := k8s.NewMockFinalizerManager(c)
+	mockFinalizer.EXPECT().AddFinalizers(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	mockEventRecorder := mock_client.NewMockEventRecorder(c)
+	mockEventRecorder.EXPECT().Event(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	r := &serviceNetworkReconciler{
+		log:              gwlog.FallbackLogger,
+		client:           k8sClient,
+		finalizerManager: mockFinalizer,
+		snManager:        mockSNManager,
+		eventRecorder:    mockEventRecorder,
+	}
+
+	result, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: "my-network"}})
+	assert.Nil(t, err)
+	assert.NotEqual(t, time.Duration(0), result.RequeueAfter)
+
+	// the status of the sub-resources is reported while the VPC association is in progress
+	updated := &anv1alpha1.ServiceNetwork{}
+	k8sClient.Get(ctx, types.NamespacedName{Name: "my-network"}, updated)
+	assert.Equal(t, "AWS_IAM", updated.Status.AuthType)
+	assert.Equal(t, "Active", updated.Status.AuthPolicyState)
+	assert.Equal(t, []anv1alpha1.ServiceNetworkAccessLogSubscriptionStatus{
+		{DestinationArn: "arn:aws:s3:::my-bucket", Arn: "als-arn"},
+	}, updated.Status.AccessLogSubscriptions)
+	assert.Equal(t, []anv1alpha1.ServiceNetworkVpcAssociationStatus{
+		{VpcId: "vpc-12345678", Arn: "snva-arn", Status: "CREATE_IN_PROGRESS"},
+	}, updated.Status.VpcAssociations)
+	programmed := meta.FindStatusCondition(updated.Status.Conditions, "Programmed")
+	assert.Equal(t, metav1.ConditionFalse, programmed.Status)
+	assert.Equal(t, "Pending", programmed.Reason)
+}
+
 func TestServiceNetworkReconciler_DeleteSuccess(t *testing.T) {
 	c := gomock.NewController(t)
 	defer c.Finish()
@@ -147,7 +238,7 @@ func TestServiceNetworkReconciler_DeleteSuccess(t *testing.T) {
 		Build()
 
 	mockSNManager := deploy.NewMockServiceNetworkManager(c)
-	mockSNManager.EXPECT().Delete(gomock.Any(), "my-network").Return(nil)
+	mockSNManager.EXPECT().Delete(gomock.Any(), "my-network", false).Return(nil)
 
 	mockFinalizer := k8s.NewMockFinalizerManager(c)
 	mockFinalizer.EXPECT().RemoveFinalizers(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
@@ -248,6 +339,7 @@ func TestServiceNetworkReconciler_DeleteConflictError(t *testing.T) {
 		Status: anv1alpha1.ServiceNetworkStatus{
 			ServiceNetworkARN: "arn:aws:vpc-lattice:us-west-2:123456789:servicenetwork/sn-123",
 			ServiceNetworkID:  "sn-123",
+			Sharing:           &anv1alpha1.ServiceNetworkSharingStatus{},
 		},
 	}
 	k8sClient := testclient.NewClientBuilder().
@@ -256,8 +348,9 @@ func TestServiceNetworkReconciler_DeleteConflictError(t *testing.T) {
 		WithStatusSubresource(&anv1alpha1.ServiceNetwork{}).
 		Build()
 
+	// the resource share is deleted along with the service network shared before
 	mockSNManager := deploy.NewMockServiceNetworkManager(c)
-	mockSNManager.EXPECT().Delete(gomock.Any(), "my-network").
+	mockSNManager.EXPECT().Delete(gomock.Any(), "my-network", true).
 		Return(fmt.Errorf("ConflictException: service network has VPC(s) associated"))
 
 	mockFinalizer := k8s.NewMockFinalizerManager(c)
@@ -0,0 +1,226 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	anv1alpha1 "github.com/aws/aws-application-networking-k8s/pkg/apis/applicationnetworking/v1alpha1"
+	pkg_aws "github.com/aws/aws-application-networking-k8s/pkg/aws"
+	"github.com/aws/aws-application-networking-k8s/pkg/controllers/predicates"
+	"github.com/aws/aws-application-networking-k8s/pkg/deploy/externaldns"
+	deploy "github.com/aws/aws-application-networking-k8s/pkg/deploy/lattice"
+	"github.com/aws/aws-application-networking-k8s/pkg/k8s"
+	model "github.com/aws/aws-application-networking-k8s/pkg/model/lattice"
+	lattice_runtime "github.com/aws/aws-application-networking-k8s/pkg/runtime"
+	"github.com/aws/aws-application-networking-k8s/pkg/utils"
+	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
+)
+
+const (
+	serviceNetworkEndpointFinalizer = "servicenetworkendpoint.k8s.aws/resources"
+
+	// serviceNetworkEndpointResyncInterval is how often programmed endpoints are reconciled again, to publish the
+	// services added to or removed from their service network since, which raise no event
+	serviceNetworkEndpointResyncInterval = 5 * time.Minute
+)
+
+type serviceNetworkEndpointReconciler struct {
+	log              gwlog.Logger
+	client           client.Client
+	cloud            pkg_aws.Cloud
+	finalizerManager k8s.FinalizerManager
+	sneManager       deploy.ServiceNetworkEndpointManager
+	dnsManager       externaldns.ServiceNetworkEndpointDnsManager
+	eventRecorder    record.EventRecorder
+}
+
+//+kubebuilder:rbac:groups=application-networking.k8s.aws,resources=servicenetworkendpoints,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=application-networking.k8s.aws,resources=servicenetworkendpoints/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=application-networking.k8s.aws,resources=servicenetworkendpoints/finalizers,verbs=update
+
+func RegisterServiceNetworkEndpointController(
+	log gwlog.Logger,
+	cloud pkg_aws.Cloud,
+	finalizerManager k8s.FinalizerManager,
+	mgr ctrl.Manager,
+) error {
+	r := &serviceNetworkEndpointReconciler{
+		log:              log,
+		client:           mgr.GetClient(),
+		cloud:            cloud,
+		finalizerManager: finalizerManager,
+		sneManager:       deploy.NewDefaultServiceNetworkEndpointManager(log, cloud),
+		dnsManager:       externaldns.NewServiceNetworkEndpointDnsManager(log, mgr.GetClient()),
+		eventRecorder:    mgr.GetEventRecorderFor("service-network-endpoint-controller"),
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&anv1alpha1.ServiceNetworkEndpoint{}, builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicates.AdditionalTagsAnnotationChangedPredicate))).
+		Complete(r)
+}
+
+func (r *serviceNetworkEndpointReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx = gwlog.StartReconcileTrace(ctx, r.log, "servicenetworkendpoint", req.Name, req.Namespace)
+	defer func() {
+		gwlog.EndReconcileTrace(ctx, r.log)
+	}()
+
+	programmed, recErr := r.reconcile(ctx, req)
+	if recErr != nil {
+		r.log.Infow(ctx, "reconcile error", "name", req.Name, "message", recErr.Error())
+	}
+	res, retryErr := lattice_runtime.HandleReconcileError(recErr)
+	if programmed && res.RequeueAfter == 0 {
+		res.RequeueAfter = serviceNetworkEndpointResyncInterval
+	}
+	if res.RequeueAfter != 0 {
+		r.log.Infow(ctx, "requeue request", "name", req.Name, "requeueAfter", res.RequeueAfter)
+	} else if retryErr != nil && !errors.Is(retryErr, reconcile.TerminalError(nil)) {
+		r.log.Infow(ctx, "requeue request using exponential backoff", "name", req.Name)
+	} else if retryErr == nil {
+		r.log.Infow(ctx, "reconciled", "name", req.Name)
+	}
+	return res, retryErr
+}
+
+// reconcile returns true when the ServiceNetworkEndpoint was programmed
+func (r *serviceNetworkEndpointReconciler) reconcile(ctx context.Context, req ctrl.Request) (bool, error) {
+	sne := &anv1alpha1.ServiceNetworkEndpoint{}
+	if err := r.client.Get(ctx, req.NamespacedName, sne); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+
+	// the periodic resyncs leave most ServiceNetworkEndpoints unchanged, so events are only recorded for the
+	// reconciles that change or fail something
+	var err error
+	changed := true
+	deleting := !sne.DeletionTimestamp.IsZero()
+	if deleting {
+		err = r.reconcileDelete(ctx, sne)
+	} else {
+		changed, err = r.reconcileUpsert(ctx, sne)
+	}
+
+	if err != nil {
+		r.eventRecorder.Event(sne, corev1.EventTypeWarning, k8s.FailedReconcileEvent, fmt.Sprintf("Reconcile failed: %s", err))
+		return false, err
+	}
+
+	if changed {
+		r.eventRecorder.Event(sne, corev1.EventTypeNormal, k8s.ReconciledEvent, "Successfully reconciled")
+	}
+	return !deleting, nil
+}
+
+// reconcileUpsert returns true when the VPC endpoint or the status of the ServiceNetworkEndpoint changed
+func (r *serviceNetworkEndpointReconciler) reconcileUpsert(ctx context.Context, sne *anv1alpha1.ServiceNetworkEndpoint) (bool, error) {
+	if err := r.finalizerManager.AddFinalizers(ctx, sne, serviceNetworkEndpointFinalizer); err != nil {
+		return false, err
+	}
+
+	endpoint := model.NewServiceNetworkEndpoint(sne, r.cloud.Config().VpcId, k8s.GetAdditionalTagsFromAnnotations(ctx, sne))
+	status, err := r.sneManager.Upsert(ctx, endpoint)
+	if err != nil {
+		// the status is only complete when waiting on the endpoint or its services to become available
+		var requeueNeededAfter *lattice_runtime.RequeueNeededAfter
+		if errors.As(err, &requeueNeededAfter) {
+			r.updateStatus(ctx, sne, metav1.ConditionFalse, "Pending", err.Error(), &status)
+		} else {
+			r.updateStatus(ctx, sne, metav1.ConditionFalse, "ReconcileError", err.Error(), nil)
+		}
+		return false, err
+	}
+
+	statusChanged := r.updateStatus(ctx, sne, metav1.ConditionTrue, "Programmed", "ServiceNetworkEndpoint is programmed", &status)
+	return status.Modified || statusChanged, r.dnsManager.Upsert(ctx, sne)
+}
+
+func (r *serviceNetworkEndpointReconciler) reconcileDelete(ctx context.Context, sne *anv1alpha1.ServiceNetworkEndpoint) error {
+	if err := r.dnsManager.Delete(ctx, sne); err != nil {
+		return err
+	}
+
+	endpoint := model.NewServiceNetworkEndpoint(sne, r.cloud.Config().VpcId, nil)
+	if err := r.sneManager.Delete(ctx, endpoint); err != nil {
+		r.updateStatus(ctx, sne, metav1.ConditionFalse, "DeleteError", err.Error(), nil)
+		return err
+	}
+
+	return r.finalizerManager.RemoveFinalizers(ctx, sne, serviceNetworkEndpointFinalizer)
+}
+
+// updateStatus sets the conditions of the ServiceNetworkEndpoint, along with the status of its VPC endpoint when
+// status is not nil, and returns true when they changed
+func (r *serviceNetworkEndpointReconciler) updateStatus(ctx context.Context, sne *anv1alpha1.ServiceNetworkEndpoint, programmedStatus metav1.ConditionStatus, reason, message string, status *model.ServiceNetworkEndpointStatus) bool {
+	sneOld := sne.DeepCopy()
+
+	sne.Status.Conditions = utils.GetNewConditions(sne.Status.Conditions, metav1.Condition{
+		Type:               "Accepted",
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: sne.Generation,
+		Reason:             "Accepted",
+		Message:            "ServiceNetworkEndpoint is accepted",
+	})
+	sne.Status.Conditions = utils.GetNewConditions(sne.Status.Conditions, metav1.Condition{
+		Type:               "Programmed",
+		Status:             programmedStatus,
+		ObservedGeneration: sne.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+	if status != nil {
+		setEndpointStatus(sne, status)
+	}
+	if equality.Semantic.DeepEqual(sne.Status, sneOld.Status) {
+		return false
+	}
+
+	if err := r.client.Status().Patch(ctx, sne, client.MergeFrom(sneOld)); err != nil {
+		r.log.Errorf(ctx, "Failed to update ServiceNetworkEndpoint status: %s", err)
+	}
+	return true
+}
+
+func setEndpointStatus(sne *anv1alpha1.ServiceNetworkEndpoint, status *model.ServiceNetworkEndpointStatus) {
+	sne.Status.ServiceNetworkARN = status.ServiceNetworkARN
+	sne.Status.VpcEndpointId = status.VpcEndpointId
+	sne.Status.State = status.State
+	sne.Status.Services = nil
+	for _, svc := range status.Services {
+		sne.Status.Services = append(sne.Status.Services, anv1alpha1.ServiceNetworkEndpointServiceStatus{
+			ServiceArn:       svc.ServiceArn,
+			CustomDomainName: svc.CustomDomainName,
+			DnsName:          svc.DnsName,
+			HostedZoneId:     svc.HostedZoneId,
+			Accessibility:    svc.Accessibility,
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mock_client "github.com/aws/aws-application-networking-k8s/mocks/controller-runtime/client"
+	anv1alpha1 "github.com/aws/aws-application-networking-k8s/pkg/apis/applicationnetworking/v1alpha1"
+	pkg_aws "github.com/aws/aws-application-networking-k8s/pkg/aws"
+	"github.com/aws/aws-application-networking-k8s/pkg/deploy/externaldns"
+	deploy "github.com/aws/aws-application-networking-k8s/pkg/deploy/lattice"
+	"github.com/aws/aws-application-networking-k8s/pkg/k8s"
+	model "github.com/aws/aws-application-networking-k8s/pkg/model/lattice"
+	lattice_runtime "github.com/aws/aws-application-networking-k8s/pkg/runtime"
+	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
+)
+
+func TestServiceNetworkEndpointReconciler(t *testing.T) {
+	now := metav1.Now()
+	programmedStatus := model.ServiceNetworkEndpointStatus{
+		ServiceNetworkARN: "sn-arn",
+		VpcEndpointId:     "vpce-1",
+		State:             "Available",
+		Services: []model.ServiceNetworkEndpointService{{
+			ServiceArn:       "svc-arn",
+			CustomDomainName: "svc.example.com",
+			DnsName:          "svc.vpce.on.aws",
+		}},
+	}
+
+	tests := []struct {
+		name              string
+		deletionTimestamp *metav1.Time
+		upsertStatus      model.ServiceNetworkEndpointStatus
+		upsertErr         error
+		wantErr           bool
+		// wantRequeueAfter is only checked when not zero
+		wantRequeueAfter time.Duration
+		wantReason       string
+		wantState        string
+		// wantEvents are the reasons of the events recorded by a reconcile and the periodic resync after it
+		wantEvents []string
+	}{
+		{
+			name:         "programmed",
+			upsertStatus: programmedStatus,
+			// services added to the service network later are picked up on the next resync
+			wantRequeueAfter: serviceNetworkEndpointResyncInterval,
+			wantReason:       "Programmed",
+			wantState:        "Available",
+			wantEvents:       []string{k8s.ReconciledEvent},
+		},
+		{
+			name: "vpc endpoint modified on resync",
+			upsertStatus: model.ServiceNetworkEndpointStatus{
+				ServiceNetworkARN: programmedStatus.ServiceNetworkARN,
+				VpcEndpointId:     programmedStatus.VpcEndpointId,
+				State:             programmedStatus.State,
+				Services:          programmedStatus.Services,
+				Modified:          true,
+			},
+			wantRequeueAfter: serviceNetworkEndpointResyncInterval,
+			wantReason:       "Programmed",
+			wantState:        "Available",
+			wantEvents:       []string{k8s.ReconciledEvent, k8s.ReconciledEvent},
+		},
+		{
+			name: "pending",
+			upsertStatus: model.ServiceNetworkEndpointStatus{
+				ServiceNetworkARN: "sn-arn",
+				VpcEndpointId:     "vpce-1",
+				State:             "Pending",
+			},
+			upsertErr:  fmt.Errorf("%w, VPC endpoint vpce-1 is Pending", lattice_runtime.NewRetryError()),
+			wantReason: "Pending",
+			wantState:  "Pending",
+			wantEvents: []string{k8s.FailedReconcileEvent, k8s.FailedReconcileEvent},
+		},
+		{
+			name:       "upsert error",
+			upsertErr:  errors.New("ec2 error"),
+			wantErr:    true,
+			wantReason: "ReconcileError",
+			wantEvents: []string{k8s.FailedReconcileEvent, k8s.FailedReconcileEvent},
+		},
+		{
+			name:              "deleted",
+			deletionTimestamp: &now,
+			wantEvents:        []string{k8s.ReconciledEvent, k8s.ReconciledEvent},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			ctx := context.TODO()
+
+			sne := &anv1alpha1.ServiceNetworkEndpoint{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "my-endpoint",
+					Namespace:         "default",
+					Finalizers:        []string{serviceNetworkEndpointFinalizer},
+					DeletionTimestamp: tt.deletionTimestamp,
+				},
+				Spec: anv1alpha1.ServiceNetworkEndpointSpec{
+					ServiceNetwork:       "my-network",
+					SubnetIds:            []string{"subnet-1"},
+					GenerateDnsEndpoints: true,
+				},
+			}
+			k8sClient := testclient.NewClientBuilder().
+				WithScheme(newSchemeForSNTest()).
+				WithObjects(sne).
+				WithStatusSubresource(&anv1alpha1.ServiceNetworkEndpoint{}).
+				Build()
+			mockCloud := pkg_aws.NewMockCloud(c)
+			mockCloud.EXPECT().Config().Return(pkg_aws.CloudConfig{VpcId: "vpc-cluster"}).AnyTimes()
+			var events []string
+			mockEventRecorder := mock_client.NewMockEventRecorder(c)
+			mockEventRecorder.EXPECT().Event(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Do(
+				func(object any, eventType, reason, message string) {
+					events = append(events, reason)
+				}).AnyTimes()
+			mockSneManager := deploy.NewMockServiceNetworkEndpointManager(c)
+			mockDnsManager := externaldns.NewMockServiceNetworkEndpointDnsManager(c)
+			mockFinalizerManager := k8s.NewMockFinalizerManager(c)
+
+			if tt.deletionTimestamp != nil {
+				mockDnsManager.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				mockSneManager.EXPECT().Delete(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, sne *model.ServiceNetworkEndpoint) error {
+						assert.Equal(t, "default/my-endpoint", sne.Name)
+						return nil
+					}).Times(2)
+				mockFinalizerManager.EXPECT().RemoveFinalizers(gomock.Any(), gomock.Any(), serviceNetworkEndpointFinalizer).Return(nil).Times(2)
+			} else {
+				mockFinalizerManager.EXPECT().AddFinalizers(gomock.Any(), gomock.Any(), serviceNetworkEndpointFinalizer).Return(nil).Times(2)
+				mockSneManager.EXPECT().Upsert(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, sne *model.ServiceNetworkEndpoint) (model.ServiceNetworkEndpointStatus, error) {
+						assert.Equal(t, "default/my-endpoint", sne.Name)
+						assert.Equal(t, "vpc-cluster", sne.VpcId)
+						return tt.upsertStatus, tt.upsertErr
+					}).Times(2)
+			}
+			if tt.deletionTimestamp == nil && tt.upsertErr == nil {
+				mockDnsManager.EXPECT().Upsert(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, sne *anv1alpha1.ServiceNetworkEndpoint) error {
+						// the DNS records are generated from the updated status
+						assert.Equal(t, "svc.vpce.on.aws", sne.Status.Services[0].DnsName)
+						return nil
+					}).Times(2)
+			}
+
+			r := &serviceNetworkEndpointReconciler{
+				log:              gwlog.FallbackLogger,
+				client:           k8sClient,
+				cloud:            mockCloud,
+				finalizerManager: mockFinalizerManager,
+				sneManager:       mockSneManager,
+				dnsManager:       mockDnsManager,
+				eventRecorder:    mockEventRecorder,
+			}
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: "my-endpoint", Namespace: "default"}}
+			r.Reconcile(ctx, req)
+			result, err := r.Reconcile(ctx, req)
+			assert.Equal(t, tt.wantEvents, events)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.wantRequeueAfter != 0 {
+				assert.Equal(t, tt.wantRequeueAfter, result.RequeueAfter)
+			}
+			if tt.deletionTimestamp != nil {
+				assert.Equal(t, time.Duration(0), result.RequeueAfter)
+				return
+			}
+			if tt.upsertErr != nil && !tt.wantErr {
+				assert.NotEqual(t, time.Duration(0), result.RequeueAfter)
+			}
+
+			updated := &anv1alpha1.ServiceNetworkEndpoint{}
+			assert.NoError(t, k8sClient.Get(ctx, types.NamespacedName{Name: "my-endpoint", Namespace: "default"}, updated))
+			assert.Equal(t, tt.upsertStatus.VpcEndpointId, updated.Status.VpcEndpointId)
+			assert.Equal(t, tt.wantState, updated.Status.State)
+			programmed := meta.FindStatusCondition(updated.Status.Conditions, "Programmed")
+			assert.Equal(t, tt.wantReason, programmed.Reason)
+			if tt.wantReason == "Programmed" {
+				assert.Equal(t, metav1.ConditionTrue, programmed.Status)
+				assert.Equal(t, "svc.example.com", updated.Status.Services[0].CustomDomainName)
+			} else {
+				assert.Equal(t, metav1.ConditionFalse, programmed.Status)
+			}
+		})
+	}
+}
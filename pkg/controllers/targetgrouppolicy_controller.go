@@ -4,12 +4,17 @@ import (
 	"context"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	anv1alpha1 "github.com/aws/aws-application-networking-k8s/pkg/apis/applicationnetworking/v1alpha1"
+	"github.com/aws/aws-application-networking-k8s/pkg/controllers/predicates"
 	policy "github.com/aws/aws-application-networking-k8s/pkg/k8s/policyhelper"
 	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
 )
@@ -33,9 +38,14 @@ func RegisterTargetGroupPolicyController(log gwlog.Logger, mgr ctrl.Manager) err
 	}
 
 	b := ctrl.NewControllerManagedBy(mgr).
-		For(&TGP{}, builder.WithPredicates(predicate.GenerationChangedPredicate{}))
+		For(&TGP{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		// the precedence of a policy changes the conditions of the other policies of its targets, and the
+		// defaults of an accepted namespace policy change their effective config, so its status changes count too
+		Watches(&TGP{}, handler.EnqueueRequestsFromMapFunc(controller.mapToNamespacePolicies),
+			builder.WithPredicates(predicates.TargetGroupPolicyPrecedenceChangedPredicate))
 	ph.AddWatchers(b, &corev1.Service{})
 	ph.AddWatchers(b, &anv1alpha1.ServiceExport{})
+	ph.AddWatchers(b, &gwv1.Gateway{}, &corev1.Namespace{})
 
 	return b.Complete(controller)
 }
@@ -51,12 +61,22 @@ func (c *TargetGroupPolicyController) Reconcile(ctx context.Context, req ctrl.Re
 	if err != nil {
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
-	c.log.Infow(ctx, "reconcile target group policy", "req", req, "targetRef", tgPolicy.Spec.TargetRef)
+	c.log.Infow(ctx, "reconcile target group policy", "req", req, "targetRef", tgPolicy.Spec.TargetRef,
+		"targetSelector", tgPolicy.Spec.TargetSelector, "sectionName", tgPolicy.Spec.SectionName)
 
-	_, err = c.ph.ValidateAndUpdateCondition(ctx, tgPolicy)
+	var reason policy.ConditionReason
+	if overridesErr := policy.ValidateTargetGroupPolicyOverrides(tgPolicy); overridesErr != nil {
+		reason = policy.ReasonInvalid
+		err = c.ph.UpdateAcceptedCondition(ctx, tgPolicy, reason, overridesErr.Error())
+	} else {
+		reason, err = c.ph.ValidateAndUpdateCondition(ctx, tgPolicy)
+	}
 	if err != nil {
 		return ctrl.Result{}, err
 	}
+	if err = c.updateEffectiveConfig(ctx, tgPolicy, reason); err != nil {
+		return ctrl.Result{}, err
+	}
 
 	c.log.Infow(ctx, "reconciled target group policy",
 		"req", req,
@@ -64,3 +84,36 @@ func (c *TargetGroupPolicyController) Reconcile(ctx context.Context, req ctrl.Re
 	)
 	return ctrl.Result{}, nil
 }
+
+// updateEffectiveConfig exposes the config of an accepted policy merged over the defaults of its namespace, and under
+// its overrides
+func (c *TargetGroupPolicyController) updateEffectiveConfig(ctx context.Context, tgPolicy *TGP, reason policy.ConditionReason) error {
+	var effectiveConfig *anv1alpha1.TargetGroupPolicyConfig
+	if reason == policy.ReasonAccepted {
+		var err error
+		effectiveConfig, err = policy.EffectiveTargetGroupPolicyConfig(ctx, c.ph, tgPolicy)
+		if err != nil {
+			return err
+		}
+	}
+	if equality.Semantic.DeepEqual(effectiveConfig, tgPolicy.Status.EffectiveConfig) {
+		return nil
+	}
+	tgPolicy.Status.EffectiveConfig = effectiveConfig
+	return c.client.Status().Update(ctx, tgPolicy)
+}
+
+func (c *TargetGroupPolicyController) mapToNamespacePolicies(ctx context.Context, obj client.Object) []reconcile.Request {
+	tgps := &anv1alpha1.TargetGroupPolicyList{}
+	if err := c.client.List(ctx, tgps, client.InNamespace(obj.GetNamespace())); err != nil {
+		c.log.Errorf(ctx, "Failed to list TargetGroupPolicies in namespace %s, %s", obj.GetNamespace(), err)
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, tgp := range tgps.Items {
+		if tgp.Name != obj.GetName() {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&tgp)})
+		}
+	}
+	return requests
+}
@@ -2,6 +2,7 @@ package externaldns
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/aws/aws-application-networking-k8s/pkg/model/core"
 	latticemodel "github.com/aws/aws-application-networking-k8s/pkg/model/lattice"
+	"github.com/aws/aws-application-networking-k8s/pkg/utils"
 	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
 )
 
@@ -21,6 +23,7 @@ import (
 
 type DnsEndpointManager interface {
 	Create(ctx context.Context, service *latticemodel.Service) error
+	Delete(ctx context.Context, service *latticemodel.Service) error
 }
 
 type defaultDnsEndpointManager struct {
@@ -36,10 +39,7 @@ func NewDnsEndpointManager(log gwlog.Logger, k8sClient client.Client) *defaultDn
 }
 
 func (s *defaultDnsEndpointManager) Create(ctx context.Context, service *latticemodel.Service) error {
-	namespacedName := types.NamespacedName{
-		Namespace: service.Spec.RouteNamespace,
-		Name:      service.Spec.RouteName + "-dns",
-	}
+	namespacedName := dnsEndpointName(service)
 	if service.Spec.CustomerDomainName == "" {
 		s.log.Debugf(ctx, "Skipping creation of %s: detected no custom domain", namespacedName)
 		return nil
@@ -124,3 +124,35 @@ func (s *defaultDnsEndpointManager) Create(ctx context.Context, service *lattice
 	}
 	return nil
 }
+
+func (s *defaultDnsEndpointManager) Delete(ctx context.Context, service *latticemodel.Service) error {
+	namespacedName := dnsEndpointName(service)
+	ep := &endpoint.DNSEndpoint{}
+	if err := s.k8sClient.Get(ctx, namespacedName, ep); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return nil
+		}
+		return err
+	}
+	s.log.Debugf(ctx, "Attempting deletion of DNSEndpoint %s", namespacedName.String())
+	if err := s.k8sClient.Delete(ctx, ep); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// dnsEndpointName returns the DNSEndpoint of a service, services built for additional route
+// hostnames get their own DNSEndpoint named after the hostname
+func dnsEndpointName(service *latticemodel.Service) types.NamespacedName {
+	name := service.Spec.RouteName + "-dns"
+	if service.Spec.AdditionalHostname {
+		name += "-" + utils.HostnameHash(service.Spec.CustomerDomainName)
+	}
+	if service.Spec.RulePart > 0 {
+		name += fmt.Sprintf("-part%d", service.Spec.RulePart)
+	}
+	return types.NamespacedName{
+		Namespace: service.Spec.RouteNamespace,
+		Name:      name,
+	}
+}
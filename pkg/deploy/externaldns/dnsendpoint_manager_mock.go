@@ -54,3 +54,17 @@ func (mr *MockDnsEndpointManagerMockRecorder) Create(ctx, service any) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDnsEndpointManager)(nil).Create), ctx, service)
 }
+
+// Delete mocks base method.
+func (m *MockDnsEndpointManager) Delete(ctx context.Context, service *lattice.Service) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, service)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDnsEndpointManagerMockRecorder) Delete(ctx, service any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDnsEndpointManager)(nil).Delete), ctx, service)
+}
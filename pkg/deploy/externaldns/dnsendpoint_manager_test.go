@@ -7,6 +7,7 @@ import (
 
 	mock_client "github.com/aws/aws-application-networking-k8s/mocks/controller-runtime/client"
 	model "github.com/aws/aws-application-networking-k8s/pkg/model/lattice"
+	"github.com/aws/aws-application-networking-k8s/pkg/utils"
 	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
 
 	"github.com/stretchr/testify/assert"
@@ -290,3 +291,66 @@ func TestCreateDnsEndpoint(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteDnsEndpoint(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+
+	service := &model.Service{
+		Spec: model.ServiceSpec{
+			ServiceTagFields: model.ServiceTagFields{
+				RouteName:      "service",
+				RouteNamespace: "default",
+			},
+			CustomerDomainName: "new.example.com",
+			AdditionalHostname: true,
+		},
+	}
+	expectedName := types.NamespacedName{
+		Namespace: "default",
+		Name:      "service-dns-" + utils.HostnameHash("new.example.com"),
+	}
+
+	tests := []struct {
+		name     string
+		getErr   error
+		deleted  bool
+		errIsNil bool
+	}{
+		{
+			name:     "existing endpoint is deleted",
+			deleted:  true,
+			errIsNil: true,
+		},
+		{
+			name:     "missing endpoint is ignored",
+			getErr:   apierrors.NewNotFound(schema.GroupResource{}, ""),
+			errIsNil: true,
+		},
+		{
+			name:     "missing CRD is ignored",
+			getErr:   &meta.NoKindMatchError{},
+			errIsNil: true,
+		},
+		{
+			name:     "get error is returned",
+			getErr:   errors.New("get error"),
+			errIsNil: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := mock_client.NewMockClient(c)
+			mgr := NewDnsEndpointManager(gwlog.FallbackLogger, client)
+
+			client.EXPECT().Get(gomock.Any(), gomock.Eq(expectedName), gomock.Any()).Return(tt.getErr)
+			if tt.deleted {
+				client.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			err := mgr.Delete(context.Background(), service)
+			assert.Equal(t, tt.errIsNil, err == nil)
+		})
+	}
+}
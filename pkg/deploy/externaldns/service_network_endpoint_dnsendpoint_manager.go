@@ -0,0 +1,118 @@
+package externaldns
+
+import (
+	"context"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/external-dns/endpoint"
+
+	anv1alpha1 "github.com/aws/aws-application-networking-k8s/pkg/apis/applicationnetworking/v1alpha1"
+	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
+)
+
+//go:generate mockgen -destination service_network_endpoint_dnsendpoint_manager_mock.go -package externaldns github.com/aws/aws-application-networking-k8s/pkg/deploy/externaldns ServiceNetworkEndpointDnsManager
+
+// ServiceNetworkEndpointDnsManager manages the DNSEndpoint of a ServiceNetworkEndpoint, with a CNAME record from the
+// custom domain name of each service reachable through the VPC endpoint to its endpoint DNS name
+type ServiceNetworkEndpointDnsManager interface {
+	Upsert(ctx context.Context, sne *anv1alpha1.ServiceNetworkEndpoint) error
+	Delete(ctx context.Context, sne *anv1alpha1.ServiceNetworkEndpoint) error
+}
+
+type defaultServiceNetworkEndpointDnsManager struct {
+	log       gwlog.Logger
+	k8sClient client.Client
+}
+
+func NewServiceNetworkEndpointDnsManager(log gwlog.Logger, k8sClient client.Client) *defaultServiceNetworkEndpointDnsManager {
+	return &defaultServiceNetworkEndpointDnsManager{
+		log:       log,
+		k8sClient: k8sClient,
+	}
+}
+
+func (s *defaultServiceNetworkEndpointDnsManager) Upsert(ctx context.Context, sne *anv1alpha1.ServiceNetworkEndpoint) error {
+	var endpoints []*endpoint.Endpoint
+	for _, svc := range sne.Status.Services {
+		if svc.CustomDomainName == "" || svc.DnsName == "" {
+			continue
+		}
+		endpoints = append(endpoints, &endpoint.Endpoint{
+			DNSName:    svc.CustomDomainName,
+			Targets:    []string{svc.DnsName},
+			RecordType: "CNAME",
+			RecordTTL:  300,
+		})
+	}
+	if !sne.Spec.GenerateDnsEndpoints || len(endpoints) == 0 {
+		return s.Delete(ctx, sne)
+	}
+
+	namespacedName := serviceNetworkEndpointDnsEndpointName(sne)
+	ep := &endpoint.DNSEndpoint{}
+	if err := s.k8sClient.Get(ctx, namespacedName, ep); err != nil {
+		if apierrors.IsNotFound(err) {
+			s.log.Debugf(ctx, "Attempting creation of DNSEndpoint %s with %d records", namespacedName.String(), len(endpoints))
+			ep = &endpoint.DNSEndpoint{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      namespacedName.Name,
+					Namespace: namespacedName.Namespace,
+				},
+				Spec: endpoint.DNSEndpointSpec{
+					Endpoints: endpoints,
+				},
+			}
+			controllerutil.SetControllerReference(sne, ep, s.k8sClient.Scheme())
+			return s.k8sClient.Create(ctx, ep)
+		} else if meta.IsNoMatchError(err) {
+			s.log.Debugf(ctx, "DNSEndpoint CRD not supported, skipping")
+			return nil
+		}
+		return err
+	}
+
+	if !metav1.IsControlledBy(ep, sne) {
+		s.log.Infof(ctx, "Skipping update of DNSEndpoint %s: not created for ServiceNetworkEndpoint %s",
+			namespacedName.String(), sne.Name)
+		return nil
+	}
+	old := ep.DeepCopy()
+	ep.Spec.Endpoints = endpoints
+	if !reflect.DeepEqual(ep.Spec.Endpoints, old.Spec.Endpoints) {
+		s.log.Debugf(ctx, "Attempting update of DNSEndpoint %s with %d records", namespacedName.String(), len(endpoints))
+		return s.k8sClient.Patch(ctx, ep, client.MergeFrom(old))
+	}
+	return nil
+}
+
+func (s *defaultServiceNetworkEndpointDnsManager) Delete(ctx context.Context, sne *anv1alpha1.ServiceNetworkEndpoint) error {
+	namespacedName := serviceNetworkEndpointDnsEndpointName(sne)
+	ep := &endpoint.DNSEndpoint{}
+	if err := s.k8sClient.Get(ctx, namespacedName, ep); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return nil
+		}
+		return err
+	}
+	if !metav1.IsControlledBy(ep, sne) {
+		return nil
+	}
+	s.log.Debugf(ctx, "Attempting deletion of DNSEndpoint %s", namespacedName.String())
+	if err := s.k8sClient.Delete(ctx, ep); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func serviceNetworkEndpointDnsEndpointName(sne *anv1alpha1.ServiceNetworkEndpoint) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: sne.Namespace,
+		Name:      sne.Name + "-sne-dns",
+	}
+}
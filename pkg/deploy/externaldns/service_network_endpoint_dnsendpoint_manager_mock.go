@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/aws/aws-application-networking-k8s/pkg/deploy/externaldns (interfaces: ServiceNetworkEndpointDnsManager)
+//
+// Generated by this command:
+//
+//	mockgen -destination service_network_endpoint_dnsendpoint_manager_mock.go -package externaldns github.com/aws/aws-application-networking-k8s/pkg/deploy/externaldns ServiceNetworkEndpointDnsManager
+//
+
+// Package externaldns is a generated GoMock package.
+package externaldns
+
+import (
+	context "context"
+	reflect "reflect"
+
+	v1alpha1 "github.com/aws/aws-application-networking-k8s/pkg/apis/applicationnetworking/v1alpha1"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockServiceNetworkEndpointDnsManager is a mock of ServiceNetworkEndpointDnsManager interface.
+type MockServiceNetworkEndpointDnsManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceNetworkEndpointDnsManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceNetworkEndpointDnsManagerMockRecorder is the mock recorder for MockServiceNetworkEndpointDnsManager.
+type MockServiceNetworkEndpointDnsManagerMockRecorder struct {
+	mock *MockServiceNetworkEndpointDnsManager
+}
+
+// NewMockServiceNetworkEndpointDnsManager creates a new mock instance.
+func NewMockServiceNetworkEndpointDnsManager(ctrl *gomock.Controller) *MockServiceNetworkEndpointDnsManager {
+	mock := &MockServiceNetworkEndpointDnsManager{ctrl: ctrl}
+	mock.recorder = &MockServiceNetworkEndpointDnsManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceNetworkEndpointDnsManager) EXPECT() *MockServiceNetworkEndpointDnsManagerMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockServiceNetworkEndpointDnsManager) Delete(ctx context.Context, sne *v1alpha1.ServiceNetworkEndpoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, sne)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockServiceNetworkEndpointDnsManagerMockRecorder) Delete(ctx, sne any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockServiceNetworkEndpointDnsManager)(nil).Delete), ctx, sne)
+}
+
+// Upsert mocks base method.
+func (m *MockServiceNetworkEndpointDnsManager) Upsert(ctx context.Context, sne *v1alpha1.ServiceNetworkEndpoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, sne)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockServiceNetworkEndpointDnsManagerMockRecorder) Upsert(ctx, sne any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockServiceNetworkEndpointDnsManager)(nil).Upsert), ctx, sne)
+}
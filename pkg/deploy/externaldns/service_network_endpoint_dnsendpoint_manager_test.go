@@ -0,0 +1,75 @@
+package externaldns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/external-dns/endpoint"
+
+	anv1alpha1 "github.com/aws/aws-application-networking-k8s/pkg/apis/applicationnetworking/v1alpha1"
+	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
+)
+
+func TestServiceNetworkEndpointDnsManager(t *testing.T) {
+	ctx := context.TODO()
+	sne := &anv1alpha1.ServiceNetworkEndpoint{
+		ObjectMeta: metav1.ObjectMeta{Name: "sne", Namespace: "ns", UID: "uid"},
+		Spec:       anv1alpha1.ServiceNetworkEndpointSpec{GenerateDnsEndpoints: true},
+		Status: anv1alpha1.ServiceNetworkEndpointStatus{
+			Services: []anv1alpha1.ServiceNetworkEndpointServiceStatus{
+				{ServiceArn: "svc-1", CustomDomainName: "svc-1.example.com", DnsName: "svc-1.vpce.on.aws"},
+				{ServiceArn: "svc-2", DnsName: "svc-2.vpce.on.aws"},
+				{ServiceArn: "svc-3", CustomDomainName: "svc-3.example.com"},
+			},
+		},
+	}
+	k8sScheme := runtime.NewScheme()
+	anv1alpha1.Install(k8sScheme)
+	dnsGv := schema.GroupVersion{Group: "externaldns.k8s.io", Version: "v1alpha1"}
+	k8sScheme.AddKnownTypes(dnsGv, &endpoint.DNSEndpoint{}, &endpoint.DNSEndpointList{})
+	metav1.AddToGroupVersion(k8sScheme, dnsGv)
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sScheme).WithObjects(sne).Build()
+	m := NewServiceNetworkEndpointDnsManager(gwlog.FallbackLogger, k8sClient)
+	key := types.NamespacedName{Namespace: "ns", Name: "sne-sne-dns"}
+
+	// creates records only for services with both a custom domain name and an endpoint DNS name
+	assert.NoError(t, m.Upsert(ctx, sne))
+	ep := &endpoint.DNSEndpoint{}
+	assert.NoError(t, k8sClient.Get(ctx, key, ep))
+	assert.True(t, metav1.IsControlledBy(ep, sne))
+	assert.Equal(t, []*endpoint.Endpoint{{
+		DNSName:    "svc-1.example.com",
+		Targets:    []string{"svc-1.vpce.on.aws"},
+		RecordType: "CNAME",
+		RecordTTL:  300,
+	}}, ep.Spec.Endpoints)
+
+	// updates records when services change
+	sne.Status.Services[1].CustomDomainName = "svc-2.example.com"
+	assert.NoError(t, m.Upsert(ctx, sne))
+	assert.NoError(t, k8sClient.Get(ctx, key, ep))
+	assert.Len(t, ep.Spec.Endpoints, 2)
+
+	// deletes the DNSEndpoint when no longer requested
+	sne.Spec.GenerateDnsEndpoints = false
+	assert.NoError(t, m.Upsert(ctx, sne))
+	assert.True(t, apierrors.IsNotFound(k8sClient.Get(ctx, key, ep)))
+
+	// leaves a DNSEndpoint of the same name it does not control alone
+	existing := &endpoint.DNSEndpoint{
+		ObjectMeta: metav1.ObjectMeta{Name: "sne-sne-dns", Namespace: "ns"},
+	}
+	assert.NoError(t, k8sClient.Create(ctx, existing))
+	sne.Spec.GenerateDnsEndpoints = true
+	assert.NoError(t, m.Upsert(ctx, sne))
+	assert.NoError(t, m.Delete(ctx, sne))
+	assert.NoError(t, k8sClient.Get(ctx, key, ep))
+	assert.Empty(t, ep.Spec.Endpoints)
+}
@@ -2,19 +2,29 @@ package lattice
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	anv1alpha1 "github.com/aws/aws-application-networking-k8s/pkg/apis/applicationnetworking/v1alpha1"
+	"github.com/aws/aws-application-networking-k8s/pkg/gateway"
+	"github.com/aws/aws-application-networking-k8s/pkg/k8s"
 	"github.com/aws/aws-application-networking-k8s/pkg/k8s/policyhelper"
+	"github.com/aws/aws-application-networking-k8s/pkg/model/core"
 	model "github.com/aws/aws-application-networking-k8s/pkg/model/lattice"
+	"github.com/aws/aws-application-networking-k8s/pkg/utils"
 	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
 )
 
 // HealthCheckConfigResolver provides centralized logic for resolving health check configuration
-// from TargetGroupPolicy resources for target groups.
+// from TargetGroupPolicy resources for target groups, and for listing target groups in the policy status.
 type HealthCheckConfigResolver struct {
 	log    gwlog.Logger
 	client client.Client
@@ -40,44 +50,175 @@ func NewHealthCheckConfigResolver(log gwlog.Logger, client client.Client) *Healt
 // 4. Falls back gracefully to nil (allowing caller to apply defaults) when policy resolution fails
 // 5. Preserves existing behavior when no policies are applicable
 func (r *HealthCheckConfigResolver) ResolveHealthCheckConfig(ctx context.Context, targetGroup *model.TargetGroup) (*types.HealthCheckConfig, error) {
+	if targetGroup.Spec.IsShared() {
+		// the health check of a shared target group was resolved with the gateways of its routes, which are not
+		// known here, and is part of its key
+		return nil, nil
+	}
+	tgp, err := r.ResolvePolicy(ctx, targetGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	if tgp == nil {
+		r.log.Debugf(ctx, "No TargetGroupPolicy found for service %s/%s",
+			targetGroup.Spec.K8SServiceNamespace, targetGroup.Spec.K8SServiceName)
+		return nil, nil
+	}
+
+	// Convert TargetGroupPolicy health check specification to VPC Lattice health check configuration format
+	healthCheckConfig := r.convertPolicyToHealthCheckConfig(tgp)
+	if healthCheckConfig != nil {
+		r.log.Debugf(ctx, "Resolved health check configuration from TargetGroupPolicy %s/%s for service %s/%s",
+			tgp.Namespace, tgp.Name, targetGroup.Spec.K8SServiceNamespace, targetGroup.Spec.K8SServiceName)
+		// The model health check may be derived from readiness probes, the policy only overrides the fields it sets
+		healthCheckConfig = gateway.MergeHealthCheckConfig(targetGroup.Spec.HealthCheckConfig, healthCheckConfig)
+	}
+
+	return healthCheckConfig, nil
+}
+
+// ResolvePolicy returns the TargetGroupPolicy applied to a target group, the one of its Service or of the
+// ServiceExport with the same name and namespace, merged over the defaults of its namespace and route gateways.
+// Both ServiceExport and route target groups of a service resolve the same policy, which keeps their health checks
+// consistent across clusters. Lambda and ALB target groups have no policy
+func (r *HealthCheckConfigResolver) ResolvePolicy(ctx context.Context, targetGroup *model.TargetGroup) (*anv1alpha1.TargetGroupPolicy, error) {
 	if r.client == nil {
 		r.log.Debugf(ctx, "No k8sClient available for policy resolution, skipping health check config resolution")
 		return nil, nil
 	}
+	return r.resolvePolicy(ctx, targetGroup, r.routeGateways(ctx, targetGroup))
+}
 
-	// Only resolve health check configuration from policies for ServiceExport target groups
-	// This maintains backwards compatibility and follows the existing pattern where only
-	// ServiceExport target groups need cross-cluster health check configuration synchronization
-	if targetGroup.Spec.K8SSourceType != model.SourceTypeSvcExport {
+func (r *HealthCheckConfigResolver) resolvePolicy(ctx context.Context, targetGroup *model.TargetGroup,
+	gateways []*gwv1.Gateway) (*anv1alpha1.TargetGroupPolicy, error) {
+	switch targetGroup.Spec.K8SSourceType {
+	case model.SourceTypeSvcExport, model.SourceTypeHTTPRoute, model.SourceTypeGRPCRoute, model.SourceTypeTLSRoute:
+	default:
 		r.log.Debugf(ctx, "Target group source type is %s, skipping policy-based health check resolution", targetGroup.Spec.K8SSourceType)
 		return nil, nil
 	}
+	if targetGroup.Spec.Type == model.TargetGroupTypeLambda || targetGroup.Spec.Type == model.TargetGroupTypeAlb {
+		return nil, nil
+	}
 
 	// Create policy handler for TargetGroupPolicy
 	tgpHandler := policyhelper.NewTargetGroupPolicyHandler(r.log, r.client)
 
 	// Try to resolve TargetGroupPolicy for the service
 	// This will check both direct Service targets and ServiceExport targets with the same name/namespace
-	tgp, err := tgpHandler.FindPolicyForService(ctx, targetGroup.Spec.K8SServiceName, targetGroup.Spec.K8SServiceNamespace)
+	tgp, err := tgpHandler.FindPolicyForService(ctx, targetGroup.Spec.K8SServiceName,
+		targetGroup.Spec.K8SServiceNamespace, targetGroup.Spec.K8SServicePortName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to resolve TargetGroupPolicy for service %s/%s: %w",
 			targetGroup.Spec.K8SServiceNamespace, targetGroup.Spec.K8SServiceName, err)
 	}
 
-	if tgp == nil {
-		r.log.Debugf(ctx, "No TargetGroupPolicy found for service %s/%s",
-			targetGroup.Spec.K8SServiceNamespace, targetGroup.Spec.K8SServiceName)
-		return nil, nil
+	// The policies of the namespace and gateways provide the defaults of the service policy
+	tgp, err = policyhelper.InheritTargetGroupPolicyDefaults(ctx, tgpHandler, targetGroup.Spec.K8SServiceNamespace,
+		gateways, tgp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve default TargetGroupPolicy for service %s/%s: %w",
+			targetGroup.Spec.K8SServiceNamespace, targetGroup.Spec.K8SServiceName, err)
 	}
+	return tgp, nil
+}
 
-	// Convert TargetGroupPolicy health check specification to VPC Lattice health check configuration format
-	healthCheckConfig := r.convertPolicyToHealthCheckConfig(tgp)
-	if healthCheckConfig != nil {
-		r.log.Debugf(ctx, "Resolved health check configuration from TargetGroupPolicy %s/%s for service %s/%s",
-			tgp.Namespace, tgp.Name, targetGroup.Spec.K8SServiceNamespace, targetGroup.Spec.K8SServiceName)
+// routeGateways returns the gateways of the route of a target group. Shared and ServiceExport target groups
+// belong to no route
+func (r *HealthCheckConfigResolver) routeGateways(ctx context.Context, targetGroup *model.TargetGroup) []*gwv1.Gateway {
+	if targetGroup.Spec.K8SRouteName == "" {
+		return nil
+	}
+	routeName := apitypes.NamespacedName{Namespace: targetGroup.Spec.K8SRouteNamespace, Name: targetGroup.Spec.K8SRouteName}
+	var route core.Route
+	var err error
+	switch targetGroup.Spec.K8SSourceType {
+	case model.SourceTypeHTTPRoute:
+		route, err = core.GetHTTPRoute(ctx, r.client, routeName)
+	case model.SourceTypeGRPCRoute:
+		route, err = core.GetGRPCRoute(ctx, r.client, routeName)
+	case model.SourceTypeTLSRoute:
+		route, err = core.GetTLSRoute(ctx, r.client, routeName)
+	default:
+		return nil
+	}
+	if err != nil {
+		r.log.Debugf(ctx, "Failed to get route %s of target group, skipping gateway defaults, %s", routeName, err)
+		return nil
+	}
+	gateways, _ := k8s.FindControlledParents(ctx, r.client, route)
+	return gateways
+}
+
+// SyncPolicyStatus lists the ARN of a deployed target group in the status of the policy applied to it, and
+// removes it from the status of the other policies of its service and gateway namespaces
+func (r *HealthCheckConfigResolver) SyncPolicyStatus(ctx context.Context, targetGroup *model.TargetGroup) error {
+	if r.client == nil || targetGroup.Status == nil || targetGroup.Status.Arn == "" {
+		return nil
+	}
+	gateways := r.routeGateways(ctx, targetGroup)
+	tgp, err := r.resolvePolicy(ctx, targetGroup, gateways)
+	if err != nil {
+		return err
+	}
+	// only the policies of these namespaces can apply to the target group
+	namespaces := utils.NewSet(targetGroup.Spec.K8SServiceNamespace)
+	for _, gw := range gateways {
+		namespaces.Put(gw.Namespace)
+	}
+	if tgp != nil {
+		namespaces.Put(tgp.Namespace)
 	}
+	return SyncTargetGroupPolicyStatus(ctx, r.client, targetGroup.Status.Arn, tgp, namespaces.Items()...)
+}
 
-	return healthCheckConfig, nil
+// SyncTargetGroupPolicyStatus lists a target group ARN in the status of the given policy only, among the policies of
+// the given namespaces, or of all namespaces when none are given. A nil policy removes the ARN from the status of all
+// policies, which is what deleted target groups need. Policies whose status is already right are not updated
+func SyncTargetGroupPolicyStatus(ctx context.Context, c client.Client, tgArn string, tgp *anv1alpha1.TargetGroupPolicy,
+	namespaces ...string) error {
+	var tgps []anv1alpha1.TargetGroupPolicy
+	if len(namespaces) == 0 {
+		namespaces = []string{metav1.NamespaceAll}
+	}
+	for _, namespace := range namespaces {
+		list := &anv1alpha1.TargetGroupPolicyList{}
+		if err := c.List(ctx, list, client.InNamespace(namespace)); err != nil {
+			return err
+		}
+		tgps = append(tgps, list.Items...)
+	}
+	var errs error
+	for _, item := range tgps {
+		applied := tgp != nil && item.Namespace == tgp.Namespace && item.Name == tgp.Name
+		if slices.Contains(item.Status.TargetGroupArns, tgArn) == applied {
+			continue
+		}
+		errs = errors.Join(errs, updatePolicyTargetGroupArns(ctx, c, client.ObjectKeyFromObject(&item), tgArn, applied))
+	}
+	return errs
+}
+
+func updatePolicyTargetGroupArns(ctx context.Context, c client.Client, key client.ObjectKey, tgArn string, applied bool) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		tgp := &anv1alpha1.TargetGroupPolicy{}
+		if err := c.Get(ctx, key, tgp); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		arns := slices.DeleteFunc(slices.Clone(tgp.Status.TargetGroupArns), func(arn string) bool {
+			return arn == tgArn
+		})
+		if applied {
+			arns = append(arns, tgArn)
+			slices.Sort(arns)
+		}
+		if slices.Equal(arns, tgp.Status.TargetGroupArns) {
+			return nil
+		}
+		tgp.Status.TargetGroupArns = arns
+		return c.Status().Update(ctx, tgp)
+	})
 }
 
 // convertPolicyToHealthCheckConfig converts TargetGroupPolicy health check specification to VPC Lattice health check configuration format.
@@ -42,7 +42,7 @@ func TestHealthCheckConfigResolver_ResolveHealthCheckConfig(t *testing.T) {
 			expectedConfig: nil,
 		},
 		{
-			name: "HTTPRoute target group should skip policy resolution",
+			name: "HTTPRoute target group with no applicable policy",
 			targetGroup: &model.TargetGroup{
 				Spec: model.TargetGroupSpec{
 					TargetGroupTagFields: model.TargetGroupTagFields{
@@ -55,7 +55,7 @@ func TestHealthCheckConfigResolver_ResolveHealthCheckConfig(t *testing.T) {
 			expectedConfig: nil,
 		},
 		{
-			name: "GRPCRoute target group should skip policy resolution",
+			name: "GRPCRoute target group with no applicable policy",
 			targetGroup: &model.TargetGroup{
 				Spec: model.TargetGroupSpec{
 					TargetGroupTagFields: model.TargetGroupTagFields{
@@ -272,6 +272,39 @@ func Test_ResolveHealthCheckConfig_WithPolicies(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "Shared target group keeps the health check resolved with its route gateways",
+			targetGroup: &model.TargetGroup{
+				Spec: model.TargetGroupSpec{
+					TargetGroupTagFields: model.TargetGroupTagFields{
+						K8SSourceType:       model.SourceTypeHTTPRoute,
+						K8SServiceName:      "test-service",
+						K8SServiceNamespace: "test-namespace",
+						K8SSharedKey:        "80-abcdef12",
+					},
+				},
+			},
+			policies: []anv1alpha1.TargetGroupPolicy{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "service-policy",
+						Namespace: "test-namespace",
+					},
+					Spec: anv1alpha1.TargetGroupPolicySpec{
+						TargetRef: &gwv1alpha2.NamespacedPolicyTargetReference{
+							Group: corev1.GroupName,
+							Kind:  "Service",
+							Name:  "test-service",
+						},
+						HealthCheck: &anv1alpha1.HealthCheckConfig{
+							Path: aws.String("/api/health"),
+						},
+					},
+				},
+			},
+			expectedConfig: nil,
+			expectError:    false,
+		},
 		{
 			name: "ServiceExport target group with applicable ServiceExport policy",
 			targetGroup: &model.TargetGroup{
@@ -310,6 +343,79 @@ func Test_ResolveHealthCheckConfig_WithPolicies(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "HTTPRoute target group with applicable ServiceExport policy over its model health check",
+			targetGroup: &model.TargetGroup{
+				Spec: model.TargetGroupSpec{
+					Type: model.TargetGroupTypeIP,
+					TargetGroupTagFields: model.TargetGroupTagFields{
+						K8SSourceType:       model.SourceTypeHTTPRoute,
+						K8SServiceName:      "test-service",
+						K8SServiceNamespace: "test-namespace",
+					},
+					HealthCheckConfig: &types.HealthCheckConfig{
+						Path:                    aws.String("/ready"),
+						UnhealthyThresholdCount: aws.Int32(5),
+					},
+				},
+			},
+			policies: []anv1alpha1.TargetGroupPolicy{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "serviceexport-policy",
+						Namespace: "test-namespace",
+					},
+					Spec: anv1alpha1.TargetGroupPolicySpec{
+						TargetRef: &gwv1alpha2.NamespacedPolicyTargetReference{
+							Group: anv1alpha1.GroupName,
+							Kind:  "ServiceExport",
+							Name:  "test-service",
+						},
+						HealthCheck: &anv1alpha1.HealthCheckConfig{
+							Path: aws.String("/custom/health"),
+						},
+					},
+				},
+			},
+			expectedConfig: &types.HealthCheckConfig{
+				Path:                    aws.String("/custom/health"),
+				UnhealthyThresholdCount: aws.Int32(5),
+			},
+			expectError: false,
+		},
+		{
+			name: "Lambda target group should skip policy resolution",
+			targetGroup: &model.TargetGroup{
+				Spec: model.TargetGroupSpec{
+					Type: model.TargetGroupTypeLambda,
+					TargetGroupTagFields: model.TargetGroupTagFields{
+						K8SSourceType:       model.SourceTypeHTTPRoute,
+						K8SServiceName:      "test-service",
+						K8SServiceNamespace: "test-namespace",
+					},
+				},
+			},
+			policies: []anv1alpha1.TargetGroupPolicy{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "service-policy",
+						Namespace: "test-namespace",
+					},
+					Spec: anv1alpha1.TargetGroupPolicySpec{
+						TargetRef: &gwv1alpha2.NamespacedPolicyTargetReference{
+							Group: corev1.GroupName,
+							Kind:  "Service",
+							Name:  "test-service",
+						},
+						HealthCheck: &anv1alpha1.HealthCheckConfig{
+							Path: aws.String("/custom/health"),
+						},
+					},
+				},
+			},
+			expectedConfig: nil,
+			expectError:    false,
+		},
 		{
 			name: "ServiceExport target group with multiple policies - Service takes precedence",
 			targetGroup: &model.TargetGroup{
@@ -585,3 +691,71 @@ func Test_ResolveHealthCheckConfig_ProtocolConversion(t *testing.T) {
 		})
 	}
 }
+
+func Test_SyncTargetGroupPolicyStatus(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = anv1alpha1.Install(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	newPolicy := func(name string, arns ...string) *anv1alpha1.TargetGroupPolicy {
+		return &anv1alpha1.TargetGroupPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test-namespace",
+			},
+			Spec: anv1alpha1.TargetGroupPolicySpec{
+				TargetRef: &gwv1alpha2.NamespacedPolicyTargetReference{
+					Group: corev1.GroupName,
+					Kind:  "Service",
+					Name:  "test-service",
+				},
+			},
+			Status: anv1alpha1.TargetGroupPolicyStatus{TargetGroupArns: arns},
+		}
+	}
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(newPolicy("old-policy", "tg-arn-1", "tg-arn-2"), newPolicy("new-policy", "tg-arn-3")).
+		WithStatusSubresource(&anv1alpha1.TargetGroupPolicy{}).
+		Build()
+
+	arns := func(name string) []string {
+		tgp := &anv1alpha1.TargetGroupPolicy{}
+		assert.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Namespace: "test-namespace", Name: name}, tgp))
+		return tgp.Status.TargetGroupArns
+	}
+
+	// the target group moves to the new policy
+	err := SyncTargetGroupPolicyStatus(ctx, k8sClient, "tg-arn-1", newPolicy("new-policy"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"tg-arn-2"}, arns("old-policy"))
+	assert.Equal(t, []string{"tg-arn-1", "tg-arn-3"}, arns("new-policy"))
+
+	// a deleted target group leaves all policies
+	err = SyncTargetGroupPolicyStatus(ctx, k8sClient, "tg-arn-2", nil)
+	assert.NoError(t, err)
+	assert.Empty(t, arns("old-policy"))
+	assert.Equal(t, []string{"tg-arn-1", "tg-arn-3"}, arns("new-policy"))
+
+	// policies already listing the target group are not updated again
+	before := &anv1alpha1.TargetGroupPolicy{}
+	assert.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Namespace: "test-namespace", Name: "new-policy"}, before))
+	err = SyncTargetGroupPolicyStatus(ctx, k8sClient, "tg-arn-1", newPolicy("new-policy"), "test-namespace")
+	assert.NoError(t, err)
+	after := &anv1alpha1.TargetGroupPolicy{}
+	assert.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Namespace: "test-namespace", Name: "new-policy"}, after))
+	assert.Equal(t, before.ResourceVersion, after.ResourceVersion)
+
+	// only the policies of the given namespaces are synced
+	other := newPolicy("other-policy", "tg-arn-3")
+	other.Namespace = "other-namespace"
+	assert.NoError(t, k8sClient.Create(ctx, other))
+	err = SyncTargetGroupPolicyStatus(ctx, k8sClient, "tg-arn-3", newPolicy("old-policy"), "test-namespace")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"tg-arn-3"}, arns("old-policy"))
+	assert.Equal(t, []string{"tg-arn-1"}, arns("new-policy"))
+	otherArns := &anv1alpha1.TargetGroupPolicy{}
+	assert.NoError(t, k8sClient.Get(ctx, client.ObjectKeyFromObject(other), otherArns))
+	assert.Equal(t, []string{"tg-arn-3"}, otherArns.Status.TargetGroupArns)
+}
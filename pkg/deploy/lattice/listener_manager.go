@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/aws/aws-application-networking-k8s/pkg/aws/services"
 	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
@@ -14,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
 	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	"k8s.io/apimachinery/pkg/util/cache"
 
 	"github.com/aws/aws-application-networking-k8s/pkg/utils"
 
@@ -29,9 +31,22 @@ type ListenerManager interface {
 	List(ctx context.Context, serviceID string) ([]*types.ListenerSummary, error)
 }
 
+// defaultActionCacheTTL bounds how long an applied default action is trusted before it is read again from
+// Lattice, for changes that do not move the listener's last update time
+const defaultActionCacheTTL = 10 * time.Minute
+
+// appliedDefaultAction is the default action last applied to a listener, with the last update time Lattice reported
+// for the listener at that point. A different update time means the listener changed since.
+type appliedDefaultAction struct {
+	action        types.RuleAction
+	lastUpdatedAt time.Time
+}
+
 type defaultListenerManager struct {
 	log   gwlog.Logger
 	cloud pkg_aws.Cloud
+	// appliedDefaultActions holds the appliedDefaultAction of each listener, by listener ARN
+	appliedDefaultActions *cache.Expiring
 }
 
 func NewListenerManager(
@@ -39,8 +54,9 @@ func NewListenerManager(
 	cloud pkg_aws.Cloud,
 ) *defaultListenerManager {
 	return &defaultListenerManager{
-		log:   log,
-		cloud: cloud,
+		log:                   log,
+		cloud:                 cloud,
+		appliedDefaultActions: cache.NewExpiring(),
 	}
 }
 
@@ -90,12 +106,17 @@ func (d *defaultListenerManager) Upsert(
 		return model.ListenerStatus{}, fmt.Errorf("failed to update tags for listener %s due to %s", aws.ToString(latticeListenerSummary.Id), err)
 	}
 
-	if modelListener.Spec.Protocol != string(types.ListenerProtocolTlsPassthrough) {
-		// The only mutable field for lattice listener is defaultAction, for non-TLS_PASSTHROUGH listener, the defaultAction is always the FixedResponse 404. Don't need to update.
-		return existingListenerStatus, nil
+	// The only mutable field for lattice listener is defaultAction, which is only read from Lattice when it differs
+	// from the one last applied, the listener was updated since, or the applied one expired
+	listenerArn := aws.ToString(latticeListenerSummary.Arn)
+	lastUpdatedAt := aws.ToTime(latticeListenerSummary.LastUpdatedAt)
+	if cached, ok := d.appliedDefaultActions.Get(listenerArn); ok {
+		applied := cached.(appliedDefaultAction)
+		if applied.lastUpdatedAt.Equal(lastUpdatedAt) && reflect.DeepEqual(applied.action, defaultAction) {
+			return existingListenerStatus, nil
+		}
 	}
-
-	// For TLS_PASSTHROUGH listener, check whether it needs to update defaultAction
+	d.appliedDefaultActions.Delete(listenerArn)
 	needToUpdateDefaultAction, err := d.needToUpdateDefaultAction(ctx, latticeSvcId, *latticeListenerSummary.Id, defaultAction)
 	if err != nil {
 		return model.ListenerStatus{}, err
@@ -104,7 +125,13 @@ func (d *defaultListenerManager) Upsert(
 		if err = d.update(ctx, latticeSvcId, latticeListenerSummary, defaultAction); err != nil {
 			return model.ListenerStatus{}, err
 		}
+		// the update moves the listener's last update time, so it is only cached once read again
+		return existingListenerStatus, nil
 	}
+	d.appliedDefaultActions.Set(listenerArn, appliedDefaultAction{
+		action:        defaultAction,
+		lastUpdatedAt: lastUpdatedAt,
+	}, defaultActionCacheTTL)
 	return existingListenerStatus, nil
 }
 
@@ -172,9 +199,14 @@ func (d *defaultListenerManager) getLatticeListenerDefaultAction(ctx context.Con
 	if !hasValidTargetGroup {
 		if stackListener.Spec.Protocol == string(types.ListenerProtocolTlsPassthrough) {
 			return nil, fmt.Errorf("TLSRoute %s/%s must have at least one valid backendRef target group", stackListener.Spec.K8SRouteNamespace, stackListener.Spec.K8SRouteName)
-		} else {
-			return nil, fmt.Errorf("unreachable code, since the defaultAction for non-TLS_PASSTHROUGH listener is always the FixedResponse 404")
 		}
+		// like rules, a default backend without any valid target group answers with a fixed response
+		statusCode := int32(model.InvalidBackendRefFixedResponseStatusCode)
+		return &types.RuleActionMemberFixedResponse{
+			Value: types.FixedResponseAction{
+				StatusCode: &statusCode,
+			},
+		}, nil
 	}
 
 	var latticeTGs []types.WeightedTargetGroup
@@ -278,6 +310,7 @@ func (d *defaultListenerManager) Delete(ctx context.Context, modelListener *mode
 	}
 
 	d.log.Debugf(ctx, "Deleting listener %s in service %s", modelListener.Status.Id, modelListener.Status.ServiceId)
+	d.appliedDefaultActions.Delete(modelListener.Status.ListenerArn)
 	listenerDeleteInput := vpclattice.DeleteListenerInput{
 		ServiceIdentifier:  aws.String(modelListener.Status.ServiceId),
 		ListenerIdentifier: aws.String(modelListener.Status.Id),
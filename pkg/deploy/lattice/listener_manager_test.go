@@ -2,8 +2,10 @@ package lattice
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
@@ -146,7 +148,11 @@ func Test_UpsertListener_DoNotNeedToUpdateExistingHTTPAndHTTPSListener(t *testin
 
 			mockTagging.EXPECT().UpdateTags(ctx, "existing-arn", gomock.Any(), nil).Return(nil)
 
-			mockLattice.EXPECT().GetListener(ctx, gomock.Any()).Times(0)
+			mockLattice.EXPECT().GetListener(ctx, gomock.Any()).Return(&vpclattice.GetListenerOutput{
+				DefaultAction: &types.RuleActionMemberFixedResponse{
+					Value: types.FixedResponseAction{StatusCode: aws.Int32(404)},
+				},
+			}, nil)
 			mockLattice.EXPECT().UpdateListener(ctx, gomock.Any()).Times(0)
 
 			lm := NewListenerManager(gwlog.FallbackLogger, cloud)
@@ -160,6 +166,154 @@ func Test_UpsertListener_DoNotNeedToUpdateExistingHTTPAndHTTPSListener(t *testin
 		})
 	}
 }
+func Test_UpsertListener_UpdateHTTPListenerDefaultAction(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+	ctx := context.TODO()
+	ms := &model.Service{
+		Status: &model.ServiceStatus{Id: "svc-id"},
+	}
+	ml := &model.Listener{
+		Spec: model.ListenerSpec{
+			Protocol: string(types.ListenerProtocolHttp),
+			Port:     8181,
+			DefaultAction: &model.DefaultAction{
+				Forward: &model.RuleAction{
+					TargetGroups: []*model.RuleTargetGroup{
+						{LatticeTgId: "lattice-tg-id-1", Weight: 1},
+					},
+				},
+			},
+		},
+	}
+
+	mockLattice := mocks.NewMockLattice(c)
+	mockTagging := mocks.NewMockTagging(c)
+	cloud := pkg_aws.NewDefaultCloudWithTagging(mockLattice, mockTagging, TestCloudConfig)
+	mockLattice.EXPECT().ListListenersAsList(ctx, gomock.Any()).Return(
+		[]types.ListenerSummary{
+			{
+				Arn:  aws.String("existing-arn"),
+				Id:   aws.String("existing-listener-id"),
+				Name: aws.String("existing-name"),
+				Port: aws.Int32(8181),
+			},
+		}, nil)
+	mockTagging.EXPECT().UpdateTags(ctx, "existing-arn", gomock.Any(), nil).Return(nil)
+	mockLattice.EXPECT().GetListener(ctx, gomock.Any()).Return(&vpclattice.GetListenerOutput{
+		DefaultAction: &types.RuleActionMemberFixedResponse{
+			Value: types.FixedResponseAction{StatusCode: aws.Int32(404)},
+		},
+	}, nil)
+	mockLattice.EXPECT().UpdateListener(ctx, &vpclattice.UpdateListenerInput{
+		DefaultAction: &types.RuleActionMemberForward{
+			Value: types.ForwardAction{
+				TargetGroups: []types.WeightedTargetGroup{
+					{TargetGroupIdentifier: aws.String("lattice-tg-id-1"), Weight: aws.Int32(1)},
+				},
+			},
+		},
+		ListenerIdentifier: aws.String("existing-listener-id"),
+		ServiceIdentifier:  aws.String("svc-id"),
+	}).Return(&vpclattice.UpdateListenerOutput{}, nil)
+
+	lm := NewListenerManager(gwlog.FallbackLogger, cloud)
+	status, err := lm.Upsert(ctx, ml, ms)
+	assert.Nil(t, err)
+	assert.Equal(t, "existing-listener-id", status.Id)
+}
+
+func Test_UpsertListener_AppliedDefaultActionCache(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name                string
+		secondLastUpdatedAt time.Time
+		firstUpdateErr      error
+		expectSecondGet     bool
+	}{
+		{
+			name:                "unchanged listener is not read again",
+			secondLastUpdatedAt: created,
+			expectSecondGet:     false,
+		},
+		{
+			name:                "listener updated out of band is read again",
+			secondLastUpdatedAt: created.Add(time.Minute),
+			expectSecondGet:     true,
+		},
+		{
+			name:                "listener is read again after a failed update",
+			secondLastUpdatedAt: created,
+			firstUpdateErr:      errors.New("update failed"),
+			expectSecondGet:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			ctx := context.TODO()
+			ms := &model.Service{
+				Status: &model.ServiceStatus{Id: "svc-id"},
+			}
+			ml := &model.Listener{
+				Spec: model.ListenerSpec{
+					Protocol: string(types.ListenerProtocolHttp),
+					Port:     8181,
+					DefaultAction: &model.DefaultAction{
+						FixedResponseStatusCode: aws.Int64(404),
+					},
+				},
+			}
+			listener := func(lastUpdatedAt time.Time) []types.ListenerSummary {
+				return []types.ListenerSummary{
+					{
+						Arn:           aws.String("existing-arn"),
+						Id:            aws.String("existing-listener-id"),
+						Name:          aws.String("existing-name"),
+						Port:          aws.Int32(8181),
+						LastUpdatedAt: aws.Time(lastUpdatedAt),
+					},
+				}
+			}
+			stale := &vpclattice.GetListenerOutput{
+				DefaultAction: &types.RuleActionMemberFixedResponse{
+					Value: types.FixedResponseAction{StatusCode: aws.Int32(500)},
+				},
+			}
+			found := &vpclattice.GetListenerOutput{
+				DefaultAction: &types.RuleActionMemberFixedResponse{
+					Value: types.FixedResponseAction{StatusCode: aws.Int32(404)},
+				},
+			}
+
+			mockLattice := mocks.NewMockLattice(c)
+			mockTagging := mocks.NewMockTagging(c)
+			cloud := pkg_aws.NewDefaultCloudWithTagging(mockLattice, mockTagging, TestCloudConfig)
+			mockTagging.EXPECT().UpdateTags(ctx, "existing-arn", gomock.Any(), nil).Return(nil).AnyTimes()
+
+			mockLattice.EXPECT().ListListenersAsList(ctx, gomock.Any()).Return(listener(created), nil)
+			if tt.firstUpdateErr != nil {
+				mockLattice.EXPECT().GetListener(ctx, gomock.Any()).Return(stale, nil)
+				mockLattice.EXPECT().UpdateListener(ctx, gomock.Any()).Return(nil, tt.firstUpdateErr)
+			} else {
+				mockLattice.EXPECT().GetListener(ctx, gomock.Any()).Return(found, nil)
+			}
+
+			lm := NewListenerManager(gwlog.FallbackLogger, cloud)
+			_, err := lm.Upsert(ctx, ml, ms)
+			assert.Equal(t, tt.firstUpdateErr != nil, err != nil)
+
+			mockLattice.EXPECT().ListListenersAsList(ctx, gomock.Any()).Return(listener(tt.secondLastUpdatedAt), nil)
+			if tt.expectSecondGet {
+				mockLattice.EXPECT().GetListener(ctx, gomock.Any()).Return(found, nil)
+			}
+			_, err = lm.Upsert(ctx, ml, ms)
+			assert.Nil(t, err)
+		})
+	}
+}
+
 func Test_UpsertListener_Update_TLS_PASSTHROUGHListener(t *testing.T) {
 	tests := []struct {
 		name                            string
@@ -547,6 +701,47 @@ func Test_defaultListenerManager_getLatticeListenerDefaultAction_HTTP_HTTPS_List
 			listenerProtocol:   string(types.ListenerProtocolHttps),
 			want:               latticeFixResponseAction404,
 		},
+		{
+			name:               "HTTP protocol Listener has the 500 fixed response modelListenerDefaultAction, return lattice fixed response 500 DefaultAction",
+			modelDefaultAction: &model.DefaultAction{FixedResponseStatusCode: aws.Int64(500)},
+			listenerProtocol:   string(types.ListenerProtocolHttp),
+			want: &types.RuleActionMemberFixedResponse{
+				Value: types.FixedResponseAction{StatusCode: aws.Int32(500)},
+			},
+		},
+		{
+			name: "HTTP protocol Listener has forward modelListenerDefaultAction, return lattice forward DefaultAction",
+			modelDefaultAction: &model.DefaultAction{
+				Forward: &model.RuleAction{
+					TargetGroups: []*model.RuleTargetGroup{
+						{LatticeTgId: "lattice-tg-id-1", Weight: 10},
+						{LatticeTgId: model.InvalidBackendRefTgId, Weight: 90},
+					},
+				},
+			},
+			listenerProtocol: string(types.ListenerProtocolHttp),
+			want: &types.RuleActionMemberForward{
+				Value: types.ForwardAction{
+					TargetGroups: []types.WeightedTargetGroup{
+						{TargetGroupIdentifier: aws.String("lattice-tg-id-1"), Weight: aws.Int32(10)},
+					},
+				},
+			},
+		},
+		{
+			name: "HTTPS protocol Listener forwards only to invalid target groups, return lattice fixed response 500 DefaultAction",
+			modelDefaultAction: &model.DefaultAction{
+				Forward: &model.RuleAction{
+					TargetGroups: []*model.RuleTargetGroup{
+						{LatticeTgId: model.InvalidBackendRefTgId, Weight: 1},
+					},
+				},
+			},
+			listenerProtocol: string(types.ListenerProtocolHttps),
+			want: &types.RuleActionMemberFixedResponse{
+				Value: types.FixedResponseAction{StatusCode: aws.Int32(500)},
+			},
+		},
 	}
 
 	c := gomock.NewController(t)
@@ -806,9 +1001,13 @@ func Test_ListenerManager_WithAdditionalTags_UpdateHTTP(t *testing.T) {
 
 	mockTagging.EXPECT().UpdateTags(ctx, "existing-arn", ml.Spec.AdditionalTags, nil).Return(nil)
 
-	// No UpdateListener call expected for HTTP listeners (only tags are updated)
+	// No UpdateListener call expected when the default action is unchanged (only tags are updated)
+	mockLattice.EXPECT().GetListener(ctx, gomock.Any()).Return(&vpclattice.GetListenerOutput{
+		DefaultAction: &types.RuleActionMemberFixedResponse{
+			Value: types.FixedResponseAction{StatusCode: aws.Int32(404)},
+		},
+	}, nil)
 	mockLattice.EXPECT().UpdateListener(ctx, gomock.Any()).Times(0)
-	mockLattice.EXPECT().GetListener(ctx, gomock.Any()).Times(0)
 
 	lm := NewListenerManager(gwlog.FallbackLogger, cloud)
 	status, err := lm.Upsert(ctx, ml, ms)
@@ -934,6 +1133,11 @@ func Test_ListenerManager_WithTakeoverAnnotation_UpdateTags(t *testing.T) {
 		pkg_aws.TagManagedBy: cloud.DefaultTags()[pkg_aws.TagManagedBy],
 	}
 	mockTagging.EXPECT().UpdateTags(ctx, "existing-arn", ml.Spec.AdditionalTags, expectedAwsManagedTags).Return(nil)
+	mockLattice.EXPECT().GetListener(ctx, gomock.Any()).Return(&vpclattice.GetListenerOutput{
+		DefaultAction: &types.RuleActionMemberFixedResponse{
+			Value: types.FixedResponseAction{StatusCode: aws.Int32(404)},
+		},
+	}, nil)
 
 	lm := NewListenerManager(gwlog.FallbackLogger, cloud)
 	status, err := lm.Upsert(ctx, ml, ms)
@@ -941,3 +1145,61 @@ func Test_ListenerManager_WithTakeoverAnnotation_UpdateTags(t *testing.T) {
 	assert.Equal(t, "existing-arn", status.ListenerArn)
 	assert.Equal(t, "existing-id", status.Id)
 }
+
+func Test_ListenerManager_SkipsGetListenerForAppliedDefaultAction(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+	ctx := context.TODO()
+	mockLattice := mocks.NewMockLattice(c)
+	mockTagging := mocks.NewMockTagging(c)
+	cloud := pkg_aws.NewDefaultCloudWithTagging(mockLattice, mockTagging, TestCloudConfig)
+
+	ml := &model.Listener{
+		Spec: model.ListenerSpec{
+			Protocol: string(types.ListenerProtocolHttp),
+			Port:     8080,
+			DefaultAction: &model.DefaultAction{
+				FixedResponseStatusCode: aws.Int64(404),
+			},
+		},
+	}
+	ms := &model.Service{
+		Status: &model.ServiceStatus{Id: "svc-id"},
+	}
+
+	mockLattice.EXPECT().ListListenersAsList(ctx, gomock.Any()).Return(
+		[]types.ListenerSummary{
+			{
+				Arn:  aws.String("existing-arn"),
+				Id:   aws.String("existing-id"),
+				Name: aws.String("existing-name"),
+				Port: aws.Int32(8080),
+			},
+		}, nil).Times(3)
+	mockTagging.EXPECT().UpdateTags(ctx, "existing-arn", gomock.Any(), nil).Return(nil).Times(3)
+
+	// the first upsert reads the default action, which is up to date
+	mockLattice.EXPECT().GetListener(ctx, gomock.Any()).Return(&vpclattice.GetListenerOutput{
+		DefaultAction: &types.RuleActionMemberFixedResponse{
+			Value: types.FixedResponseAction{StatusCode: aws.Int32(404)},
+		},
+	}, nil)
+	lm := NewListenerManager(gwlog.FallbackLogger, cloud)
+	_, err := lm.Upsert(ctx, ml, ms)
+	assert.Nil(t, err)
+
+	// the second one trusts the applied default action
+	_, err = lm.Upsert(ctx, ml, ms)
+	assert.Nil(t, err)
+
+	// a changed default action is read and updated
+	ml.Spec.DefaultAction.FixedResponseStatusCode = aws.Int64(500)
+	mockLattice.EXPECT().GetListener(ctx, gomock.Any()).Return(&vpclattice.GetListenerOutput{
+		DefaultAction: &types.RuleActionMemberFixedResponse{
+			Value: types.FixedResponseAction{StatusCode: aws.Int32(404)},
+		},
+	}, nil)
+	mockLattice.EXPECT().UpdateListener(ctx, gomock.Any()).Return(&vpclattice.UpdateListenerOutput{}, nil)
+	_, err = lm.Upsert(ctx, ml, ms)
+	assert.Nil(t, err)
+}
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 
@@ -112,7 +114,14 @@ func (r *defaultRuleManager) buildLatticeRule(modelRule *model.Rule) (*vpclattic
 		}
 	}
 
-	if hasValidTargetGroup {
+	if modelRule.Spec.Action.FixedResponseStatusCode != nil {
+		statusCode := *modelRule.Spec.Action.FixedResponseStatusCode
+		gro.Action = &types.RuleActionMemberFixedResponse{
+			Value: types.FixedResponseAction{
+				StatusCode: &statusCode,
+			},
+		}
+	} else if hasValidTargetGroup {
 		var latticeTGs []types.WeightedTargetGroup
 		for _, ruleTg := range modelRule.Spec.Action.TargetGroups {
 			// skip any invalid TGs - eventually VPC Lattice may support weighted fixed response
@@ -339,6 +348,10 @@ func updateMatchFromRule(httpMatch *types.HttpMatch, modelRule *model.Rule) {
 			Name:          modelRule.Spec.MatchedHeaders[i].Name,
 			CaseSensitive: aws.Bool(false), // see HTTPHeaderMatch.HTTPHeaderName in gw spec
 		}
+		// prefix and contains matches translated from regular expressions are case-sensitive
+		if modelRule.Spec.MatchedHeaders[i].CaseSensitive != nil {
+			headerMatch.CaseSensitive = modelRule.Spec.MatchedHeaders[i].CaseSensitive
+		}
 		httpMatch.HeaderMatches = append(httpMatch.HeaderMatches, headerMatch)
 	}
 }
@@ -346,15 +359,28 @@ func updateMatchFromRule(httpMatch *types.HttpMatch, modelRule *model.Rule) {
 func isMatchEqual(localRule, latticeRule *vpclattice.GetRuleOutput) bool {
 	// Normalize nil vs empty HeaderMatches before comparison.
 	// The Lattice API returns empty slices while the local model uses nil.
+	// Header matches are compared regardless of order, with a missing case-sensitivity flag
+	// treated as false, the Lattice default.
 	normalizeMatch := func(m types.RuleMatch) types.RuleMatch {
-		if hm, ok := m.(*types.RuleMatchMemberHttpMatch); ok {
-			if hm.Value.HeaderMatches != nil && len(hm.Value.HeaderMatches) == 0 {
-				v := hm.Value
-				v.HeaderMatches = nil
-				return &types.RuleMatchMemberHttpMatch{Value: v}
-			}
+		hm, ok := m.(*types.RuleMatchMemberHttpMatch)
+		if !ok {
+			return m
+		}
+		v := hm.Value
+		if len(v.HeaderMatches) == 0 {
+			v.HeaderMatches = nil
+			return &types.RuleMatchMemberHttpMatch{Value: v}
+		}
+		headerMatches := make([]types.HeaderMatch, len(v.HeaderMatches))
+		for i, h := range v.HeaderMatches {
+			h.CaseSensitive = aws.Bool(aws.ToBool(h.CaseSensitive))
+			headerMatches[i] = h
 		}
-		return m
+		sort.SliceStable(headerMatches, func(i, j int) bool {
+			return strings.ToLower(aws.ToString(headerMatches[i].Name)) < strings.ToLower(aws.ToString(headerMatches[j].Name))
+		})
+		v.HeaderMatches = headerMatches
+		return &types.RuleMatchMemberHttpMatch{Value: v}
 	}
 	return reflect.DeepEqual(normalizeMatch(localRule.Match), normalizeMatch(latticeRule.Match))
 }
@@ -815,3 +815,172 @@ func Test_RuleManager_ExternalTargetGroupArnDiffersFromExistingRule_Updates(t *t
 	_, err := rm.Upsert(ctx, r, l, svc)
 	assert.Nil(t, err)
 }
+
+func Test_RuleManager_FixedResponseAction_Update(t *testing.T) {
+	existingForward := &types.RuleActionMemberForward{
+		Value: types.ForwardAction{
+			TargetGroups: []types.WeightedTargetGroup{
+				{
+					TargetGroupIdentifier: aws.String("tg-id"),
+					Weight:                aws.Int32(1),
+				},
+			},
+		},
+	}
+	fixedResponse := func(code int32) types.RuleAction {
+		return &types.RuleActionMemberFixedResponse{
+			Value: types.FixedResponseAction{StatusCode: aws.Int32(code)},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		existingAction types.RuleAction
+		updateExpected bool
+	}{
+		{
+			name:           "forward to fixed response",
+			existingAction: existingForward,
+			updateExpected: true,
+		},
+		{
+			name:           "different status code",
+			existingAction: fixedResponse(500),
+			updateExpected: true,
+		},
+		{
+			name:           "same status code",
+			existingAction: fixedResponse(404),
+			updateExpected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			ctx := context.TODO()
+			mockLattice := mocks.NewMockLattice(c)
+			mockTagging := mocks.NewMockTagging(c)
+			cloud := pkg_aws.NewDefaultCloudWithTagging(mockLattice, mockTagging, TestCloudConfig)
+
+			svc := &model.Service{
+				Status: &model.ServiceStatus{Id: "svc-id"},
+			}
+			l := &model.Listener{
+				Spec: model.ListenerSpec{
+					Port:     80,
+					Protocol: "HTTP",
+				},
+				Status: &model.ListenerStatus{Id: "listener-id"},
+			}
+			r := &model.Rule{
+				Spec: model.RuleSpec{
+					Priority: 1,
+					Method:   "GET",
+					Action: model.RuleAction{
+						FixedResponseStatusCode: aws.Int32(404),
+					},
+				},
+			}
+
+			mockLattice.EXPECT().GetRulesAsList(ctx, gomock.Any()).Return(
+				[]*vpclattice.GetRuleOutput{
+					{
+						Id:  aws.String("existing-id"),
+						Arn: aws.String("existing-arn"),
+						Match: &types.RuleMatchMemberHttpMatch{
+							Value: types.HttpMatch{
+								Method: aws.String("GET"),
+							},
+						},
+						Action:   tt.existingAction,
+						Name:     aws.String("existing-name"),
+						Priority: aws.Int32(1),
+					},
+				}, nil)
+			mockTagging.EXPECT().UpdateTags(ctx, "existing-arn", gomock.Any(), nil).Return(nil)
+
+			if tt.updateExpected {
+				mockLattice.EXPECT().UpdateRule(ctx, gomock.Any()).DoAndReturn(
+					func(ctx context.Context, input *vpclattice.UpdateRuleInput, i ...interface{}) (*vpclattice.UpdateRuleOutput, error) {
+						assert.Equal(t, fixedResponse(404), input.Action)
+						return &vpclattice.UpdateRuleOutput{}, nil
+					})
+			} else {
+				mockLattice.EXPECT().UpdateRule(ctx, gomock.Any()).Times(0)
+			}
+
+			rm := NewRuleManager(gwlog.FallbackLogger, cloud)
+			ruleStatus, err := rm.Upsert(ctx, r, l, svc)
+			assert.Nil(t, err)
+			assert.Equal(t, "existing-arn", ruleStatus.Arn)
+		})
+	}
+}
+
+func Test_isMatchEqual_HeaderMatches(t *testing.T) {
+	header := func(name string, match types.HeaderMatchType, caseSensitive *bool) types.HeaderMatch {
+		return types.HeaderMatch{Name: aws.String(name), Match: match, CaseSensitive: caseSensitive}
+	}
+	rule := func(headers ...types.HeaderMatch) *vpclattice.GetRuleOutput {
+		return &vpclattice.GetRuleOutput{
+			Match: &types.RuleMatchMemberHttpMatch{
+				Value: types.HttpMatch{HeaderMatches: headers},
+			},
+		}
+	}
+	prefix := &types.HeaderMatchTypeMemberPrefix{Value: "foo"}
+	contains := &types.HeaderMatchTypeMemberContains{Value: "foo"}
+	exact := &types.HeaderMatchTypeMemberExact{Value: "foo"}
+
+	tests := []struct {
+		name     string
+		local    *vpclattice.GetRuleOutput
+		lattice  *vpclattice.GetRuleOutput
+		expected bool
+	}{
+		{
+			name:     "same prefix match",
+			local:    rule(header("a", prefix, aws.Bool(true))),
+			lattice:  rule(header("a", prefix, aws.Bool(true))),
+			expected: true,
+		},
+		{
+			name:     "prefix and contains differ",
+			local:    rule(header("a", prefix, aws.Bool(true))),
+			lattice:  rule(header("a", contains, aws.Bool(true))),
+			expected: false,
+		},
+		{
+			name:     "case sensitivity differs",
+			local:    rule(header("a", contains, aws.Bool(true))),
+			lattice:  rule(header("a", contains, aws.Bool(false))),
+			expected: false,
+		},
+		{
+			name:     "missing case sensitivity is false",
+			local:    rule(header("a", exact, aws.Bool(false))),
+			lattice:  rule(header("a", exact, nil)),
+			expected: true,
+		},
+		{
+			name:     "header order is ignored",
+			local:    rule(header("a", exact, aws.Bool(false)), header("b", prefix, aws.Bool(true))),
+			lattice:  rule(header("b", prefix, aws.Bool(true)), header("a", exact, aws.Bool(false))),
+			expected: true,
+		},
+		{
+			name:     "empty and nil header matches",
+			local:    rule(),
+			lattice:  rule([]types.HeaderMatch{}...),
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isMatchEqual(tt.local, tt.lattice))
+		})
+	}
+}
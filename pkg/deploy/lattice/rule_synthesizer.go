@@ -1,9 +1,11 @@
 package lattice
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 
@@ -140,30 +142,69 @@ func (r *ruleSynthesizer) deleteStaleLatticeRules(ctx context.Context, snlRules
 func (r *ruleSynthesizer) adjustPriorities(ctx context.Context, snlStackRules map[snlKey]ruleIdMap, resRule []*model.Rule) error {
 	var updateErr error
 	for snl := range snlStackRules {
-		activeRules := snlStackRules[snl]
-		for _, rule := range activeRules {
-			if rule.Spec.Priority != rule.Status.Priority {
-				// *any* mismatch in priority prompts a batch update of ALL priorities
-				r.log.Debugf(ctx, "Found rule priority mismatch, update required")
-
-				var rulesToUpdate []*model.Rule
-				for _, snlRule := range activeRules {
-					rulesToUpdate = append(rulesToUpdate, snlRule)
-				}
+		groups := groupByRouteRule(snlStackRules[snl])
+		for _, group := range groups {
+			if !hasPriorityMismatch(group) {
+				continue
+			}
 
-				err := r.ruleManager.UpdatePriorities(ctx, snl.SvcId, snl.ListenerId, rulesToUpdate)
-				if err != nil {
-					updateErr = errors.Join(updateErr,
-						fmt.Errorf("failed RuleManager.UpdatePriorities for rules %+v due to %s", resRule, err))
-				}
-				break
+			// *any* mismatch in priority prompts a batch update of ALL priorities
+			r.log.Debugf(ctx, "Found rule priority mismatch for route rule %d, update required",
+				group[0].Spec.RouteRuleIndex)
+
+			// rules expanded from the same route rule are kept next to each other, ordered by
+			// match, so a route rule's priorities always move together in the batch
+			var rulesToUpdate []*model.Rule
+			for _, g := range groups {
+				rulesToUpdate = append(rulesToUpdate, g...)
+			}
+
+			err := r.ruleManager.UpdatePriorities(ctx, snl.SvcId, snl.ListenerId, rulesToUpdate)
+			if err != nil {
+				updateErr = errors.Join(updateErr,
+					fmt.Errorf("failed RuleManager.UpdatePriorities for rules %+v due to %s", resRule, err))
 			}
+			break
 		}
 	}
 
 	return updateErr
 }
 
+// groupByRouteRule groups lattice rules by the route rule they were expanded from.
+// Groups are ordered by route rule index and rules within a group by match index
+func groupByRouteRule(rules ruleIdMap) [][]*model.Rule {
+	byIndex := make(map[int][]*model.Rule)
+	for _, rule := range rules {
+		byIndex[rule.Spec.RouteRuleIndex] = append(byIndex[rule.Spec.RouteRuleIndex], rule)
+	}
+
+	indexes := make([]int, 0, len(byIndex))
+	for i := range byIndex {
+		indexes = append(indexes, i)
+	}
+	slices.Sort(indexes)
+
+	groups := make([][]*model.Rule, 0, len(indexes))
+	for _, i := range indexes {
+		group := byIndex[i]
+		slices.SortFunc(group, func(a, b *model.Rule) int {
+			return cmp.Compare(a.Spec.MatchIndex, b.Spec.MatchIndex)
+		})
+		groups = append(groups, group)
+	}
+	return groups
+}
+
+func hasPriorityMismatch(rules []*model.Rule) bool {
+	for _, rule := range rules {
+		if rule.Spec.Priority != rule.Status.Priority {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *ruleSynthesizer) getStackObjects(rule *model.Rule) (*model.Listener, *model.Service, error) {
 	listener := &model.Listener{}
 	err := r.stack.GetResource(rule.Spec.StackListenerId, listener)
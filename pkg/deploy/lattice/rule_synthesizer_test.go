@@ -135,3 +135,36 @@ func Test_SynthesizeRule(t *testing.T) {
 		rs.Synthesize(ctx)
 	})
 }
+
+func Test_GroupByRouteRule(t *testing.T) {
+	newRule := func(id string, routeRuleIndex, matchIndex int) *model.Rule {
+		return &model.Rule{
+			Spec: model.RuleSpec{
+				RouteRuleIndex: routeRuleIndex,
+				MatchIndex:     matchIndex,
+			},
+			Status: &model.RuleStatus{Id: id},
+		}
+	}
+
+	rules := ruleIdMap{
+		"r-1-1": newRule("r-1-1", 1, 1),
+		"r-0-0": newRule("r-0-0", 0, 0),
+		"r-1-0": newRule("r-1-0", 1, 0),
+		"r-2-0": newRule("r-2-0", 2, 0),
+		"r-1-2": newRule("r-1-2", 1, 2),
+	}
+
+	groups := groupByRouteRule(rules)
+	assert.Equal(t, 3, len(groups))
+
+	var ids [][]string
+	for _, group := range groups {
+		var groupIds []string
+		for _, rule := range group {
+			groupIds = append(groupIds, rule.Status.Id)
+		}
+		ids = append(ids, groupIds)
+	}
+	assert.Equal(t, [][]string{{"r-0-0"}, {"r-1-0", "r-1-1", "r-1-2"}, {"r-2-0"}}, ids)
+}
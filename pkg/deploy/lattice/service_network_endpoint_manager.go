@@ -0,0 +1,268 @@
+package lattice
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+
+	pkg_aws "github.com/aws/aws-application-networking-k8s/pkg/aws"
+	"github.com/aws/aws-application-networking-k8s/pkg/aws/services"
+	model "github.com/aws/aws-application-networking-k8s/pkg/model/lattice"
+	lattice_runtime "github.com/aws/aws-application-networking-k8s/pkg/runtime"
+	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
+)
+
+//go:generate mockgen -destination service_network_endpoint_manager_mock.go -package lattice github.com/aws/aws-application-networking-k8s/pkg/deploy/lattice ServiceNetworkEndpointManager
+
+type ServiceNetworkEndpointManager interface {
+	// Upsert creates the VPC endpoint of a ServiceNetworkEndpoint resource, converges its subnets and security
+	// groups, and returns the services reachable through it.
+	Upsert(ctx context.Context, sne *model.ServiceNetworkEndpoint) (model.ServiceNetworkEndpointStatus, error)
+
+	// Delete deletes the VPC endpoint of a ServiceNetworkEndpoint resource.
+	Delete(ctx context.Context, sne *model.ServiceNetworkEndpoint) error
+}
+
+func NewDefaultServiceNetworkEndpointManager(log gwlog.Logger, cloud pkg_aws.Cloud) *defaultServiceNetworkEndpointManager {
+	return &defaultServiceNetworkEndpointManager{
+		log:   log,
+		cloud: cloud,
+	}
+}
+
+type defaultServiceNetworkEndpointManager struct {
+	log   gwlog.Logger
+	cloud pkg_aws.Cloud
+}
+
+func (m *defaultServiceNetworkEndpointManager) Upsert(ctx context.Context, sne *model.ServiceNetworkEndpoint) (model.ServiceNetworkEndpointStatus, error) {
+	status := model.ServiceNetworkEndpointStatus{}
+	// the service network is the one named by the resource, even when DEFAULT_SERVICE_NETWORK overrides the ones of
+	// Gateways
+	allSn, err := m.cloud.Lattice().ListServiceNetworksAsList(ctx, &vpclattice.ListServiceNetworksInput{})
+	if err != nil {
+		return status, err
+	}
+	sn, err := services.MatchServiceNetwork(allSn, sne.ServiceNetwork)
+	if err != nil {
+		return status, err
+	}
+	status.ServiceNetworkARN = aws.ToString(sn.Arn)
+
+	vpce, err := m.findVpcEndpoint(ctx, sne)
+	if err != nil {
+		return status, err
+	}
+	if vpce != nil && (aws.ToString(vpce.ServiceNetworkArn) != status.ServiceNetworkARN || aws.ToString(vpce.VpcId) != sne.VpcId) {
+		// the service network and VPC of an endpoint cannot be modified
+		m.log.Infof(ctx, "Replacing VPC endpoint %s of ServiceNetworkEndpoint %s", aws.ToString(vpce.VpcEndpointId), sne.Name)
+		if err = m.deleteVpcEndpoint(ctx, aws.ToString(vpce.VpcEndpointId)); err != nil {
+			return status, err
+		}
+		vpce = nil
+		status.Modified = true
+	}
+	if vpce == nil {
+		vpce, err = m.createVpcEndpoint(ctx, sne, status.ServiceNetworkARN)
+		if err != nil {
+			return status, err
+		}
+		status.Modified = true
+	}
+	status.VpcEndpointId = aws.ToString(vpce.VpcEndpointId)
+	status.State = string(vpce.State)
+
+	switch vpce.State {
+	case ec2types.StateAvailable:
+		updated, err := m.updateVpcEndpoint(ctx, sne, vpce)
+		if err != nil {
+			return status, err
+		}
+		status.Modified = status.Modified || updated
+	case ec2types.StatePending, ec2types.StatePendingAcceptance:
+		return status, fmt.Errorf("%w, VPC endpoint %s is %s", lattice_runtime.NewRetryError(), status.VpcEndpointId, vpce.State)
+	default:
+		reason := ""
+		if vpce.LastError != nil {
+			reason = ": " + aws.ToString(vpce.LastError.Message)
+		}
+		return status, fmt.Errorf("VPC endpoint %s is %s%s", status.VpcEndpointId, vpce.State, reason)
+	}
+
+	status.Services, err = m.listServices(ctx, status.VpcEndpointId)
+	if err != nil {
+		return status, err
+	}
+	for _, svc := range status.Services {
+		if strings.EqualFold(svc.Accessibility, "Pending") {
+			return status, fmt.Errorf("%w, service %s is pending on VPC endpoint %s", lattice_runtime.NewRetryError(), svc.ServiceArn, status.VpcEndpointId)
+		}
+	}
+	return status, nil
+}
+
+func (m *defaultServiceNetworkEndpointManager) Delete(ctx context.Context, sne *model.ServiceNetworkEndpoint) error {
+	vpce, err := m.findVpcEndpoint(ctx, sne)
+	if err != nil || vpce == nil {
+		return err
+	}
+	return m.deleteVpcEndpoint(ctx, aws.ToString(vpce.VpcEndpointId))
+}
+
+// findVpcEndpoint returns the VPC endpoint created by this controller for the ServiceNetworkEndpoint resource,
+// or nil if there is none
+func (m *defaultServiceNetworkEndpointManager) findVpcEndpoint(ctx context.Context, sne *model.ServiceNetworkEndpoint) (*ec2types.VpcEndpoint, error) {
+	vpces, err := m.cloud.EC2().DescribeVpcEndpointsAsList(ctx, &ec2.DescribeVpcEndpointsInput{
+		Filters: []ec2types.Filter{
+			{Name: aws.String("vpc-endpoint-type"), Values: []string{string(ec2types.VpcEndpointTypeServiceNetwork)}},
+			{Name: aws.String("tag:" + model.ServiceNetworkEndpointTagKey), Values: []string{sne.Name}},
+			{Name: aws.String("tag:" + pkg_aws.TagManagedBy), Values: []string{m.cloud.DefaultTags()[pkg_aws.TagManagedBy]}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, vpce := range vpces {
+		if vpce.State != ec2types.StateDeleting && vpce.State != ec2types.StateDeleted {
+			return &vpce, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *defaultServiceNetworkEndpointManager) createVpcEndpoint(ctx context.Context, sne *model.ServiceNetworkEndpoint, snArn string) (*ec2types.VpcEndpoint, error) {
+	tags := m.cloud.DefaultTagsMergedWith(services.Tags{model.ServiceNetworkEndpointTagKey: sne.Name})
+	tags = m.cloud.MergeTags(tags, sne.AdditionalTags)
+	m.log.Infof(ctx, "Creating VPC endpoint of ServiceNetworkEndpoint %s to %s", sne.Name, snArn)
+	resp, err := m.cloud.EC2().CreateVpcEndpoint(ctx, &ec2.CreateVpcEndpointInput{
+		VpcEndpointType:   ec2types.VpcEndpointTypeServiceNetwork,
+		ServiceNetworkArn: aws.String(snArn),
+		VpcId:             aws.String(sne.VpcId),
+		SubnetIds:         sne.SubnetIds,
+		SecurityGroupIds:  sne.SecurityGroupIds,
+		TagSpecifications: []ec2types.TagSpecification{{
+			ResourceType: ec2types.ResourceTypeVpcEndpoint,
+			Tags:         toEC2Tags(tags),
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.VpcEndpoint, nil
+}
+
+// updateVpcEndpoint reverts the subnets and security groups of the VPC endpoint to the ones of the resource, and
+// returns true when they differed
+func (m *defaultServiceNetworkEndpointManager) updateVpcEndpoint(ctx context.Context, sne *model.ServiceNetworkEndpoint, vpce *ec2types.VpcEndpoint) (bool, error) {
+	input := &ec2.ModifyVpcEndpointInput{VpcEndpointId: vpce.VpcEndpointId}
+	input.AddSubnetIds, input.RemoveSubnetIds = diffIds(sne.SubnetIds, vpce.SubnetIds)
+	if len(sne.SecurityGroupIds) > 0 {
+		var sgIds []string
+		for _, group := range vpce.Groups {
+			sgIds = append(sgIds, aws.ToString(group.GroupId))
+		}
+		input.AddSecurityGroupIds, input.RemoveSecurityGroupIds = diffIds(sne.SecurityGroupIds, sgIds)
+	}
+	if len(input.AddSubnetIds)+len(input.RemoveSubnetIds)+len(input.AddSecurityGroupIds)+len(input.RemoveSecurityGroupIds) == 0 {
+		return false, nil
+	}
+
+	m.log.Infof(ctx, "Updating subnets and security groups of VPC endpoint %s", aws.ToString(vpce.VpcEndpointId))
+	if _, err := m.cloud.EC2().ModifyVpcEndpoint(ctx, input); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (m *defaultServiceNetworkEndpointManager) deleteVpcEndpoint(ctx context.Context, vpceId string) error {
+	m.log.Infof(ctx, "Deleting VPC endpoint %s", vpceId)
+	resp, err := m.cloud.EC2().DeleteVpcEndpoints(ctx, &ec2.DeleteVpcEndpointsInput{
+		VpcEndpointIds: []string{vpceId},
+	})
+	if err != nil {
+		return err
+	}
+	for _, item := range resp.Unsuccessful {
+		if item.Error != nil && !strings.HasSuffix(aws.ToString(item.Error.Code), ".NotFound") {
+			return fmt.Errorf("failed to delete VPC endpoint %s: %s", vpceId, aws.ToString(item.Error.Message))
+		}
+	}
+	return nil
+}
+
+// listServices returns the services reachable through the VPC endpoint, sorted by ARN
+func (m *defaultServiceNetworkEndpointManager) listServices(ctx context.Context, vpceId string) ([]model.ServiceNetworkEndpointService, error) {
+	associations, err := m.cloud.EC2().DescribeVpcEndpointAssociationsAsList(ctx, &ec2.DescribeVpcEndpointAssociationsInput{
+		VpcEndpointIds: []string{vpceId},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var svcs []model.ServiceNetworkEndpointService
+	for _, association := range associations {
+		svcArn := aws.ToString(association.AssociatedResourceArn)
+		parsedArn, err := arn.Parse(svcArn)
+		if err != nil || !strings.HasPrefix(parsedArn.Resource, "service/") {
+			// resource configurations are not services
+			continue
+		}
+		svc := model.ServiceNetworkEndpointService{
+			ServiceArn:    svcArn,
+			Accessibility: aws.ToString(association.AssociatedResourceAccessibility),
+		}
+		if dnsEntry := association.DnsEntry; dnsEntry != nil {
+			svc.DnsName = aws.ToString(dnsEntry.DnsName)
+			svc.HostedZoneId = aws.ToString(dnsEntry.HostedZoneId)
+		}
+		// services of other accounts in a shared service network cannot be read
+		if parsedArn.AccountID == m.cloud.Config().AccountId {
+			latticeSvc, err := m.cloud.Lattice().GetService(ctx, &vpclattice.GetServiceInput{ServiceIdentifier: aws.String(svcArn)})
+			if services.IgnoreNotFound(err) != nil {
+				return nil, err
+			}
+			if err == nil {
+				svc.CustomDomainName = aws.ToString(latticeSvc.CustomDomainName)
+			}
+		}
+		svcs = append(svcs, svc)
+	}
+	slices.SortFunc(svcs, func(a, b model.ServiceNetworkEndpointService) int {
+		return strings.Compare(a.ServiceArn, b.ServiceArn)
+	})
+	return svcs, nil
+}
+
+// diffIds returns the desired ids missing from the current ones, and the current ids not desired
+func diffIds(desired, current []string) ([]string, []string) {
+	var add, remove []string
+	for _, id := range desired {
+		if !slices.Contains(current, id) {
+			add = append(add, id)
+		}
+	}
+	for _, id := range current {
+		if !slices.Contains(desired, id) {
+			remove = append(remove, id)
+		}
+	}
+	return add, remove
+}
+
+func toEC2Tags(tags services.Tags) []ec2types.Tag {
+	var ec2Tags []ec2types.Tag
+	for key, value := range tags {
+		ec2Tags = append(ec2Tags, ec2types.Tag{Key: aws.String(key), Value: aws.String(value)})
+	}
+	slices.SortFunc(ec2Tags, func(a, b ec2types.Tag) int {
+		return strings.Compare(aws.ToString(a.Key), aws.ToString(b.Key))
+	})
+	return ec2Tags
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/aws/aws-application-networking-k8s/pkg/deploy/lattice (interfaces: ServiceNetworkEndpointManager)
+//
+// Generated by this command:
+//
+//	mockgen -destination service_network_endpoint_manager_mock.go -package lattice github.com/aws/aws-application-networking-k8s/pkg/deploy/lattice ServiceNetworkEndpointManager
+//
+
+// Package lattice is a generated GoMock package.
+package lattice
+
+import (
+	context "context"
+	reflect "reflect"
+
+	lattice "github.com/aws/aws-application-networking-k8s/pkg/model/lattice"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockServiceNetworkEndpointManager is a mock of ServiceNetworkEndpointManager interface.
+type MockServiceNetworkEndpointManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceNetworkEndpointManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceNetworkEndpointManagerMockRecorder is the mock recorder for MockServiceNetworkEndpointManager.
+type MockServiceNetworkEndpointManagerMockRecorder struct {
+	mock *MockServiceNetworkEndpointManager
+}
+
+// NewMockServiceNetworkEndpointManager creates a new mock instance.
+func NewMockServiceNetworkEndpointManager(ctrl *gomock.Controller) *MockServiceNetworkEndpointManager {
+	mock := &MockServiceNetworkEndpointManager{ctrl: ctrl}
+	mock.recorder = &MockServiceNetworkEndpointManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceNetworkEndpointManager) EXPECT() *MockServiceNetworkEndpointManagerMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockServiceNetworkEndpointManager) Delete(ctx context.Context, sne *lattice.ServiceNetworkEndpoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, sne)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockServiceNetworkEndpointManagerMockRecorder) Delete(ctx, sne any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockServiceNetworkEndpointManager)(nil).Delete), ctx, sne)
+}
+
+// Upsert mocks base method.
+func (m *MockServiceNetworkEndpointManager) Upsert(ctx context.Context, sne *lattice.ServiceNetworkEndpoint) (lattice.ServiceNetworkEndpointStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, sne)
+	ret0, _ := ret[0].(lattice.ServiceNetworkEndpointStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockServiceNetworkEndpointManagerMockRecorder) Upsert(ctx, sne any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockServiceNetworkEndpointManager)(nil).Upsert), ctx, sne)
+}
@@ -0,0 +1,276 @@
+package lattice
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	pkg_aws "github.com/aws/aws-application-networking-k8s/pkg/aws"
+	mocks "github.com/aws/aws-application-networking-k8s/pkg/aws/services"
+	"github.com/aws/aws-application-networking-k8s/pkg/config"
+	model "github.com/aws/aws-application-networking-k8s/pkg/model/lattice"
+	lattice_runtime "github.com/aws/aws-application-networking-k8s/pkg/runtime"
+	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
+)
+
+const (
+	testSneSnArn  = "arn:aws:vpc-lattice:region:account-id:servicenetwork/sn-1"
+	testSneSvcArn = "arn:aws:vpc-lattice:region:account-id:service/svc-1"
+)
+
+func Test_UpsertServiceNetworkEndpoint(t *testing.T) {
+	tests := []struct {
+		name             string
+		securityGroupIds []string
+		overrideMode     bool
+		mockEC2          func(m *mocks.MockEC2)
+		mockLattice      func(m *mocks.MockLattice)
+		wantRequeue      bool
+		wantErr          string
+		wantVpcEndpoint  string
+		wantState        string
+		wantModified     bool
+		wantServices     []model.ServiceNetworkEndpointService
+	}{
+		{
+			name: "creates the vpc endpoint",
+			mockEC2: func(m *mocks.MockEC2) {
+				m.EXPECT().DescribeVpcEndpointsAsList(gomock.Any(), gomock.Any()).Return(nil, nil)
+				m.EXPECT().CreateVpcEndpoint(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, input *ec2.CreateVpcEndpointInput, _ ...func(*ec2.Options)) (*ec2.CreateVpcEndpointOutput, error) {
+						assert.Equal(t, ec2types.VpcEndpointTypeServiceNetwork, input.VpcEndpointType)
+						assert.Equal(t, testSneSnArn, aws.ToString(input.ServiceNetworkArn))
+						assert.Equal(t, "vpc-id", aws.ToString(input.VpcId))
+						assert.Equal(t, []string{"subnet-1", "subnet-2"}, input.SubnetIds)
+						assert.Contains(t, input.TagSpecifications[0].Tags, ec2types.Tag{
+							Key: aws.String(model.ServiceNetworkEndpointTagKey), Value: aws.String("ns/sne")})
+						return &ec2.CreateVpcEndpointOutput{VpcEndpoint: &ec2types.VpcEndpoint{
+							VpcEndpointId: aws.String("vpce-1"),
+							State:         ec2types.StatePending,
+						}}, nil
+					})
+			},
+			wantRequeue:     true,
+			wantVpcEndpoint: "vpce-1",
+			wantState:       "Pending",
+			wantModified:    true,
+		},
+		{
+			// the endpoint is created in the service network of the resource, not the default one
+			name:         "ignores the default service network in override mode",
+			overrideMode: true,
+			mockEC2: func(m *mocks.MockEC2) {
+				m.EXPECT().DescribeVpcEndpointsAsList(gomock.Any(), gomock.Any()).Return(nil, nil)
+				m.EXPECT().CreateVpcEndpoint(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, input *ec2.CreateVpcEndpointInput, _ ...func(*ec2.Options)) (*ec2.CreateVpcEndpointOutput, error) {
+						assert.Equal(t, testSneSnArn, aws.ToString(input.ServiceNetworkArn))
+						return &ec2.CreateVpcEndpointOutput{VpcEndpoint: &ec2types.VpcEndpoint{
+							VpcEndpointId: aws.String("vpce-1"),
+							State:         ec2types.StatePending,
+						}}, nil
+					})
+			},
+			wantRequeue:     true,
+			wantVpcEndpoint: "vpce-1",
+			wantState:       "Pending",
+			wantModified:    true,
+		},
+		{
+			name:             "updates the vpc endpoint and lists its services",
+			securityGroupIds: []string{"sg-1"},
+			mockEC2: func(m *mocks.MockEC2) {
+				m.EXPECT().DescribeVpcEndpointsAsList(gomock.Any(), gomock.Any()).Return([]ec2types.VpcEndpoint{{
+					VpcEndpointId:     aws.String("vpce-1"),
+					VpcId:             aws.String("vpc-id"),
+					ServiceNetworkArn: aws.String(testSneSnArn),
+					State:             ec2types.StateAvailable,
+					SubnetIds:         []string{"subnet-1", "subnet-3"},
+					Groups:            []ec2types.SecurityGroupIdentifier{{GroupId: aws.String("sg-default")}},
+				}}, nil)
+				m.EXPECT().ModifyVpcEndpoint(gomock.Any(), &ec2.ModifyVpcEndpointInput{
+					VpcEndpointId:          aws.String("vpce-1"),
+					AddSubnetIds:           []string{"subnet-2"},
+					RemoveSubnetIds:        []string{"subnet-3"},
+					AddSecurityGroupIds:    []string{"sg-1"},
+					RemoveSecurityGroupIds: []string{"sg-default"},
+				}).Return(&ec2.ModifyVpcEndpointOutput{}, nil)
+				m.EXPECT().DescribeVpcEndpointAssociationsAsList(gomock.Any(), gomock.Any()).Return([]ec2types.VpcEndpointAssociation{
+					{
+						AssociatedResourceArn:           aws.String(testSneSvcArn),
+						AssociatedResourceAccessibility: aws.String("Accessible"),
+						DnsEntry: &ec2types.DnsEntry{
+							DnsName:      aws.String("svc-1.vpce.on.aws"),
+							HostedZoneId: aws.String("Z123"),
+						},
+					},
+					{
+						AssociatedResourceArn: aws.String("arn:aws:vpc-lattice:region:other-account:service/svc-0"),
+					},
+					{
+						AssociatedResourceArn: aws.String("arn:aws:vpc-lattice:region:account-id:resourceconfiguration/rcfg-1"),
+					},
+				}, nil)
+			},
+			mockLattice: func(m *mocks.MockLattice) {
+				m.EXPECT().GetService(gomock.Any(), &vpclattice.GetServiceInput{ServiceIdentifier: aws.String(testSneSvcArn)}).
+					Return(&vpclattice.GetServiceOutput{CustomDomainName: aws.String("svc.example.com")}, nil)
+			},
+			wantVpcEndpoint: "vpce-1",
+			wantState:       "Available",
+			wantModified:    true,
+			wantServices: []model.ServiceNetworkEndpointService{
+				{
+					ServiceArn:       testSneSvcArn,
+					CustomDomainName: "svc.example.com",
+					DnsName:          "svc-1.vpce.on.aws",
+					HostedZoneId:     "Z123",
+					Accessibility:    "Accessible",
+				},
+				{ServiceArn: "arn:aws:vpc-lattice:region:other-account:service/svc-0"},
+			},
+		},
+		{
+			name: "leaves an up to date vpc endpoint alone",
+			mockEC2: func(m *mocks.MockEC2) {
+				m.EXPECT().DescribeVpcEndpointsAsList(gomock.Any(), gomock.Any()).Return([]ec2types.VpcEndpoint{{
+					VpcEndpointId:     aws.String("vpce-1"),
+					VpcId:             aws.String("vpc-id"),
+					ServiceNetworkArn: aws.String(testSneSnArn),
+					State:             ec2types.StateAvailable,
+					SubnetIds:         []string{"subnet-2", "subnet-1"},
+				}}, nil)
+				m.EXPECT().DescribeVpcEndpointAssociationsAsList(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			wantVpcEndpoint: "vpce-1",
+			wantState:       "Available",
+		},
+		{
+			name: "replaces the vpc endpoint on service network change",
+			mockEC2: func(m *mocks.MockEC2) {
+				m.EXPECT().DescribeVpcEndpointsAsList(gomock.Any(), gomock.Any()).Return([]ec2types.VpcEndpoint{{
+					VpcEndpointId:     aws.String("vpce-old"),
+					VpcId:             aws.String("vpc-id"),
+					ServiceNetworkArn: aws.String("arn:aws:vpc-lattice:region:account-id:servicenetwork/sn-old"),
+					State:             ec2types.StateAvailable,
+				}}, nil)
+				m.EXPECT().DeleteVpcEndpoints(gomock.Any(), &ec2.DeleteVpcEndpointsInput{VpcEndpointIds: []string{"vpce-old"}}).
+					Return(&ec2.DeleteVpcEndpointsOutput{}, nil)
+				m.EXPECT().CreateVpcEndpoint(gomock.Any(), gomock.Any()).Return(&ec2.CreateVpcEndpointOutput{
+					VpcEndpoint: &ec2types.VpcEndpoint{VpcEndpointId: aws.String("vpce-new"), State: ec2types.StatePending},
+				}, nil)
+			},
+			wantRequeue:     true,
+			wantVpcEndpoint: "vpce-new",
+			wantState:       "Pending",
+			wantModified:    true,
+		},
+		{
+			name: "failed vpc endpoint",
+			mockEC2: func(m *mocks.MockEC2) {
+				m.EXPECT().DescribeVpcEndpointsAsList(gomock.Any(), gomock.Any()).Return([]ec2types.VpcEndpoint{{
+					VpcEndpointId:     aws.String("vpce-1"),
+					VpcId:             aws.String("vpc-id"),
+					ServiceNetworkArn: aws.String(testSneSnArn),
+					State:             ec2types.StateFailed,
+					LastError:         &ec2types.LastError{Message: aws.String("subnet not found")},
+				}}, nil)
+			},
+			wantErr:         "subnet not found",
+			wantVpcEndpoint: "vpce-1",
+			wantState:       "Failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			ctx := context.TODO()
+			mockLattice := mocks.NewMockLattice(c)
+			mockEC2 := mocks.NewMockEC2(c)
+			cloud := pkg_aws.NewDefaultCloudWithEC2(mockLattice, mockEC2, TestCloudConfig)
+
+			if tt.overrideMode {
+				originalOverrideMode := config.ServiceNetworkOverrideMode
+				originalDefaultServiceNetwork := config.DefaultServiceNetwork
+				config.ServiceNetworkOverrideMode = true
+				config.DefaultServiceNetwork = "default-sn"
+				defer func() {
+					config.ServiceNetworkOverrideMode = originalOverrideMode
+					config.DefaultServiceNetwork = originalDefaultServiceNetwork
+				}()
+			}
+
+			mockLattice.EXPECT().ListServiceNetworksAsList(gomock.Any(), gomock.Any()).Return([]types.ServiceNetworkSummary{
+				{Name: aws.String("sn"), Arn: aws.String(testSneSnArn), Id: aws.String("sn-1")},
+				{Name: aws.String("default-sn"), Arn: aws.String("arn:aws:vpc-lattice:region:account-id:servicenetwork/sn-default"), Id: aws.String("sn-default")},
+			}, nil)
+			tt.mockEC2(mockEC2)
+			if tt.mockLattice != nil {
+				tt.mockLattice(mockLattice)
+			}
+
+			m := NewDefaultServiceNetworkEndpointManager(gwlog.FallbackLogger, cloud)
+			status, err := m.Upsert(ctx, &model.ServiceNetworkEndpoint{
+				Name:             "ns/sne",
+				ServiceNetwork:   "sn",
+				VpcId:            "vpc-id",
+				SubnetIds:        []string{"subnet-1", "subnet-2"},
+				SecurityGroupIds: tt.securityGroupIds,
+			})
+
+			var requeue *lattice_runtime.RequeueNeededAfter
+			switch {
+			case tt.wantRequeue:
+				assert.ErrorAs(t, err, &requeue)
+			case tt.wantErr != "":
+				assert.ErrorContains(t, err, tt.wantErr)
+				assert.False(t, errors.As(err, &requeue))
+			default:
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testSneSnArn, status.ServiceNetworkARN)
+			assert.Equal(t, tt.wantVpcEndpoint, status.VpcEndpointId)
+			assert.Equal(t, tt.wantState, status.State)
+			assert.Equal(t, tt.wantModified, status.Modified)
+			assert.Equal(t, tt.wantServices, status.Services)
+		})
+	}
+}
+
+func Test_DeleteServiceNetworkEndpoint(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+	ctx := context.TODO()
+	mockLattice := mocks.NewMockLattice(c)
+	mockEC2 := mocks.NewMockEC2(c)
+	cloud := pkg_aws.NewDefaultCloudWithEC2(mockLattice, mockEC2, TestCloudConfig)
+	m := NewDefaultServiceNetworkEndpointManager(gwlog.FallbackLogger, cloud)
+	sne := &model.ServiceNetworkEndpoint{Name: "ns/sne", ServiceNetwork: "sn", VpcId: "vpc-id"}
+
+	// nothing to delete
+	mockEC2.EXPECT().DescribeVpcEndpointsAsList(gomock.Any(), gomock.Any()).Return([]ec2types.VpcEndpoint{{
+		VpcEndpointId: aws.String("vpce-1"),
+		State:         ec2types.StateDeleting,
+	}}, nil)
+	assert.NoError(t, m.Delete(ctx, sne))
+
+	// already gone
+	mockEC2.EXPECT().DescribeVpcEndpointsAsList(gomock.Any(), gomock.Any()).Return([]ec2types.VpcEndpoint{{
+		VpcEndpointId: aws.String("vpce-2"),
+		State:         ec2types.StateAvailable,
+	}}, nil)
+	mockEC2.EXPECT().DeleteVpcEndpoints(gomock.Any(), &ec2.DeleteVpcEndpointsInput{VpcEndpointIds: []string{"vpce-2"}}).
+		Return(&ec2.DeleteVpcEndpointsOutput{Unsuccessful: []ec2types.UnsuccessfulItem{{
+			Error: &ec2types.UnsuccessfulItemError{Code: aws.String("InvalidVpcEndpoint.NotFound")},
+		}}}, nil)
+	assert.NoError(t, m.Delete(ctx, sne))
+}
@@ -2,7 +2,10 @@ package lattice
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"reflect"
 
 	"slices"
 
@@ -32,10 +35,30 @@ type ServiceNetworkManager interface {
 	// Does not create a VPC association.
 	Upsert(ctx context.Context, name string, additionalTags services.Tags) (model.ServiceNetworkStatus, error)
 
-	// Delete deletes a service network by name if owned by this controller.
-	Delete(ctx context.Context, snName string) error
+	// UpsertConfig converges the auth type, auth policy, access log subscriptions and VPC associations of a service
+	// network with its configuration, reverting any drift.
+	UpsertConfig(ctx context.Context, sn model.ServiceNetworkStatus, cfg *model.ServiceNetworkConfig) (model.ServiceNetworkConfigStatus, error)
+
+	// RepairDrift reverts the tags and auth type of a service network owned by this controller changed out of band,
+	// and returns the kinds of drift it repaired. An empty authType leaves the auth type as it is.
+	RepairDrift(ctx context.Context, sn model.ServiceNetworkStatus, authType string) ([]string, error)
+
+	// Delete deletes a service network by name if owned by this controller, along with its resource share when shared
+	// through RAM.
+	Delete(ctx context.Context, snName string, shared bool) error
+
+	// DeleteProvisioned deletes a service network created by this controller with the given tags, along with the VPC
+	// associations created for it. It leaves service networks still associated with services or other VPCs in place,
+	// and returns true in that case.
+	DeleteProvisioned(ctx context.Context, snName string, provisionedTags services.Tags) (bool, error)
 }
 
+const (
+	ServiceNetworkDriftRecreated = "Recreated"
+	ServiceNetworkDriftTags      = "Tags"
+	ServiceNetworkDriftAuthType  = "AuthType"
+)
+
 func NewDefaultServiceNetworkManager(log gwlog.Logger, cloud pkg_aws.Cloud) *defaultServiceNetworkManager {
 	return &defaultServiceNetworkManager{
 		log:   log,
@@ -318,7 +341,7 @@ func (m *defaultServiceNetworkManager) Upsert(ctx context.Context, name string,
 	}, nil
 }
 
-func (m *defaultServiceNetworkManager) Delete(ctx context.Context, snName string) error {
+func (m *defaultServiceNetworkManager) Delete(ctx context.Context, snName string, shared bool) error {
 	sn, err := m.cloud.Lattice().FindServiceNetwork(ctx, snName)
 	if err != nil {
 		if services.IsNotFoundError(err) {
@@ -337,6 +360,18 @@ func (m *defaultServiceNetworkManager) Delete(ctx context.Context, snName string
 		return nil
 	}
 
+	if shared {
+		share, err := m.findResourceShare(ctx, snName)
+		if err != nil {
+			return err
+		}
+		if share != nil {
+			if err := m.deleteResourceShare(ctx, snName, share); err != nil {
+				return err
+			}
+		}
+	}
+
 	_, err = m.cloud.Lattice().DeleteServiceNetwork(ctx, &vpclattice.DeleteServiceNetworkInput{
 		ServiceNetworkIdentifier: sn.SvcNetwork.Id,
 	})
@@ -348,6 +383,115 @@ func (m *defaultServiceNetworkManager) Delete(ctx context.Context, snName string
 	return nil
 }
 
+func (m *defaultServiceNetworkManager) RepairDrift(ctx context.Context, sn model.ServiceNetworkStatus, authType string) ([]string, error) {
+	var repaired []string
+	tagsResp, err := m.cloud.Lattice().ListTagsForResource(ctx, &vpclattice.ListTagsForResourceInput{
+		ResourceArn: aws.String(sn.ServiceNetworkARN),
+	})
+	if err != nil {
+		return repaired, err
+	}
+	driftedTags := services.Tags{}
+	for key, value := range m.cloud.DefaultTags() {
+		if tagsResp.Tags[key] != value {
+			driftedTags[key] = value
+		}
+	}
+	if len(driftedTags) > 0 {
+		m.log.Infof(ctx, "Reverting tags %v of ServiceNetwork %s", driftedTags, sn.ServiceNetworkARN)
+		_, err = m.cloud.Lattice().TagResource(ctx, &vpclattice.TagResourceInput{
+			ResourceArn: aws.String(sn.ServiceNetworkARN),
+			Tags:        driftedTags,
+		})
+		if err != nil {
+			return repaired, err
+		}
+		repaired = append(repaired, ServiceNetworkDriftTags)
+	}
+
+	if authType == "" {
+		return repaired, nil
+	}
+	snResp, err := m.cloud.Lattice().GetServiceNetwork(ctx, &vpclattice.GetServiceNetworkInput{
+		ServiceNetworkIdentifier: aws.String(sn.ServiceNetworkID),
+	})
+	if err != nil {
+		return repaired, err
+	}
+	if string(snResp.AuthType) != authType {
+		m.log.Infof(ctx, "Reverting auth type of ServiceNetwork %s from %s to %s", sn.ServiceNetworkARN, snResp.AuthType, authType)
+		_, err = m.cloud.Lattice().UpdateServiceNetwork(ctx, &vpclattice.UpdateServiceNetworkInput{
+			ServiceNetworkIdentifier: aws.String(sn.ServiceNetworkID),
+			AuthType:                 types.AuthType(authType),
+		})
+		if err != nil {
+			return repaired, err
+		}
+		repaired = append(repaired, ServiceNetworkDriftAuthType)
+	}
+	return repaired, nil
+}
+
+func (m *defaultServiceNetworkManager) DeleteProvisioned(ctx context.Context, snName string, provisionedTags services.Tags) (bool, error) {
+	sn, err := m.cloud.Lattice().FindServiceNetwork(ctx, snName)
+	if err != nil {
+		if services.IsNotFoundError(err) {
+			return false, nil // already gone
+		}
+		return false, err
+	}
+	isLocal, err := m.isLocalServiceNetwork(sn.SvcNetwork.Arn)
+	if err != nil || !isLocal {
+		return false, err
+	}
+	tagsResp, err := m.cloud.Lattice().ListTagsForResource(ctx, &vpclattice.ListTagsForResourceInput{
+		ResourceArn: sn.SvcNetwork.Arn,
+	})
+	if err != nil {
+		return false, err
+	}
+	for key, value := range m.cloud.DefaultTagsMergedWith(provisionedTags) {
+		if tagsResp.Tags[key] != value {
+			m.log.Infof(ctx, "ServiceNetwork %s not provisioned by controller, skipping deletion", snName)
+			return false, nil
+		}
+	}
+
+	ssas, err := m.cloud.Lattice().ListServiceNetworkServiceAssociationsAsList(ctx, &vpclattice.ListServiceNetworkServiceAssociationsInput{
+		ServiceNetworkIdentifier: sn.SvcNetwork.Id,
+	})
+	if err != nil {
+		return false, err
+	}
+	if len(ssas) > 0 {
+		m.log.Infof(ctx, "ServiceNetwork %s still has %d service associations, skipping deletion", snName, len(ssas))
+		return true, nil
+	}
+
+	// deletes the VPC associations created for the service network
+	_, err = m.upsertVpcAssociations(ctx, aws.ToString(sn.SvcNetwork.Id), &model.ServiceNetworkConfig{Name: snName})
+	if err != nil {
+		return false, err
+	}
+	snvas, err := m.cloud.Lattice().ListServiceNetworkVpcAssociationsAsList(ctx, &vpclattice.ListServiceNetworkVpcAssociationsInput{
+		ServiceNetworkIdentifier: sn.SvcNetwork.Id,
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, snva := range snvas {
+		if snva.Status != types.ServiceNetworkVpcAssociationStatusDeleteInProgress {
+			m.log.Infof(ctx, "ServiceNetwork %s is associated with VPC %s, skipping deletion", snName, aws.ToString(snva.VpcId))
+			return true, nil
+		}
+	}
+	if len(snvas) > 0 {
+		return false, fmt.Errorf("%w, vpc associations of ServiceNetwork %s being deleted", lattice_runtime.NewRetryError(), snName)
+	}
+	// provisioned service networks are not shared
+	return false, m.Delete(ctx, snName, false)
+}
+
 func (m *defaultServiceNetworkManager) updateServiceNetworkVpcAssociation(ctx context.Context, existingSN *types.ServiceNetworkSummary, sgIds []string, existingSnvaId *string, additionalTags services.Tags) (model.ServiceNetworkStatus, error) {
 	snva, err := m.cloud.Lattice().GetServiceNetworkVpcAssociation(ctx, &vpclattice.GetServiceNetworkVpcAssociationInput{
 		ServiceNetworkVpcAssociationIdentifier: existingSnvaId,
@@ -388,6 +532,313 @@ func (m *defaultServiceNetworkManager) updateServiceNetworkVpcAssociation(ctx co
 	}
 }
 
+func (m *defaultServiceNetworkManager) UpsertConfig(ctx context.Context, sn model.ServiceNetworkStatus, cfg *model.ServiceNetworkConfig) (model.ServiceNetworkConfigStatus, error) {
+	status := model.ServiceNetworkConfigStatus{}
+	var err error
+	status.AuthType, status.AuthPolicyState, err = m.upsertAuth(ctx, sn.ServiceNetworkID, cfg)
+	if err != nil {
+		return status, err
+	}
+	status.AccessLogSubscriptions, err = m.upsertAccessLogSubscriptions(ctx, sn.ServiceNetworkARN, cfg)
+	if err != nil {
+		return status, err
+	}
+	// associations still in progress do not hold back sharing
+	var vpcErr error
+	status.VpcAssociations, vpcErr = m.upsertVpcAssociations(ctx, sn.ServiceNetworkID, cfg)
+	requeue := &lattice_runtime.RequeueNeededAfter{}
+	if vpcErr != nil && !errors.As(vpcErr, &requeue) {
+		return status, vpcErr
+	}
+	if cfg.Sharing != nil || cfg.SharingManaged {
+		status.Sharing, err = m.upsertSharing(ctx, sn.ServiceNetworkARN, cfg)
+		if err != nil {
+			return status, err
+		}
+	}
+	return status, vpcErr
+}
+
+func (m *defaultServiceNetworkManager) upsertAuth(ctx context.Context, snId string, cfg *model.ServiceNetworkConfig) (string, string, error) {
+	sn, err := m.cloud.Lattice().GetServiceNetwork(ctx, &vpclattice.GetServiceNetworkInput{
+		ServiceNetworkIdentifier: &snId,
+	})
+	if err != nil {
+		return "", "", err
+	}
+	authType := string(sn.AuthType)
+	if cfg.AuthType != nil && authType != *cfg.AuthType {
+		m.log.Infof(ctx, "Updating auth type of ServiceNetwork %s from %s to %s", cfg.Name, authType, *cfg.AuthType)
+		_, err = m.cloud.Lattice().UpdateServiceNetwork(ctx, &vpclattice.UpdateServiceNetworkInput{
+			ServiceNetworkIdentifier: &snId,
+			AuthType:                 types.AuthType(*cfg.AuthType),
+		})
+		if err != nil {
+			return "", "", err
+		}
+		authType = *cfg.AuthType
+	}
+
+	if cfg.AuthPolicy == nil {
+		if cfg.AuthPolicyManaged {
+			m.log.Infof(ctx, "Deleting auth policy of ServiceNetwork %s", cfg.Name)
+			_, err = m.cloud.Lattice().DeleteAuthPolicy(ctx, &vpclattice.DeleteAuthPolicyInput{ResourceIdentifier: &snId})
+			if services.IgnoreNotFound(err) != nil {
+				return "", "", err
+			}
+		}
+		return authType, "", nil
+	}
+
+	policy, err := m.cloud.Lattice().GetAuthPolicy(ctx, &vpclattice.GetAuthPolicyInput{ResourceIdentifier: &snId})
+	if err != nil && !services.IsNotFoundError(err) {
+		return "", "", err
+	}
+	if err == nil && authPolicyEqual(aws.ToString(policy.Policy), *cfg.AuthPolicy) {
+		return authType, string(policy.State), nil
+	}
+	m.log.Infof(ctx, "Putting auth policy of ServiceNetwork %s", cfg.Name)
+	resp, err := m.cloud.Lattice().PutAuthPolicy(ctx, &vpclattice.PutAuthPolicyInput{
+		ResourceIdentifier: &snId,
+		Policy:             cfg.AuthPolicy,
+	})
+	if err != nil {
+		return "", "", err
+	}
+	return authType, string(resp.State), nil
+}
+
+// authPolicyEqual compares policy documents regardless of their formatting
+func authPolicyEqual(policy1, policy2 string) bool {
+	var doc1, doc2 any
+	if json.Unmarshal([]byte(policy1), &doc1) != nil || json.Unmarshal([]byte(policy2), &doc2) != nil {
+		return policy1 == policy2
+	}
+	return reflect.DeepEqual(doc1, doc2)
+}
+
+// upsertAccessLogSubscriptions subscribes the service network to the configured destinations. Subscriptions created
+// for the ServiceNetwork resource to other destinations are deleted first, as there can only be one per destination type
+func (m *defaultServiceNetworkManager) upsertAccessLogSubscriptions(ctx context.Context, snArn string, cfg *model.ServiceNetworkConfig) ([]model.ServiceNetworkAccessLogSubscriptionStatus, error) {
+	resp, err := m.cloud.Lattice().ListAccessLogSubscriptions(ctx, &vpclattice.ListAccessLogSubscriptionsInput{
+		ResourceIdentifier: &snArn,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]string)
+	for _, als := range resp.Items {
+		destinationArn := aws.ToString(als.DestinationArn)
+		if slices.Contains(cfg.AccessLogDestinations, destinationArn) {
+			existing[destinationArn] = aws.ToString(als.Arn)
+			continue
+		}
+		managed, err := m.isConfigManaged(ctx, aws.ToString(als.Arn), cfg.Name)
+		if err != nil {
+			return nil, err
+		}
+		if !managed {
+			continue
+		}
+		m.log.Infof(ctx, "Deleting access log subscription of ServiceNetwork %s to %s", cfg.Name, destinationArn)
+		_, err = m.cloud.Lattice().DeleteAccessLogSubscription(ctx, &vpclattice.DeleteAccessLogSubscriptionInput{
+			AccessLogSubscriptionIdentifier: als.Id,
+		})
+		if services.IgnoreNotFound(err) != nil {
+			return nil, err
+		}
+	}
+
+	var statuses []model.ServiceNetworkAccessLogSubscriptionStatus
+	for _, destinationArn := range cfg.AccessLogDestinations {
+		alsArn, ok := existing[destinationArn]
+		if !ok {
+			m.log.Infof(ctx, "Creating access log subscription of ServiceNetwork %s to %s", cfg.Name, destinationArn)
+			resp, err := m.cloud.Lattice().CreateAccessLogSubscription(ctx, &vpclattice.CreateAccessLogSubscriptionInput{
+				ResourceIdentifier: &snArn,
+				DestinationArn:     aws.String(destinationArn),
+				Tags:               m.configTags(cfg),
+			})
+			if err != nil {
+				var ce *types.ConflictException
+				if errors.As(err, &ce) {
+					return statuses, services.NewConflictError("AccessLogSubscription", cfg.Name, aws.ToString(ce.Message))
+				}
+				return statuses, err
+			}
+			alsArn = aws.ToString(resp.Arn)
+		}
+		statuses = append(statuses, model.ServiceNetworkAccessLogSubscriptionStatus{
+			DestinationArn: destinationArn,
+			Arn:            alsArn,
+		})
+	}
+	return statuses, nil
+}
+
+// upsertVpcAssociations associates the service network with the configured VPCs. Associations created for the
+// ServiceNetwork resource with other VPCs are deleted
+func (m *defaultServiceNetworkManager) upsertVpcAssociations(ctx context.Context, snId string, cfg *model.ServiceNetworkConfig) ([]model.ServiceNetworkVpcAssociationStatus, error) {
+	snvas, err := m.cloud.Lattice().ListServiceNetworkVpcAssociationsAsList(ctx, &vpclattice.ListServiceNetworkVpcAssociationsInput{
+		ServiceNetworkIdentifier: &snId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]types.ServiceNetworkVpcAssociationSummary)
+	for _, snva := range snvas {
+		vpcId := aws.ToString(snva.VpcId)
+		desired := slices.ContainsFunc(cfg.VpcAssociations, func(a model.ServiceNetworkVpcAssociation) bool {
+			return a.VpcId == vpcId
+		})
+		if desired {
+			existing[vpcId] = snva
+			continue
+		}
+		if snva.Status == types.ServiceNetworkVpcAssociationStatusDeleteInProgress {
+			continue
+		}
+		managed, err := m.isConfigManaged(ctx, aws.ToString(snva.Arn), cfg.Name)
+		if err != nil {
+			return nil, err
+		}
+		if !managed {
+			continue
+		}
+		m.log.Infof(ctx, "Disassociating ServiceNetwork %s from VPC %s", cfg.Name, vpcId)
+		_, err = m.cloud.Lattice().DeleteServiceNetworkVpcAssociation(ctx, &vpclattice.DeleteServiceNetworkVpcAssociationInput{
+			ServiceNetworkVpcAssociationIdentifier: snva.Id,
+		})
+		if services.IgnoreNotFound(err) != nil {
+			return nil, err
+		}
+	}
+
+	var statuses []model.ServiceNetworkVpcAssociationStatus
+	inProgress := false
+	for _, desired := range cfg.VpcAssociations {
+		var status model.ServiceNetworkVpcAssociationStatus
+		if snva, ok := existing[desired.VpcId]; ok {
+			status, err = m.updateConfigVpcAssociation(ctx, snva, desired, cfg)
+		} else {
+			m.log.Infof(ctx, "Associating ServiceNetwork %s with VPC %s", cfg.Name, desired.VpcId)
+			var resp *vpclattice.CreateServiceNetworkVpcAssociationOutput
+			resp, err = m.cloud.Lattice().CreateServiceNetworkVpcAssociation(ctx, &vpclattice.CreateServiceNetworkVpcAssociationInput{
+				ServiceNetworkIdentifier: &snId,
+				VpcIdentifier:            aws.String(desired.VpcId),
+				SecurityGroupIds:         desired.SecurityGroupIds,
+				Tags:                     m.configTags(cfg),
+			})
+			if err == nil {
+				status = model.ServiceNetworkVpcAssociationStatus{
+					VpcId:            desired.VpcId,
+					Arn:              aws.ToString(resp.Arn),
+					Status:           string(resp.Status),
+					SecurityGroupIds: resp.SecurityGroupIds,
+				}
+			}
+		}
+		if err != nil {
+			return statuses, err
+		}
+		statuses = append(statuses, status)
+		inProgress = inProgress || status.Status != string(types.ServiceNetworkVpcAssociationStatusActive)
+	}
+	if inProgress {
+		return statuses, fmt.Errorf("%w, vpc associations of ServiceNetwork %s in progress", lattice_runtime.NewRetryError(), cfg.Name)
+	}
+	return statuses, nil
+}
+
+func (m *defaultServiceNetworkManager) updateConfigVpcAssociation(ctx context.Context, snva types.ServiceNetworkVpcAssociationSummary,
+	desired model.ServiceNetworkVpcAssociation, cfg *model.ServiceNetworkConfig) (model.ServiceNetworkVpcAssociationStatus, error) {
+	status := model.ServiceNetworkVpcAssociationStatus{
+		VpcId:  desired.VpcId,
+		Arn:    aws.ToString(snva.Arn),
+		Status: string(snva.Status),
+	}
+	switch snva.Status {
+	case types.ServiceNetworkVpcAssociationStatusActive, types.ServiceNetworkVpcAssociationStatusUpdateFailed,
+		types.ServiceNetworkVpcAssociationStatusCreateFailed:
+	default:
+		// a mutation is in progress, check again later
+		return status, nil
+	}
+
+	// associations of the VPC made otherwise, like the one of the cluster VPC with the gateway service network,
+	// are left alone
+	managed, err := m.isConfigManaged(ctx, status.Arn, cfg.Name)
+	if err != nil {
+		return status, err
+	}
+	if !managed {
+		return status, services.NewConflictError("snva", cfg.Name,
+			fmt.Sprintf("Found existing vpc association not created for ServiceNetwork: %s", status.Arn))
+	}
+
+	if snva.Status == types.ServiceNetworkVpcAssociationStatusCreateFailed {
+		// the failed association is in the way of a new one, which is created once it is deleted
+		m.log.Infof(ctx, "Deleting failed association of ServiceNetwork %s with VPC %s", cfg.Name, desired.VpcId)
+		resp, err := m.cloud.Lattice().DeleteServiceNetworkVpcAssociation(ctx, &vpclattice.DeleteServiceNetworkVpcAssociationInput{
+			ServiceNetworkVpcAssociationIdentifier: snva.Id,
+		})
+		if services.IgnoreNotFound(err) != nil {
+			return status, err
+		}
+		if resp != nil {
+			status.Status = string(resp.Status)
+		}
+		return status, nil
+	}
+
+	err = m.cloud.Tagging().UpdateTags(ctx, status.Arn, cfg.AdditionalTags, services.Tags{model.ServiceNetworkTagKey: cfg.Name})
+	if err != nil {
+		return status, fmt.Errorf("failed to update tags for service network vpc association %s: %w", status.Arn, err)
+	}
+
+	resp, err := m.cloud.Lattice().GetServiceNetworkVpcAssociation(ctx, &vpclattice.GetServiceNetworkVpcAssociationInput{
+		ServiceNetworkVpcAssociationIdentifier: snva.Id,
+	})
+	if err != nil {
+		return status, err
+	}
+	status.SecurityGroupIds = resp.SecurityGroupIds
+	if securityGroupIdsEqual(desired.SecurityGroupIds, resp.SecurityGroupIds) {
+		return status, nil
+	}
+
+	m.log.Infof(ctx, "Updating security groups of ServiceNetwork %s association with VPC %s", cfg.Name, desired.VpcId)
+	updateResp, err := m.cloud.Lattice().UpdateServiceNetworkVpcAssociation(ctx, &vpclattice.UpdateServiceNetworkVpcAssociationInput{
+		ServiceNetworkVpcAssociationIdentifier: snva.Id,
+		SecurityGroupIds:                       desired.SecurityGroupIds,
+	})
+	if err != nil {
+		return status, err
+	}
+	status.Status = string(updateResp.Status)
+	status.SecurityGroupIds = updateResp.SecurityGroupIds
+	return status, nil
+}
+
+func (m *defaultServiceNetworkManager) configTags(cfg *model.ServiceNetworkConfig) services.Tags {
+	tags := m.cloud.DefaultTagsMergedWith(services.Tags{model.ServiceNetworkTagKey: cfg.Name})
+	return m.cloud.MergeTags(tags, cfg.AdditionalTags)
+}
+
+// isConfigManaged tells whether a resource was created by this controller for the named ServiceNetwork resource
+func (m *defaultServiceNetworkManager) isConfigManaged(ctx context.Context, arn, snName string) (bool, error) {
+	resp, err := m.cloud.Lattice().ListTagsForResource(ctx, &vpclattice.ListTagsForResourceInput{ResourceArn: &arn})
+	if err != nil {
+		return false, err
+	}
+	if resp.Tags[model.ServiceNetworkTagKey] != snName {
+		return false, nil
+	}
+	return m.cloud.TryOwnFromTags(ctx, arn, resp.Tags, true)
+}
+
 func securityGroupIdsEqual(arr1, arr2 []string) bool {
 	if len(arr1) == 0 && len(arr2) == 0 {
 		return true
@@ -58,17 +58,32 @@ func (mr *MockServiceNetworkManagerMockRecorder) CreateOrUpdate(ctx, serviceNetw
 }
 
 // Delete mocks base method.
-func (m *MockServiceNetworkManager) Delete(ctx context.Context, snName string) error {
+func (m *MockServiceNetworkManager) Delete(ctx context.Context, snName string, shared bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, snName)
+	ret := m.ctrl.Call(m, "Delete", ctx, snName, shared)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockServiceNetworkManagerMockRecorder) Delete(ctx, snName any) *gomock.Call {
+func (mr *MockServiceNetworkManagerMockRecorder) Delete(ctx, snName, shared any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockServiceNetworkManager)(nil).Delete), ctx, snName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockServiceNetworkManager)(nil).Delete), ctx, snName, shared)
+}
+
+// DeleteProvisioned mocks base method.
+func (m *MockServiceNetworkManager) DeleteProvisioned(ctx context.Context, snName string, provisionedTags services.Tags) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProvisioned", ctx, snName, provisionedTags)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProvisioned indicates an expected call of DeleteProvisioned.
+func (mr *MockServiceNetworkManagerMockRecorder) DeleteProvisioned(ctx, snName, provisionedTags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProvisioned", reflect.TypeOf((*MockServiceNetworkManager)(nil).DeleteProvisioned), ctx, snName, provisionedTags)
 }
 
 // DeleteVpcAssociation mocks base method.
@@ -85,6 +100,21 @@ func (mr *MockServiceNetworkManagerMockRecorder) DeleteVpcAssociation(ctx, snNam
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpcAssociation", reflect.TypeOf((*MockServiceNetworkManager)(nil).DeleteVpcAssociation), ctx, snName)
 }
 
+// RepairDrift mocks base method.
+func (m *MockServiceNetworkManager) RepairDrift(ctx context.Context, sn lattice.ServiceNetworkStatus, authType string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RepairDrift", ctx, sn, authType)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RepairDrift indicates an expected call of RepairDrift.
+func (mr *MockServiceNetworkManagerMockRecorder) RepairDrift(ctx, sn, authType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepairDrift", reflect.TypeOf((*MockServiceNetworkManager)(nil).RepairDrift), ctx, sn, authType)
+}
+
 // Upsert mocks base method.
 func (m *MockServiceNetworkManager) Upsert(ctx context.Context, name string, additionalTags services.Tags) (lattice.ServiceNetworkStatus, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +130,21 @@ func (mr *MockServiceNetworkManagerMockRecorder) Upsert(ctx, name, additionalTag
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockServiceNetworkManager)(nil).Upsert), ctx, name, additionalTags)
 }
 
+// UpsertConfig mocks base method.
+func (m *MockServiceNetworkManager) UpsertConfig(ctx context.Context, sn lattice.ServiceNetworkStatus, cfg *lattice.ServiceNetworkConfig) (lattice.ServiceNetworkConfigStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertConfig", ctx, sn, cfg)
+	ret0, _ := ret[0].(lattice.ServiceNetworkConfigStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertConfig indicates an expected call of UpsertConfig.
+func (mr *MockServiceNetworkManagerMockRecorder) UpsertConfig(ctx, sn, cfg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertConfig", reflect.TypeOf((*MockServiceNetworkManager)(nil).UpsertConfig), ctx, sn, cfg)
+}
+
 // UpsertVpcAssociation mocks base method.
 func (m *MockServiceNetworkManager) UpsertVpcAssociation(ctx context.Context, snName string, sgIds []string, additionalTags services.Tags) (string, error) {
 	m.ctrl.T.Helper()
@@ -964,7 +964,7 @@ func Test_Delete_NotFound(t *testing.T) {
 		Return(nil, mocks.NewNotFoundError("ServiceNetwork", "test-sn"))
 
 	snMgr := NewDefaultServiceNetworkManager(gwlog.FallbackLogger, cloud)
-	err := snMgr.Delete(ctx, "test-sn")
+	err := snMgr.Delete(ctx, "test-sn", false)
 
 	assert.Nil(t, err)
 }
@@ -994,7 +994,7 @@ func Test_Delete_NotOwned_Skips(t *testing.T) {
 		}, nil)
 
 	snMgr := NewDefaultServiceNetworkManager(gwlog.FallbackLogger, cloud)
-	err := snMgr.Delete(ctx, "test-sn")
+	err := snMgr.Delete(ctx, "test-sn", false)
 
 	assert.Nil(t, err)
 }
@@ -1004,7 +1004,8 @@ func Test_Delete_Owned_Deletes(t *testing.T) {
 	defer c.Finish()
 	ctx := context.TODO()
 	mockLattice := mocks.NewMockLattice(c)
-	cloud := pkg_aws.NewDefaultCloud(mockLattice, TestCloudConfig)
+	mockRAM := mocks.NewMockRAM(c)
+	cloud := pkg_aws.NewDefaultCloudWithRAM(mockLattice, mockRAM, TestCloudConfig)
 
 	snArn := "arn:aws:vpc-lattice:us-west-2:account-id:servicenetwork/sn-123"
 	mockLattice.EXPECT().FindServiceNetwork(gomock.Any(), "test-sn").
@@ -1020,10 +1021,11 @@ func Test_Delete_Owned_Deletes(t *testing.T) {
 		Return(&vpclattice.ListTagsForResourceOutput{
 			Tags: cloud.DefaultTags(),
 		}, nil)
+	mockRAM.EXPECT().GetResourceSharesAsList(gomock.Any(), gomock.Any()).Return(nil, nil)
 	mockLattice.EXPECT().DeleteServiceNetwork(gomock.Any(), gomock.Any()).Return(nil, nil)
 
 	snMgr := NewDefaultServiceNetworkManager(gwlog.FallbackLogger, cloud)
-	err := snMgr.Delete(ctx, "test-sn")
+	err := snMgr.Delete(ctx, "test-sn", true)
 
 	assert.Nil(t, err)
 }
@@ -1033,7 +1035,8 @@ func Test_Delete_ConflictException(t *testing.T) {
 	defer c.Finish()
 	ctx := context.TODO()
 	mockLattice := mocks.NewMockLattice(c)
-	cloud := pkg_aws.NewDefaultCloud(mockLattice, TestCloudConfig)
+	mockRAM := mocks.NewMockRAM(c)
+	cloud := pkg_aws.NewDefaultCloudWithRAM(mockLattice, mockRAM, TestCloudConfig)
 
 	snArn := "arn:aws:vpc-lattice:us-west-2:account-id:servicenetwork/sn-123"
 	mockLattice.EXPECT().FindServiceNetwork(gomock.Any(), "test-sn").
@@ -1048,12 +1051,412 @@ func Test_Delete_ConflictException(t *testing.T) {
 		Return(&vpclattice.ListTagsForResourceOutput{
 			Tags: cloud.DefaultTags(),
 		}, nil)
+	// the service network was never shared, so RAM is not queried
 	mockLattice.EXPECT().DeleteServiceNetwork(gomock.Any(), gomock.Any()).
 		Return(nil, errors.New("ConflictException: service network has VPC(s) associated"))
 
 	snMgr := NewDefaultServiceNetworkManager(gwlog.FallbackLogger, cloud)
-	err := snMgr.Delete(ctx, "test-sn")
+	err := snMgr.Delete(ctx, "test-sn", false)
 
 	assert.NotNil(t, err)
 	assert.Contains(t, err.Error(), "ConflictException")
 }
+
+func Test_UpsertConfig_RevertsDrift(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+	ctx := context.TODO()
+	mockLattice := mocks.NewMockLattice(c)
+	mockTagging := mocks.NewMockTagging(c)
+	cloud := pkg_aws.NewDefaultCloudWithTagging(mockLattice, mockTagging, TestCloudConfig)
+
+	sn := model.ServiceNetworkStatus{
+		ServiceNetworkARN: "arn:aws:vpc-lattice:us-west-2:123456789:servicenetwork/sn-123",
+		ServiceNetworkID:  "sn-123",
+	}
+	cfg := &model.ServiceNetworkConfig{
+		Name:                  "test-sn",
+		AuthType:              aws.String("AWS_IAM"),
+		AuthPolicy:            aws.String(`{"Version": "2012-10-17", "Statement": []}`),
+		AccessLogDestinations: []string{"arn:aws:s3:::new-bucket"},
+		VpcAssociations: []model.ServiceNetworkVpcAssociation{
+			{VpcId: "vpc-1", SecurityGroupIds: []string{"sg-1"}},
+			{VpcId: "vpc-2", SecurityGroupIds: []string{"sg-2"}},
+		},
+	}
+	managedTags := map[string]string{
+		pkg_aws.TagManagedBy:       "account-id/cluster/vpc-id",
+		model.ServiceNetworkTagKey: "test-sn",
+	}
+
+	// auth type and policy were changed out of band
+	mockLattice.EXPECT().GetServiceNetwork(gomock.Any(), gomock.Any()).
+		Return(&vpclattice.GetServiceNetworkOutput{AuthType: types.AuthTypeNone}, nil)
+	mockLattice.EXPECT().UpdateServiceNetwork(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, input *vpclattice.UpdateServiceNetworkInput, opts ...func(*vpclattice.Options)) (*vpclattice.UpdateServiceNetworkOutput, error) {
+			assert.Equal(t, types.AuthTypeAwsIam, input.AuthType)
+			return &vpclattice.UpdateServiceNetworkOutput{}, nil
+		})
+	mockLattice.EXPECT().GetAuthPolicy(gomock.Any(), gomock.Any()).
+		Return(&vpclattice.GetAuthPolicyOutput{Policy: aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow"}]}`)}, nil)
+	mockLattice.EXPECT().PutAuthPolicy(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, input *vpclattice.PutAuthPolicyInput, opts ...func(*vpclattice.Options)) (*vpclattice.PutAuthPolicyOutput, error) {
+			assert.Equal(t, cfg.AuthPolicy, input.Policy)
+			return &vpclattice.PutAuthPolicyOutput{State: types.AuthPolicyStateActive}, nil
+		})
+
+	// the subscription to the previous destination is replaced, the one of another owner is kept
+	mockLattice.EXPECT().ListAccessLogSubscriptions(gomock.Any(), gomock.Any()).
+		Return(&vpclattice.ListAccessLogSubscriptionsOutput{Items: []types.AccessLogSubscriptionSummary{
+			{Id: aws.String("als-old"), Arn: aws.String("als-old-arn"), DestinationArn: aws.String("arn:aws:s3:::old-bucket")},
+			{Id: aws.String("als-other"), Arn: aws.String("als-other-arn"), DestinationArn: aws.String("arn:aws:logs:us-west-2:123456789:log-group:other")},
+		}}, nil)
+	mockLattice.EXPECT().ListTagsForResource(gomock.Any(), &vpclattice.ListTagsForResourceInput{ResourceArn: aws.String("als-old-arn")}).
+		Return(&vpclattice.ListTagsForResourceOutput{Tags: managedTags}, nil)
+	mockLattice.EXPECT().ListTagsForResource(gomock.Any(), &vpclattice.ListTagsForResourceInput{ResourceArn: aws.String("als-other-arn")}).
+		Return(&vpclattice.ListTagsForResourceOutput{Tags: map[string]string{}}, nil)
+	mockLattice.EXPECT().DeleteAccessLogSubscription(gomock.Any(), &vpclattice.DeleteAccessLogSubscriptionInput{
+		AccessLogSubscriptionIdentifier: aws.String("als-old"),
+	}).Return(&vpclattice.DeleteAccessLogSubscriptionOutput{}, nil)
+	mockLattice.EXPECT().CreateAccessLogSubscription(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, input *vpclattice.CreateAccessLogSubscriptionInput, opts ...func(*vpclattice.Options)) (*vpclattice.CreateAccessLogSubscriptionOutput, error) {
+			assert.Equal(t, "arn:aws:s3:::new-bucket", aws.ToString(input.DestinationArn))
+			assert.Equal(t, "test-sn", input.Tags[model.ServiceNetworkTagKey])
+			return &vpclattice.CreateAccessLogSubscriptionOutput{Arn: aws.String("als-new-arn")}, nil
+		})
+
+	// vpc-1 security groups drifted, vpc-2 association was deleted, vpc-3 is no longer desired
+	mockLattice.EXPECT().ListServiceNetworkVpcAssociationsAsList(gomock.Any(), gomock.Any()).
+		Return([]types.ServiceNetworkVpcAssociationSummary{
+			{Id: aws.String("snva-1"), Arn: aws.String("snva-1-arn"), VpcId: aws.String("vpc-1"), Status: types.ServiceNetworkVpcAssociationStatusActive},
+			{Id: aws.String("snva-3"), Arn: aws.String("snva-3-arn"), VpcId: aws.String("vpc-3"), Status: types.ServiceNetworkVpcAssociationStatusActive},
+		}, nil)
+	mockLattice.EXPECT().ListTagsForResource(gomock.Any(), &vpclattice.ListTagsForResourceInput{ResourceArn: aws.String("snva-3-arn")}).
+		Return(&vpclattice.ListTagsForResourceOutput{Tags: managedTags}, nil)
+	mockLattice.EXPECT().DeleteServiceNetworkVpcAssociation(gomock.Any(), &vpclattice.DeleteServiceNetworkVpcAssociationInput{
+		ServiceNetworkVpcAssociationIdentifier: aws.String("snva-3"),
+	}).Return(&vpclattice.DeleteServiceNetworkVpcAssociationOutput{}, nil)
+	mockLattice.EXPECT().ListTagsForResource(gomock.Any(), &vpclattice.ListTagsForResourceInput{ResourceArn: aws.String("snva-1-arn")}).
+		Return(&vpclattice.ListTagsForResourceOutput{Tags: managedTags}, nil)
+	mockTagging.EXPECT().UpdateTags(gomock.Any(), "snva-1-arn", gomock.Any(), mocks.Tags{model.ServiceNetworkTagKey: "test-sn"}).Return(nil)
+	mockLattice.EXPECT().GetServiceNetworkVpcAssociation(gomock.Any(), gomock.Any()).
+		Return(&vpclattice.GetServiceNetworkVpcAssociationOutput{SecurityGroupIds: []string{"sg-other"}}, nil)
+	mockLattice.EXPECT().UpdateServiceNetworkVpcAssociation(gomock.Any(), gomock.Any()).
+		Return(&vpclattice.UpdateServiceNetworkVpcAssociationOutput{
+			Status:           types.ServiceNetworkVpcAssociationStatusActive,
+			SecurityGroupIds: []string{"sg-1"},
+		}, nil)
+	mockLattice.EXPECT().CreateServiceNetworkVpcAssociation(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, input *vpclattice.CreateServiceNetworkVpcAssociationInput, opts ...func(*vpclattice.Options)) (*vpclattice.CreateServiceNetworkVpcAssociationOutput, error) {
+			assert.Equal(t, "vpc-2", aws.ToString(input.VpcIdentifier))
+			assert.Equal(t, []string{"sg-2"}, input.SecurityGroupIds)
+			return &vpclattice.CreateServiceNetworkVpcAssociationOutput{
+				Arn:              aws.String("snva-2-arn"),
+				Status:           types.ServiceNetworkVpcAssociationStatusActive,
+				SecurityGroupIds: []string{"sg-2"},
+			}, nil
+		})
+
+	snMgr := NewDefaultServiceNetworkManager(gwlog.FallbackLogger, cloud)
+	status, err := snMgr.UpsertConfig(ctx, sn, cfg)
+
+	assert.Nil(t, err)
+	assert.Equal(t, model.ServiceNetworkConfigStatus{
+		AuthType:        "AWS_IAM",
+		AuthPolicyState: "Active",
+		AccessLogSubscriptions: []model.ServiceNetworkAccessLogSubscriptionStatus{
+			{DestinationArn: "arn:aws:s3:::new-bucket", Arn: "als-new-arn"},
+		},
+		VpcAssociations: []model.ServiceNetworkVpcAssociationStatus{
+			{VpcId: "vpc-1", Arn: "snva-1-arn", Status: "ACTIVE", SecurityGroupIds: []string{"sg-1"}},
+			{VpcId: "vpc-2", Arn: "snva-2-arn", Status: "ACTIVE", SecurityGroupIds: []string{"sg-2"}},
+		},
+	}, status)
+}
+
+func Test_UpsertConfig_UnsetFieldsAreNotManaged(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+	ctx := context.TODO()
+	mockLattice := mocks.NewMockLattice(c)
+	cloud := pkg_aws.NewDefaultCloud(mockLattice, TestCloudConfig)
+
+	sn := model.ServiceNetworkStatus{
+		ServiceNetworkARN: "arn:aws:vpc-lattice:us-west-2:123456789:servicenetwork/sn-123",
+		ServiceNetworkID:  "sn-123",
+	}
+
+	// the auth type and policy set with an IAMAuthPolicy and the cluster VPC association are left as they are
+	mockLattice.EXPECT().GetServiceNetwork(gomock.Any(), gomock.Any()).
+		Return(&vpclattice.GetServiceNetworkOutput{AuthType: types.AuthTypeAwsIam}, nil)
+	mockLattice.EXPECT().ListAccessLogSubscriptions(gomock.Any(), gomock.Any()).
+		Return(&vpclattice.ListAccessLogSubscriptionsOutput{}, nil)
+	mockLattice.EXPECT().ListServiceNetworkVpcAssociationsAsList(gomock.Any(), gomock.Any()).
+		Return([]types.ServiceNetworkVpcAssociationSummary{
+			{Id: aws.String("snva-1"), Arn: aws.String("snva-1-arn"), VpcId: aws.String("vpc-id"), Status: types.ServiceNetworkVpcAssociationStatusActive},
+		}, nil)
+	mockLattice.EXPECT().ListTagsForResource(gomock.Any(), gomock.Any()).
+		Return(&vpclattice.ListTagsForResourceOutput{Tags: map[string]string{pkg_aws.TagManagedBy: "account-id/cluster/vpc-id"}}, nil)
+
+	snMgr := NewDefaultServiceNetworkManager(gwlog.FallbackLogger, cloud)
+	status, err := snMgr.UpsertConfig(ctx, sn, &model.ServiceNetworkConfig{Name: "test-sn"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, model.ServiceNetworkConfigStatus{AuthType: "AWS_IAM"}, status)
+
+	// the auth policy previously set from the spec is deleted
+	mockLattice.EXPECT().GetServiceNetwork(gomock.Any(), gomock.Any()).
+		Return(&vpclattice.GetServiceNetworkOutput{AuthType: types.AuthTypeAwsIam}, nil)
+	mockLattice.EXPECT().DeleteAuthPolicy(gomock.Any(), gomock.Any()).Return(&vpclattice.DeleteAuthPolicyOutput{}, nil)
+	mockLattice.EXPECT().ListAccessLogSubscriptions(gomock.Any(), gomock.Any()).
+		Return(&vpclattice.ListAccessLogSubscriptionsOutput{}, nil)
+	mockLattice.EXPECT().ListServiceNetworkVpcAssociationsAsList(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	_, err = snMgr.UpsertConfig(ctx, sn, &model.ServiceNetworkConfig{Name: "test-sn", AuthPolicyManaged: true})
+	assert.Nil(t, err)
+}
+
+func Test_UpsertConfig_VpcAssociationInProgress(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+	ctx := context.TODO()
+	mockLattice := mocks.NewMockLattice(c)
+	cloud := pkg_aws.NewDefaultCloud(mockLattice, TestCloudConfig)
+
+	sn := model.ServiceNetworkStatus{
+		ServiceNetworkARN: "arn:aws:vpc-lattice:us-west-2:123456789:servicenetwork/sn-123",
+		ServiceNetworkID:  "sn-123",
+	}
+	cfg := &model.ServiceNetworkConfig{
+		Name:            "test-sn",
+		AuthType:        aws.String("NONE"),
+		VpcAssociations: []model.ServiceNetworkVpcAssociation{{VpcId: "vpc-1"}},
+	}
+
+	mockLattice.EXPECT().GetServiceNetwork(gomock.Any(), gomock.Any()).
+		Return(&vpclattice.GetServiceNetworkOutput{AuthType: types.AuthTypeNone}, nil)
+	mockLattice.EXPECT().ListAccessLogSubscriptions(gomock.Any(), gomock.Any()).
+		Return(&vpclattice.ListAccessLogSubscriptionsOutput{}, nil)
+	mockLattice.EXPECT().ListServiceNetworkVpcAssociationsAsList(gomock.Any(), gomock.Any()).
+		Return([]types.ServiceNetworkVpcAssociationSummary{
+			{Id: aws.String("snva-1"), Arn: aws.String("snva-1-arn"), VpcId: aws.String("vpc-1"), Status: types.ServiceNetworkVpcAssociationStatusCreateInProgress},
+		}, nil)
+
+	snMgr := NewDefaultServiceNetworkManager(gwlog.FallbackLogger, cloud)
+	status, err := snMgr.UpsertConfig(ctx, sn, cfg)
+
+	var requeueNeededAfter *lattice_runtime.RequeueNeededAfter
+	assert.ErrorAs(t, err, &requeueNeededAfter)
+	assert.Equal(t, []model.ServiceNetworkVpcAssociationStatus{
+		{VpcId: "vpc-1", Arn: "snva-1-arn", Status: "CREATE_IN_PROGRESS"},
+	}, status.VpcAssociations)
+}
+
+func Test_UpsertConfig_VpcAssociationNotCreatedForServiceNetwork(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+	ctx := context.TODO()
+	mockLattice := mocks.NewMockLattice(c)
+	mockTagging := mocks.NewMockTagging(c)
+	cloud := pkg_aws.NewDefaultCloudWithTagging(mockLattice, mockTagging, TestCloudConfig)
+
+	sn := model.ServiceNetworkStatus{
+		ServiceNetworkARN: "arn:aws:vpc-lattice:us-west-2:123456789:servicenetwork/sn-123",
+		ServiceNetworkID:  "sn-123",
+	}
+	cfg := &model.ServiceNetworkConfig{
+		Name:            "test-sn",
+		VpcAssociations: []model.ServiceNetworkVpcAssociation{{VpcId: "vpc-id"}},
+	}
+
+	// the cluster VPC association made for the gateway is owned by the controller, but not taken over
+	mockLattice.EXPECT().GetServiceNetwork(gomock.Any(), gomock.Any()).
+		Return(&vpclattice.GetServiceNetworkOutput{AuthType: types.AuthTypeNone}, nil)
+	mockLattice.EXPECT().ListAccessLogSubscriptions(gomock.Any(), gomock.Any()).
+		Return(&vpclattice.ListAccessLogSubscriptionsOutput{}, nil)
+	mockLattice.EXPECT().ListServiceNetworkVpcAssociationsAsList(gomock.Any(), gomock.Any()).
+		Return([]types.ServiceNetworkVpcAssociationSummary{
+			{Id: aws.String("snva-1"), Arn: aws.String("snva-1-arn"), VpcId: aws.String("vpc-id"), Status: types.ServiceNetworkVpcAssociationStatusActive},
+		}, nil)
+	mockLattice.EXPECT().ListTagsForResource(gomock.Any(), gomock.Any()).
+		Return(&vpclattice.ListTagsForResourceOutput{Tags: map[string]string{pkg_aws.TagManagedBy: "account-id/cluster/vpc-id"}}, nil)
+	mockTagging.EXPECT().UpdateTags(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	mockLattice.EXPECT().UpdateServiceNetworkVpcAssociation(gomock.Any(), gomock.Any()).Times(0)
+
+	snMgr := NewDefaultServiceNetworkManager(gwlog.FallbackLogger, cloud)
+	_, err := snMgr.UpsertConfig(ctx, sn, cfg)
+
+	assert.True(t, mocks.IsConflictError(err))
+}
+
+func Test_UpsertConfig_VpcAssociationCreateFailed(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+	ctx := context.TODO()
+	mockLattice := mocks.NewMockLattice(c)
+	cloud := pkg_aws.NewDefaultCloud(mockLattice, TestCloudConfig)
+
+	sn := model.ServiceNetworkStatus{
+		ServiceNetworkARN: "arn:aws:vpc-lattice:us-west-2:123456789:servicenetwork/sn-123",
+		ServiceNetworkID:  "sn-123",
+	}
+	cfg := &model.ServiceNetworkConfig{
+		Name:            "test-sn",
+		VpcAssociations: []model.ServiceNetworkVpcAssociation{{VpcId: "vpc-1"}},
+	}
+
+	// the failed association is deleted, rather than associating the VPC again next to it
+	mockLattice.EXPECT().GetServiceNetwork(gomock.Any(), gomock.Any()).
+		Return(&vpclattice.GetServiceNetworkOutput{AuthType: types.AuthTypeNone}, nil)
+	mockLattice.EXPECT().ListAccessLogSubscriptions(gomock.Any(), gomock.Any()).
+		Return(&vpclattice.ListAccessLogSubscriptionsOutput{}, nil)
+	mockLattice.EXPECT().ListServiceNetworkVpcAssociationsAsList(gomock.Any(), gomock.Any()).
+		Return([]types.ServiceNetworkVpcAssociationSummary{
+			{Id: aws.String("snva-1"), Arn: aws.String("snva-1-arn"), VpcId: aws.String("vpc-1"), Status: types.ServiceNetworkVpcAssociationStatusCreateFailed},
+		}, nil)
+	mockLattice.EXPECT().ListTagsForResource(gomock.Any(), gomock.Any()).
+		Return(&vpclattice.ListTagsForResourceOutput{Tags: map[string]string{
+			pkg_aws.TagManagedBy:       "account-id/cluster/vpc-id",
+			model.ServiceNetworkTagKey: "test-sn",
+		}}, nil)
+	mockLattice.EXPECT().DeleteServiceNetworkVpcAssociation(gomock.Any(), &vpclattice.DeleteServiceNetworkVpcAssociationInput{
+		ServiceNetworkVpcAssociationIdentifier: aws.String("snva-1"),
+	}).Return(&vpclattice.DeleteServiceNetworkVpcAssociationOutput{Status: types.ServiceNetworkVpcAssociationStatusDeleteInProgress}, nil)
+	mockLattice.EXPECT().CreateServiceNetworkVpcAssociation(gomock.Any(), gomock.Any()).Times(0)
+
+	snMgr := NewDefaultServiceNetworkManager(gwlog.FallbackLogger, cloud)
+	status, err := snMgr.UpsertConfig(ctx, sn, cfg)
+
+	var requeueNeededAfter *lattice_runtime.RequeueNeededAfter
+	assert.ErrorAs(t, err, &requeueNeededAfter)
+	assert.Equal(t, []model.ServiceNetworkVpcAssociationStatus{
+		{VpcId: "vpc-1", Arn: "snva-1-arn", Status: "DELETE_IN_PROGRESS"},
+	}, status.VpcAssociations)
+}
+
+func Test_authPolicyEqual(t *testing.T) {
+	assert.True(t, authPolicyEqual(`{"Version": "2012-10-17"}`, "{\n  \"Version\":\"2012-10-17\"\n}"))
+	assert.False(t, authPolicyEqual(`{"Version": "2012-10-17"}`, `{"Version": "2008-10-17"}`))
+	assert.False(t, authPolicyEqual("", `{"Version": "2012-10-17"}`))
+}
+
+func Test_RepairDrift(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+	ctx := context.TODO()
+	mockLattice := mocks.NewMockLattice(c)
+	cloud := pkg_aws.NewDefaultCloud(mockLattice, TestCloudConfig)
+	sn := model.ServiceNetworkStatus{
+		ServiceNetworkARN: "arn:aws:vpc-lattice:us-west-2:account-id:servicenetwork/sn-123",
+		ServiceNetworkID:  "sn-123",
+	}
+
+	mockLattice.EXPECT().ListTagsForResource(gomock.Any(), gomock.Any()).
+		Return(&vpclattice.ListTagsForResourceOutput{Tags: map[string]string{"team": "a"}}, nil)
+	mockLattice.EXPECT().TagResource(gomock.Any(), &vpclattice.TagResourceInput{
+		ResourceArn: aws.String(sn.ServiceNetworkARN),
+		Tags:        cloud.DefaultTags(),
+	}).Return(&vpclattice.TagResourceOutput{}, nil)
+	mockLattice.EXPECT().GetServiceNetwork(gomock.Any(), gomock.Any()).
+		Return(&vpclattice.GetServiceNetworkOutput{AuthType: types.AuthTypeNone}, nil)
+	mockLattice.EXPECT().UpdateServiceNetwork(gomock.Any(), &vpclattice.UpdateServiceNetworkInput{
+		ServiceNetworkIdentifier: aws.String("sn-123"),
+		AuthType:                 types.AuthTypeAwsIam,
+	}).Return(&vpclattice.UpdateServiceNetworkOutput{}, nil)
+
+	snMgr := NewDefaultServiceNetworkManager(gwlog.FallbackLogger, cloud)
+	repaired, err := snMgr.RepairDrift(ctx, sn, "AWS_IAM")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{ServiceNetworkDriftTags, ServiceNetworkDriftAuthType}, repaired)
+
+	// nothing to repair
+	mockLattice.EXPECT().ListTagsForResource(gomock.Any(), gomock.Any()).
+		Return(&vpclattice.ListTagsForResourceOutput{Tags: cloud.DefaultTags()}, nil)
+	mockLattice.EXPECT().GetServiceNetwork(gomock.Any(), gomock.Any()).
+		Return(&vpclattice.GetServiceNetworkOutput{AuthType: types.AuthTypeAwsIam}, nil)
+
+	repaired, err = snMgr.RepairDrift(ctx, sn, "AWS_IAM")
+	assert.Nil(t, err)
+	assert.Empty(t, repaired)
+
+	// the auth type is left as it is
+	mockLattice.EXPECT().ListTagsForResource(gomock.Any(), gomock.Any()).
+		Return(&vpclattice.ListTagsForResourceOutput{Tags: cloud.DefaultTags()}, nil)
+
+	repaired, err = snMgr.RepairDrift(ctx, sn, "")
+	assert.Nil(t, err)
+	assert.Empty(t, repaired)
+}
+
+func Test_DeleteProvisioned(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+	ctx := context.TODO()
+	mockLattice := mocks.NewMockLattice(c)
+	mockRAM := mocks.NewMockRAM(c)
+	cloud := pkg_aws.NewDefaultCloudWithRAM(mockLattice, mockRAM, TestCloudConfig)
+	snArn := "arn:aws:vpc-lattice:us-west-2:account-id:servicenetwork/sn-123"
+	snvaArn := "arn:aws:vpc-lattice:us-west-2:account-id:servicenetworkvpcassociation/snva-123"
+	gatewayTags := mocks.Tags{model.K8SGatewayNameKey: "test-sn", model.K8SGatewayNamespaceKey: "default"}
+	snTags := cloud.DefaultTagsMergedWith(gatewayTags)
+	snvaTags := cloud.DefaultTagsMergedWith(mocks.Tags{model.ServiceNetworkTagKey: "test-sn"})
+
+	mockLattice.EXPECT().FindServiceNetwork(gomock.Any(), "test-sn").
+		Return(&mocks.ServiceNetworkInfo{
+			SvcNetwork: types.ServiceNetworkSummary{Arn: aws.String(snArn), Id: aws.String("sn-123")},
+		}, nil).AnyTimes()
+	mockLattice.EXPECT().ListTagsForResource(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, input *vpclattice.ListTagsForResourceInput, _ ...func(*vpclattice.Options)) (*vpclattice.ListTagsForResourceOutput, error) {
+			if aws.ToString(input.ResourceArn) == snvaArn {
+				return &vpclattice.ListTagsForResourceOutput{Tags: snvaTags}, nil
+			}
+			return &vpclattice.ListTagsForResourceOutput{Tags: snTags}, nil
+		}).AnyTimes()
+	snMgr := NewDefaultServiceNetworkManager(gwlog.FallbackLogger, cloud)
+
+	// services are still associated
+	mockLattice.EXPECT().ListServiceNetworkServiceAssociationsAsList(gomock.Any(), gomock.Any()).
+		Return([]types.ServiceNetworkServiceAssociationSummary{{Id: aws.String("snsa-123")}}, nil)
+	inUse, err := snMgr.DeleteProvisioned(ctx, "test-sn", gatewayTags)
+	assert.Nil(t, err)
+	assert.True(t, inUse)
+
+	// the association with the cluster VPC is deleted first
+	mockLattice.EXPECT().ListServiceNetworkServiceAssociationsAsList(gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+	mockLattice.EXPECT().ListServiceNetworkVpcAssociationsAsList(gomock.Any(), gomock.Any()).
+		Return([]types.ServiceNetworkVpcAssociationSummary{{
+			Arn:    aws.String(snvaArn),
+			Id:     aws.String("snva-123"),
+			VpcId:  aws.String("vpc-id"),
+			Status: types.ServiceNetworkVpcAssociationStatusActive,
+		}}, nil)
+	mockLattice.EXPECT().DeleteServiceNetworkVpcAssociation(gomock.Any(), &vpclattice.DeleteServiceNetworkVpcAssociationInput{
+		ServiceNetworkVpcAssociationIdentifier: aws.String("snva-123"),
+	}).Return(&vpclattice.DeleteServiceNetworkVpcAssociationOutput{}, nil)
+	mockLattice.EXPECT().ListServiceNetworkVpcAssociationsAsList(gomock.Any(), gomock.Any()).
+		Return([]types.ServiceNetworkVpcAssociationSummary{{
+			Arn:    aws.String(snvaArn),
+			Id:     aws.String("snva-123"),
+			VpcId:  aws.String("vpc-id"),
+			Status: types.ServiceNetworkVpcAssociationStatusDeleteInProgress,
+		}}, nil)
+	inUse, err = snMgr.DeleteProvisioned(ctx, "test-sn", gatewayTags)
+	requeue := &lattice_runtime.RequeueNeededAfter{}
+	assert.ErrorAs(t, err, &requeue)
+	assert.False(t, inUse)
+
+	// then the service network
+	mockLattice.EXPECT().ListServiceNetworkVpcAssociationsAsList(gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+	mockLattice.EXPECT().DeleteServiceNetwork(gomock.Any(), &vpclattice.DeleteServiceNetworkInput{
+		ServiceNetworkIdentifier: aws.String("sn-123"),
+	}).Return(&vpclattice.DeleteServiceNetworkOutput{}, nil)
+	inUse, err = snMgr.DeleteProvisioned(ctx, "test-sn", gatewayTags)
+	assert.Nil(t, err)
+	assert.False(t, inUse)
+
+	// service networks not provisioned for the Gateway are left alone
+	snTags = cloud.DefaultTags()
+	inUse, err = snMgr.DeleteProvisioned(ctx, "test-sn", gatewayTags)
+	assert.Nil(t, err)
+	assert.False(t, inUse)
+}
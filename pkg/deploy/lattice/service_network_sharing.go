@@ -0,0 +1,219 @@
+package lattice
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ram"
+	ramtypes "github.com/aws/aws-sdk-go-v2/service/ram/types"
+
+	"github.com/aws/aws-application-networking-k8s/pkg/aws/services"
+	model "github.com/aws/aws-application-networking-k8s/pkg/model/lattice"
+	lattice_runtime "github.com/aws/aws-application-networking-k8s/pkg/runtime"
+)
+
+const (
+	ResourceShareInvitationPending  = "Pending"
+	ResourceShareInvitationAccepted = "Accepted"
+
+	// external principals can take a while to accept the resource share invitation
+	resourceShareRequeueInterval = time.Minute
+)
+
+// upsertSharing shares the service network with the configured principals through a resource share named after it,
+// and deletes the resource share when sharing is not configured
+func (m *defaultServiceNetworkManager) upsertSharing(ctx context.Context, snArn string, cfg *model.ServiceNetworkConfig) (*model.ServiceNetworkSharingStatus, error) {
+	share, err := m.findResourceShare(ctx, cfg.Name)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Sharing == nil {
+		if share != nil {
+			return nil, m.deleteResourceShare(ctx, cfg.Name, share)
+		}
+		return nil, nil
+	}
+
+	if share == nil {
+		m.log.Infof(ctx, "Creating resource share of ServiceNetwork %s", cfg.Name)
+		resp, err := m.cloud.RAM().CreateResourceShare(ctx, &ram.CreateResourceShareInput{
+			Name:                    aws.String(cfg.Name),
+			ResourceArns:            []string{snArn},
+			Principals:              cfg.Sharing.Principals,
+			AllowExternalPrincipals: aws.Bool(cfg.Sharing.AllowExternalPrincipals),
+			Tags:                    toRAMTags(m.configTags(cfg)),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return sharingStatus(resp.ResourceShare, cfg.Sharing.Principals, nil)
+	}
+
+	if aws.ToBool(share.AllowExternalPrincipals) != cfg.Sharing.AllowExternalPrincipals {
+		m.log.Infof(ctx, "Updating resource share of ServiceNetwork %s to allow external principals: %t",
+			cfg.Name, cfg.Sharing.AllowExternalPrincipals)
+		resp, err := m.cloud.RAM().UpdateResourceShare(ctx, &ram.UpdateResourceShareInput{
+			ResourceShareArn:        share.ResourceShareArn,
+			AllowExternalPrincipals: aws.Bool(cfg.Sharing.AllowExternalPrincipals),
+		})
+		if err != nil {
+			return nil, err
+		}
+		share = resp.ResourceShare
+	}
+
+	associations, err := m.cloud.RAM().GetResourceShareAssociationsAsList(ctx, &ram.GetResourceShareAssociationsInput{
+		AssociationType:   ramtypes.ResourceShareAssociationTypePrincipal,
+		ResourceShareArns: []string{aws.ToString(share.ResourceShareArn)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	resourceAssociations, err := m.cloud.RAM().GetResourceShareAssociationsAsList(ctx, &ram.GetResourceShareAssociationsInput{
+		AssociationType:   ramtypes.ResourceShareAssociationTypeResource,
+		ResourceShareArns: []string{aws.ToString(share.ResourceShareArn)},
+		ResourceArn:       aws.String(snArn),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	principalAssociations := make(map[string]ramtypes.ResourceShareAssociation)
+	var removedPrincipals []string
+	for _, association := range associations {
+		if !isActiveResourceShareAssociation(association) {
+			continue
+		}
+		principal := aws.ToString(association.AssociatedEntity)
+		if slices.Contains(cfg.Sharing.Principals, principal) {
+			principalAssociations[principal] = association
+		} else {
+			removedPrincipals = append(removedPrincipals, principal)
+		}
+	}
+	var addedPrincipals []string
+	for _, principal := range cfg.Sharing.Principals {
+		if _, ok := principalAssociations[principal]; !ok {
+			addedPrincipals = append(addedPrincipals, principal)
+		}
+	}
+	var addedResources []string
+	if !slices.ContainsFunc(resourceAssociations, isActiveResourceShareAssociation) {
+		addedResources = []string{snArn}
+	}
+
+	if len(addedPrincipals) > 0 || len(addedResources) > 0 {
+		m.log.Infof(ctx, "Associating resource share of ServiceNetwork %s with principals %v and resources %v",
+			cfg.Name, addedPrincipals, addedResources)
+		_, err = m.cloud.RAM().AssociateResourceShare(ctx, &ram.AssociateResourceShareInput{
+			ResourceShareArn: share.ResourceShareArn,
+			Principals:       addedPrincipals,
+			ResourceArns:     addedResources,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(removedPrincipals) > 0 {
+		m.log.Infof(ctx, "Disassociating resource share of ServiceNetwork %s from principals %v", cfg.Name, removedPrincipals)
+		_, err = m.cloud.RAM().DisassociateResourceShare(ctx, &ram.DisassociateResourceShareInput{
+			ResourceShareArn: share.ResourceShareArn,
+			Principals:       removedPrincipals,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sharingStatus(share, cfg.Sharing.Principals, principalAssociations)
+}
+
+// sharingStatus reports the association of each principal, principals without association are being associated.
+// It returns a requeue error until all principals are associated
+func sharingStatus(share *ramtypes.ResourceShare, principals []string,
+	associations map[string]ramtypes.ResourceShareAssociation) (*model.ServiceNetworkSharingStatus, error) {
+	status := &model.ServiceNetworkSharingStatus{
+		ResourceShareArn: aws.ToString(share.ResourceShareArn),
+		Status:           string(share.Status),
+	}
+	inProgress := false
+	for _, principal := range principals {
+		principalStatus := model.ServiceNetworkSharingPrincipalStatus{
+			Principal:         principal,
+			AssociationStatus: string(ramtypes.ResourceShareAssociationStatusAssociating),
+		}
+		if association, ok := associations[principal]; ok {
+			principalStatus.AssociationStatus = string(association.Status)
+			principalStatus.Message = aws.ToString(association.StatusMessage)
+			if aws.ToBool(association.External) {
+				principalStatus.InvitationStatus = ResourceShareInvitationPending
+				if association.Status == ramtypes.ResourceShareAssociationStatusAssociated {
+					principalStatus.InvitationStatus = ResourceShareInvitationAccepted
+				}
+			}
+		}
+		inProgress = inProgress || principalStatus.AssociationStatus == string(ramtypes.ResourceShareAssociationStatusAssociating)
+		status.Principals = append(status.Principals, principalStatus)
+	}
+	if inProgress {
+		return status, lattice_runtime.NewRequeueNeededAfter(
+			fmt.Sprintf("resource share %s associations in progress", status.ResourceShareArn), resourceShareRequeueInterval)
+	}
+	return status, nil
+}
+
+func isActiveResourceShareAssociation(association ramtypes.ResourceShareAssociation) bool {
+	switch association.Status {
+	case ramtypes.ResourceShareAssociationStatusDisassociating, ramtypes.ResourceShareAssociationStatusDisassociated:
+		return false
+	default:
+		return true
+	}
+}
+
+// findResourceShare finds the active resource share created by this controller for the named ServiceNetwork resource
+func (m *defaultServiceNetworkManager) findResourceShare(ctx context.Context, snName string) (*ramtypes.ResourceShare, error) {
+	shares, err := m.cloud.RAM().GetResourceSharesAsList(ctx, &ram.GetResourceSharesInput{
+		Name:                aws.String(snName),
+		ResourceOwner:       ramtypes.ResourceOwnerSelf,
+		ResourceShareStatus: ramtypes.ResourceShareStatusActive,
+	})
+	if err != nil {
+		return nil, err
+	}
+	managedBy := m.cloud.GetManagedByFromTags(m.cloud.DefaultTags())
+	for i := range shares {
+		tags := fromRAMTags(shares[i].Tags)
+		if tags[model.ServiceNetworkTagKey] == snName && m.cloud.GetManagedByFromTags(tags) == managedBy {
+			return &shares[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *defaultServiceNetworkManager) deleteResourceShare(ctx context.Context, snName string, share *ramtypes.ResourceShare) error {
+	m.log.Infof(ctx, "Deleting resource share %s of ServiceNetwork %s", aws.ToString(share.ResourceShareArn), snName)
+	_, err := m.cloud.RAM().DeleteResourceShare(ctx, &ram.DeleteResourceShareInput{
+		ResourceShareArn: share.ResourceShareArn,
+	})
+	return err
+}
+
+func toRAMTags(tags services.Tags) []ramtypes.Tag {
+	var ramTags []ramtypes.Tag
+	for key, value := range tags {
+		ramTags = append(ramTags, ramtypes.Tag{Key: aws.String(key), Value: aws.String(value)})
+	}
+	return ramTags
+}
+
+func fromRAMTags(ramTags []ramtypes.Tag) services.Tags {
+	tags := make(services.Tags, len(ramTags))
+	for _, tag := range ramTags {
+		tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	return tags
+}
@@ -0,0 +1,163 @@
+package lattice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ram"
+	ramtypes "github.com/aws/aws-sdk-go-v2/service/ram/types"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	pkg_aws "github.com/aws/aws-application-networking-k8s/pkg/aws"
+	mocks "github.com/aws/aws-application-networking-k8s/pkg/aws/services"
+	model "github.com/aws/aws-application-networking-k8s/pkg/model/lattice"
+	lattice_runtime "github.com/aws/aws-application-networking-k8s/pkg/runtime"
+	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
+)
+
+const (
+	testSharingSnArn = "arn:aws:vpc-lattice:us-west-2:123456789012:servicenetwork/sn-123"
+	testShareArn     = "arn:aws:ram:us-west-2:123456789012:resource-share/share-123"
+	testOuArn        = "arn:aws:organizations::123456789012:ou/o-abc/ou-abc-def"
+)
+
+func Test_UpsertSharing_CreatesResourceShare(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+	ctx := context.TODO()
+	mockLattice := mocks.NewMockLattice(c)
+	mockRAM := mocks.NewMockRAM(c)
+	cloud := pkg_aws.NewDefaultCloudWithRAM(mockLattice, mockRAM, TestCloudConfig)
+
+	// a share of the same name created by someone else is left alone
+	mockRAM.EXPECT().GetResourceSharesAsList(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, input *ram.GetResourceSharesInput) ([]ramtypes.ResourceShare, error) {
+			assert.Equal(t, "test-sn", aws.ToString(input.Name))
+			assert.Equal(t, ramtypes.ResourceOwnerSelf, input.ResourceOwner)
+			return []ramtypes.ResourceShare{{
+				ResourceShareArn: aws.String("arn:aws:ram:us-west-2:123456789012:resource-share/other"),
+				Tags:             []ramtypes.Tag{{Key: aws.String(model.ServiceNetworkTagKey), Value: aws.String("test-sn")}},
+			}}, nil
+		})
+	mockRAM.EXPECT().CreateResourceShare(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, input *ram.CreateResourceShareInput, _ ...func(*ram.Options)) (*ram.CreateResourceShareOutput, error) {
+			assert.Equal(t, "test-sn", aws.ToString(input.Name))
+			assert.Equal(t, []string{testSharingSnArn}, input.ResourceArns)
+			assert.Equal(t, []string{"111122223333", testOuArn}, input.Principals)
+			assert.False(t, aws.ToBool(input.AllowExternalPrincipals))
+			tags := fromRAMTags(input.Tags)
+			assert.Equal(t, "test-sn", tags[model.ServiceNetworkTagKey])
+			assert.Equal(t, "account-id/cluster/vpc-id", tags[pkg_aws.TagManagedBy])
+			return &ram.CreateResourceShareOutput{ResourceShare: &ramtypes.ResourceShare{
+				ResourceShareArn: aws.String(testShareArn),
+				Status:           ramtypes.ResourceShareStatusActive,
+			}}, nil
+		})
+
+	snMgr := NewDefaultServiceNetworkManager(gwlog.FallbackLogger, cloud)
+	status, err := snMgr.upsertSharing(ctx, testSharingSnArn, &model.ServiceNetworkConfig{
+		Name:    "test-sn",
+		Sharing: &model.ServiceNetworkSharing{Principals: []string{"111122223333", testOuArn}},
+	})
+
+	requeue := &lattice_runtime.RequeueNeededAfter{}
+	assert.ErrorAs(t, err, &requeue)
+	assert.Equal(t, &model.ServiceNetworkSharingStatus{
+		ResourceShareArn: testShareArn,
+		Status:           "ACTIVE",
+		Principals: []model.ServiceNetworkSharingPrincipalStatus{
+			{Principal: "111122223333", AssociationStatus: "ASSOCIATING"},
+			{Principal: testOuArn, AssociationStatus: "ASSOCIATING"},
+		},
+	}, status)
+}
+
+func Test_UpsertSharing_RevertsDrift(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+	ctx := context.TODO()
+	mockLattice := mocks.NewMockLattice(c)
+	mockRAM := mocks.NewMockRAM(c)
+	cloud := pkg_aws.NewDefaultCloudWithRAM(mockLattice, mockRAM, TestCloudConfig)
+	share := ramtypes.ResourceShare{
+		ResourceShareArn:        aws.String(testShareArn),
+		Status:                  ramtypes.ResourceShareStatusActive,
+		AllowExternalPrincipals: aws.Bool(false),
+		Tags:                    toRAMTags(cloud.DefaultTagsMergedWith(mocks.Tags{model.ServiceNetworkTagKey: "test-sn"})),
+	}
+
+	mockRAM.EXPECT().GetResourceSharesAsList(gomock.Any(), gomock.Any()).Return([]ramtypes.ResourceShare{share}, nil)
+	mockRAM.EXPECT().UpdateResourceShare(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, input *ram.UpdateResourceShareInput, _ ...func(*ram.Options)) (*ram.UpdateResourceShareOutput, error) {
+			assert.True(t, aws.ToBool(input.AllowExternalPrincipals))
+			updated := share
+			updated.AllowExternalPrincipals = aws.Bool(true)
+			return &ram.UpdateResourceShareOutput{ResourceShare: &updated}, nil
+		})
+	mockRAM.EXPECT().GetResourceShareAssociationsAsList(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, input *ram.GetResourceShareAssociationsInput) ([]ramtypes.ResourceShareAssociation, error) {
+			if input.AssociationType == ramtypes.ResourceShareAssociationTypeResource {
+				// the service network was removed from the share
+				return []ramtypes.ResourceShareAssociation{
+					{AssociatedEntity: aws.String(testSharingSnArn), Status: ramtypes.ResourceShareAssociationStatusDisassociated},
+				}, nil
+			}
+			return []ramtypes.ResourceShareAssociation{
+				{AssociatedEntity: aws.String("111122223333"), Status: ramtypes.ResourceShareAssociationStatusAssociated, External: aws.Bool(true)},
+				{AssociatedEntity: aws.String("444455556666"), Status: ramtypes.ResourceShareAssociationStatusAssociated},
+				{AssociatedEntity: aws.String("777788889999"), Status: ramtypes.ResourceShareAssociationStatusDisassociated},
+			}, nil
+		}).Times(2)
+	mockRAM.EXPECT().AssociateResourceShare(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, input *ram.AssociateResourceShareInput, _ ...func(*ram.Options)) (*ram.AssociateResourceShareOutput, error) {
+			assert.Equal(t, []string{testOuArn}, input.Principals)
+			assert.Equal(t, []string{testSharingSnArn}, input.ResourceArns)
+			return &ram.AssociateResourceShareOutput{}, nil
+		})
+	mockRAM.EXPECT().DisassociateResourceShare(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, input *ram.DisassociateResourceShareInput, _ ...func(*ram.Options)) (*ram.DisassociateResourceShareOutput, error) {
+			assert.Equal(t, []string{"444455556666"}, input.Principals)
+			return &ram.DisassociateResourceShareOutput{}, nil
+		})
+
+	snMgr := NewDefaultServiceNetworkManager(gwlog.FallbackLogger, cloud)
+	status, err := snMgr.upsertSharing(ctx, testSharingSnArn, &model.ServiceNetworkConfig{
+		Name: "test-sn",
+		Sharing: &model.ServiceNetworkSharing{
+			Principals:              []string{"111122223333", testOuArn},
+			AllowExternalPrincipals: true,
+		},
+	})
+
+	requeue := &lattice_runtime.RequeueNeededAfter{}
+	assert.ErrorAs(t, err, &requeue)
+	assert.Equal(t, []model.ServiceNetworkSharingPrincipalStatus{
+		{Principal: "111122223333", AssociationStatus: "ASSOCIATED", InvitationStatus: ResourceShareInvitationAccepted},
+		{Principal: testOuArn, AssociationStatus: "ASSOCIATING"},
+	}, status.Principals)
+}
+
+func Test_UpsertSharing_DeletesUnsetSharing(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+	ctx := context.TODO()
+	mockLattice := mocks.NewMockLattice(c)
+	mockRAM := mocks.NewMockRAM(c)
+	cloud := pkg_aws.NewDefaultCloudWithRAM(mockLattice, mockRAM, TestCloudConfig)
+
+	mockRAM.EXPECT().GetResourceSharesAsList(gomock.Any(), gomock.Any()).Return([]ramtypes.ResourceShare{{
+		ResourceShareArn: aws.String(testShareArn),
+		Tags:             toRAMTags(cloud.DefaultTagsMergedWith(mocks.Tags{model.ServiceNetworkTagKey: "test-sn"})),
+	}}, nil)
+	mockRAM.EXPECT().DeleteResourceShare(gomock.Any(), &ram.DeleteResourceShareInput{
+		ResourceShareArn: aws.String(testShareArn),
+	}).Return(&ram.DeleteResourceShareOutput{}, nil)
+
+	snMgr := NewDefaultServiceNetworkManager(gwlog.FallbackLogger, cloud)
+	status, err := snMgr.upsertSharing(ctx, testSharingSnArn, &model.ServiceNetworkConfig{Name: "test-sn", SharingManaged: true})
+
+	assert.Nil(t, err)
+	assert.Nil(t, status)
+}
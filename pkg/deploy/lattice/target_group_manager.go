@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
@@ -47,10 +48,52 @@ func NewTargetGroupManager(log gwlog.Logger, awsCloud pkg_aws.Cloud, k8sClient c
 	}
 }
 
+// sharedTargetGroupLocks serializes the find-or-create of each shared target group. The routes sharing it are
+// reconciled concurrently, and would otherwise each create a target group. A lock is removed once no route holds or
+// waits for it
+var sharedTargetGroupLocks = struct {
+	sync.Mutex
+	locks map[string]*sharedTargetGroupLock
+}{locks: make(map[string]*sharedTargetGroupLock)}
+
+type sharedTargetGroupLock struct {
+	sync.Mutex
+	users int
+}
+
+func lockSharedTargetGroup(spec model.TargetGroupSpec) func() {
+	key := fmt.Sprintf("%s/%s/%s/%s/%s", spec.VpcId, spec.K8SSourceType, spec.K8SServiceNamespace,
+		spec.K8SServiceName, spec.K8SSharedKey)
+
+	sharedTargetGroupLocks.Lock()
+	lock, ok := sharedTargetGroupLocks.locks[key]
+	if !ok {
+		lock = &sharedTargetGroupLock{}
+		sharedTargetGroupLocks.locks[key] = lock
+	}
+	lock.users++
+	sharedTargetGroupLocks.Unlock()
+
+	lock.Lock()
+	return func() {
+		lock.Unlock()
+		sharedTargetGroupLocks.Lock()
+		lock.users--
+		if lock.users == 0 {
+			delete(sharedTargetGroupLocks.locks, key)
+		}
+		sharedTargetGroupLocks.Unlock()
+	}
+}
+
 func (s *defaultTargetGroupManager) Upsert(
 	ctx context.Context,
 	modelTg *model.TargetGroup,
 ) (model.TargetGroupStatus, error) {
+	if modelTg.Spec.IsShared() {
+		defer lockSharedTargetGroup(modelTg.Spec)()
+	}
+
 	// check if exists
 	latticeTgSummary, err := s.findTargetGroup(ctx, modelTg)
 	if err != nil {
@@ -83,6 +126,17 @@ func (s *defaultTargetGroupManager) create(ctx context.Context, modelTg *model.T
 		IpAddressType:   types.IpAddressType(aws.ToString(ipAddressType)),
 		HealthCheck:     modelTg.Spec.HealthCheckConfig,
 	}
+	switch modelTg.Spec.Type {
+	case model.TargetGroupTypeLambda:
+		// lambda target groups only take the event structure version
+		latticeTgCfg = &types.TargetGroupConfig{
+			LambdaEventStructureVersion: types.LambdaEventStructureVersion(modelTg.Spec.LambdaEventStructureVersion),
+		}
+	case model.TargetGroupTypeAlb:
+		// alb target groups take neither ip address type nor health check
+		latticeTgCfg.IpAddressType = ""
+		latticeTgCfg.HealthCheck = nil
+	}
 
 	latticeTgName := model.GenerateTgName(modelTg.Spec)
 	createInput := vpclattice.CreateTargetGroupInput{
@@ -97,7 +151,9 @@ func (s *defaultTargetGroupManager) create(ctx context.Context, modelTg *model.T
 	createInput.Tags[model.K8SSourceTypeKey] = string(modelTg.Spec.K8SSourceType)
 	createInput.Tags[model.K8SProtocolVersionKey] = modelTg.Spec.ProtocolVersion
 
-	if modelTg.Spec.IsSourceTypeRoute() {
+	if modelTg.Spec.IsShared() {
+		createInput.Tags[model.K8SSharedKeyKey] = modelTg.Spec.K8SSharedKey
+	} else if modelTg.Spec.IsSourceTypeRoute() {
 		createInput.Tags[model.K8SRouteNameKey] = modelTg.Spec.K8SRouteName
 		createInput.Tags[model.K8SRouteNamespaceKey] = modelTg.Spec.K8SRouteNamespace
 	}
@@ -136,6 +192,26 @@ func (s *defaultTargetGroupManager) update(ctx context.Context, targetGroup *mod
 		return model.TargetGroupStatus{}, fmt.Errorf("failed to update tags for target group %s: %w", aws.ToString(latticeTg.Id), err)
 	}
 
+	if targetGroup.Spec.Type == model.TargetGroupTypeLambda || targetGroup.Spec.Type == model.TargetGroupTypeAlb {
+		// lambda and alb target groups do not support health checks
+		return model.TargetGroupStatus{
+			Name: aws.ToString(latticeTg.Name),
+			Arn:  aws.ToString(latticeTg.Arn),
+			Id:   aws.ToString(latticeTg.Id),
+		}, nil
+	}
+
+	if targetGroup.Spec.ReadinessProbe != nil && targetGroup.Spec.ReadinessProbe.Conflict {
+		// the pods are rolling out a new readiness probe, keep the health check they last agreed on
+		s.log.Debugf(ctx, "Keeping health check of target group %s until its pods agree on their readiness probe",
+			aws.ToString(latticeTg.Id))
+		return model.TargetGroupStatus{
+			Name: aws.ToString(latticeTg.Name),
+			Arn:  aws.ToString(latticeTg.Arn),
+			Id:   aws.ToString(latticeTg.Id),
+		}, nil
+	}
+
 	if healthCheckConfig == nil {
 		s.log.Debugf(ctx, "HealthCheck is empty. Resetting to default settings")
 		healthCheckConfig = &types.HealthCheckConfig{}
@@ -206,6 +282,7 @@ func (s *defaultTargetGroupManager) Delete(ctx context.Context, modelTg *model.T
 	if err != nil {
 		if services.IsLatticeAPINotFoundErr(err) {
 			s.log.Debugf(ctx, "Target group %s was already deleted", modelTg.Status.Id)
+			s.removeFromPolicyStatus(ctx, modelTg)
 			return nil
 		}
 		return fmt.Errorf("failed ListTargets %s due to %s", modelTg.Status.Id, err)
@@ -260,6 +337,7 @@ func (s *defaultTargetGroupManager) Delete(ctx context.Context, modelTg *model.T
 	if err != nil {
 		if services.IsLatticeAPINotFoundErr(err) {
 			s.log.Infof(ctx, "Target group %s was already deleted", modelTg.Status.Id)
+			s.removeFromPolicyStatus(ctx, modelTg)
 			return nil
 		} else {
 			return fmt.Errorf("failed DeleteTargetGroup %s due to %s", modelTg.Status.Id, err)
@@ -267,9 +345,20 @@ func (s *defaultTargetGroupManager) Delete(ctx context.Context, modelTg *model.T
 	}
 
 	s.log.Infof(ctx, "Success DeleteTargetGroup %s", modelTg.Status.Id)
+	s.removeFromPolicyStatus(ctx, modelTg)
 	return nil
 }
 
+// removeFromPolicyStatus removes a deleted target group from the status of the policies listing it
+func (s *defaultTargetGroupManager) removeFromPolicyStatus(ctx context.Context, modelTg *model.TargetGroup) {
+	if s.k8sClient == nil || modelTg.Status.Arn == "" {
+		return
+	}
+	if err := SyncTargetGroupPolicyStatus(ctx, s.k8sClient, modelTg.Status.Arn, nil); err != nil {
+		s.log.Infof(ctx, "Failed to remove target group %s from TargetGroupPolicy status due to %s", modelTg.Status.Id, err)
+	}
+}
+
 type tgListOutput struct {
 	tgSummary types.TargetGroupSummary
 	tags      services.Tags
@@ -342,6 +431,8 @@ func (s *defaultTargetGroupManager) findTargetGroup(
 			IpAddressType: latticeTg.Config.IpAddressType,
 			Type:          latticeTg.Type,
 			VpcIdentifier: latticeTg.Config.VpcIdentifier,
+
+			LambdaEventStructureVersion: latticeTg.Config.LambdaEventStructureVersion,
 		}, nil) // we already know that tags match
 		if err != nil {
 			return nil, err
@@ -368,7 +459,8 @@ func (s *defaultTargetGroupManager) IsTargetGroupMatch(ctx context.Context,
 		string(latticeTg.Protocol) != modelTg.Spec.Protocol ||
 		string(latticeTg.IpAddressType) != modelTg.Spec.IpAddressType ||
 		string(latticeTg.Type) != string(modelTg.Spec.Type) ||
-		aws.ToString(latticeTg.VpcIdentifier) != modelTg.Spec.VpcId {
+		aws.ToString(latticeTg.VpcIdentifier) != modelTg.Spec.VpcId ||
+		string(latticeTg.LambdaEventStructureVersion) != modelTg.Spec.LambdaEventStructureVersion {
 
 		return false, nil
 	}
@@ -5,7 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
@@ -134,6 +137,75 @@ func Test_CreateTargetGroup_TGNotExist_Active(t *testing.T) {
 	}
 }
 
+func Test_CreateTargetGroup_SharedTG_ConcurrentUpsertsCreateOnce(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+	ctx := context.TODO()
+
+	mockLattice := mocks.NewMockLattice(c)
+	mockTagging := mocks.NewMockTagging(c)
+	cloud := pkg_aws.NewDefaultCloudWithTagging(mockLattice, mockTagging, TestCloudConfig)
+
+	tgSpec := model.TargetGroupSpec{
+		Port:            80,
+		Protocol:        string(types.TargetGroupProtocolHttp),
+		ProtocolVersion: string(types.TargetGroupProtocolVersionHttp1),
+		Type:            model.TargetGroupTypeIP,
+	}
+	tgSpec.K8SSourceType = model.SourceTypeHTTPRoute
+	tgSpec.K8SServiceName = "svc"
+	tgSpec.K8SServiceNamespace = "default"
+	tgSpec.K8SSharedKey = "80-abcdef12"
+
+	// the second upsert only looks the target group up once the first one created it
+	var created atomic.Bool
+	mockTagging.EXPECT().FindResourcesByTags(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, resourceType mocks.ResourceType, tags mocks.Tags) ([]string, error) {
+			if created.Load() {
+				return []string{"tg-arn"}, nil
+			}
+			return nil, nil
+		}).Times(2)
+	mockLattice.EXPECT().CreateTargetGroup(ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, input *vpclattice.CreateTargetGroupInput, arg3 ...interface{}) (*vpclattice.CreateTargetGroupOutput, error) {
+			assert.Equal(t, tgSpec.K8SSharedKey, input.Tags[model.K8SSharedKeyKey])
+			time.Sleep(10 * time.Millisecond)
+			created.Store(true)
+			return &vpclattice.CreateTargetGroupOutput{
+				Arn:    aws.String("tg-arn"),
+				Id:     aws.String("tg-id"),
+				Status: types.TargetGroupStatusActive,
+			}, nil
+		}).Times(1)
+	mockLattice.EXPECT().GetTargetGroup(ctx, gomock.Any()).Return(&vpclattice.GetTargetGroupOutput{
+		Arn:    aws.String("tg-arn"),
+		Id:     aws.String("tg-id"),
+		Status: types.TargetGroupStatusActive,
+		Type:   types.TargetGroupTypeIp,
+		Config: &types.TargetGroupConfig{
+			Port:          aws.Int32(80),
+			Protocol:      types.TargetGroupProtocolHttp,
+			VpcIdentifier: aws.String(tgSpec.VpcId),
+		},
+	}, nil).AnyTimes()
+	mockTagging.EXPECT().UpdateTags(ctx, "tg-arn", gomock.Any(), nil).Return(nil).AnyTimes()
+	mockLattice.EXPECT().UpdateTargetGroup(ctx, gomock.Any()).Return(nil, nil).AnyTimes()
+
+	tgManager := NewTargetGroupManager(gwlog.FallbackLogger, cloud, nil)
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := tgManager.Upsert(ctx, &model.TargetGroup{Spec: tgSpec})
+			assert.Nil(t, err)
+			assert.Equal(t, "tg-id", resp.Id)
+		}()
+	}
+	wg.Wait()
+	assert.Empty(t, sharedTargetGroupLocks.locks)
+}
+
 // target group status is failed, and is active after creation
 func Test_CreateTargetGroup_TGFailed_Active(t *testing.T) {
 	c := gomock.NewController(t)
@@ -337,6 +409,178 @@ func Test_CreateTargetGroup_TGActive_HealthCheckSame(t *testing.T) {
 	assert.Equal(t, "id", resp.Id)
 }
 
+func Test_CreateTargetGroup_TGActive_ReadinessProbeConflictKeepsHealthCheck(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+	ctx := context.TODO()
+
+	mockLattice := mocks.NewMockLattice(c)
+	mockTagging := mocks.NewMockTagging(c)
+	cloud := pkg_aws.NewDefaultCloudWithTagging(mockLattice, mockTagging, TestCloudConfig)
+
+	tgSpec := model.TargetGroupSpec{
+		Port:            80,
+		Protocol:        string(types.TargetGroupProtocolHttp),
+		ProtocolVersion: string(types.TargetGroupProtocolVersionHttp1),
+		ReadinessProbe:  &model.ReadinessProbeHealthCheck{Port: 80, Conflict: true},
+	}
+	mockTagging.EXPECT().FindResourcesByTags(ctx, gomock.Any(), gomock.Any()).Return([]string{"tg-arn"}, nil)
+	mockLattice.EXPECT().GetTargetGroup(ctx, gomock.Any()).Return(&vpclattice.GetTargetGroupOutput{
+		Arn:    aws.String("tg-arn"),
+		Id:     aws.String("tg-id"),
+		Status: types.TargetGroupStatusActive,
+		Config: &types.TargetGroupConfig{
+			Port:        aws.Int32(80),
+			Protocol:    types.TargetGroupProtocolHttp,
+			HealthCheck: &types.HealthCheckConfig{Path: aws.String("/healthz")},
+		},
+	}, nil)
+	mockTagging.EXPECT().UpdateTags(ctx, "tg-arn", gomock.Any(), nil).Return(nil)
+	// the health check the pods last agreed on is kept while they disagree
+	mockLattice.EXPECT().UpdateTargetGroup(ctx, gomock.Any()).Times(0)
+
+	tgManager := NewTargetGroupManager(gwlog.FallbackLogger, cloud, nil)
+	resp, err := tgManager.Upsert(ctx, &model.TargetGroup{Spec: tgSpec})
+	assert.Nil(t, err)
+	assert.Equal(t, "tg-id", resp.Id)
+}
+
+func Test_CreateTargetGroup_Lambda(t *testing.T) {
+	ctx := context.TODO()
+	c := gomock.NewController(t)
+	defer c.Finish()
+
+	mockLattice := mocks.NewMockLattice(c)
+	mockTagging := mocks.NewMockTagging(c)
+	cloud := pkg_aws.NewDefaultCloudWithTagging(mockLattice, mockTagging, TestCloudConfig)
+
+	tgSpec := model.TargetGroupSpec{
+		Type:                        model.TargetGroupTypeLambda,
+		LambdaEventStructureVersion: string(types.LambdaEventStructureVersionV1),
+	}
+	tgSpec.K8SClusterName = "cluster-name"
+	tgSpec.K8SSourceType = model.SourceTypeHTTPRoute
+	tgSpec.K8SServiceName = "hello"
+	tgSpec.K8SServiceNamespace = "default"
+	tgSpec.K8SRouteName = "httproute1"
+	tgSpec.K8SRouteNamespace = "default"
+
+	mockTagging.EXPECT().FindResourcesByTags(ctx, gomock.Any(), gomock.Any()).Return(nil, nil)
+	mockLattice.EXPECT().CreateTargetGroup(ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, input *vpclattice.CreateTargetGroupInput, arg3 ...interface{}) (*vpclattice.CreateTargetGroupOutput, error) {
+			assert.Equal(t, types.TargetGroupTypeLambda, input.Type)
+			assert.Equal(t, &types.TargetGroupConfig{
+				LambdaEventStructureVersion: types.LambdaEventStructureVersionV1,
+			}, input.Config)
+			assert.Equal(t, "hello", input.Tags[model.K8SServiceNameKey])
+			assert.Equal(t, "httproute1", input.Tags[model.K8SRouteNameKey])
+
+			return &vpclattice.CreateTargetGroupOutput{
+				Arn:    aws.String("tg-arn-1"),
+				Id:     aws.String("tg-id-1"),
+				Name:   aws.String("tg-name-1"),
+				Status: types.TargetGroupStatusActive,
+			}, nil
+		},
+	)
+
+	tgManager := NewTargetGroupManager(gwlog.FallbackLogger, cloud, nil)
+	resp, err := tgManager.Upsert(ctx, &model.TargetGroup{Spec: tgSpec})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "tg-arn-1", resp.Arn)
+	assert.Equal(t, "tg-id-1", resp.Id)
+}
+
+func Test_CreateTargetGroup_Alb(t *testing.T) {
+	ctx := context.TODO()
+	c := gomock.NewController(t)
+	defer c.Finish()
+
+	mockLattice := mocks.NewMockLattice(c)
+	mockTagging := mocks.NewMockTagging(c)
+	cloud := pkg_aws.NewDefaultCloudWithTagging(mockLattice, mockTagging, TestCloudConfig)
+
+	tgSpec := model.TargetGroupSpec{
+		VpcId:           "vpc-id",
+		Type:            model.TargetGroupTypeAlb,
+		Port:            443,
+		Protocol:        string(types.TargetGroupProtocolHttps),
+		ProtocolVersion: string(types.TargetGroupProtocolVersionHttp2),
+	}
+	tgSpec.K8SClusterName = "cluster-name"
+	tgSpec.K8SSourceType = model.SourceTypeHTTPRoute
+	tgSpec.K8SServiceName = "legacy"
+	tgSpec.K8SServiceNamespace = "default"
+	tgSpec.K8SRouteName = "httproute1"
+	tgSpec.K8SRouteNamespace = "default"
+
+	mockTagging.EXPECT().FindResourcesByTags(ctx, gomock.Any(), gomock.Any()).Return(nil, nil)
+	mockLattice.EXPECT().CreateTargetGroup(ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, input *vpclattice.CreateTargetGroupInput, arg3 ...interface{}) (*vpclattice.CreateTargetGroupOutput, error) {
+			assert.Equal(t, types.TargetGroupTypeAlb, input.Type)
+			assert.Equal(t, &types.TargetGroupConfig{
+				Port:            aws.Int32(443),
+				Protocol:        types.TargetGroupProtocolHttps,
+				ProtocolVersion: types.TargetGroupProtocolVersionHttp2,
+				VpcIdentifier:   aws.String("vpc-id"),
+			}, input.Config)
+
+			return &vpclattice.CreateTargetGroupOutput{
+				Arn:    aws.String("tg-arn-1"),
+				Id:     aws.String("tg-id-1"),
+				Name:   aws.String("tg-name-1"),
+				Status: types.TargetGroupStatusActive,
+			}, nil
+		},
+	)
+
+	tgManager := NewTargetGroupManager(gwlog.FallbackLogger, cloud, nil)
+	resp, err := tgManager.Upsert(ctx, &model.TargetGroup{Spec: tgSpec})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "tg-arn-1", resp.Arn)
+}
+
+// lambda target groups do not support health checks, so an existing one is never updated
+func Test_UpdateTargetGroup_Lambda_SkipsHealthCheck(t *testing.T) {
+	ctx := context.TODO()
+	c := gomock.NewController(t)
+	defer c.Finish()
+
+	mockLattice := mocks.NewMockLattice(c)
+	mockTagging := mocks.NewMockTagging(c)
+	cloud := pkg_aws.NewDefaultCloudWithTagging(mockLattice, mockTagging, TestCloudConfig)
+
+	tgSpec := model.TargetGroupSpec{
+		Type:                        model.TargetGroupTypeLambda,
+		LambdaEventStructureVersion: string(types.LambdaEventStructureVersionV2),
+	}
+
+	tgOutput := vpclattice.GetTargetGroupOutput{
+		Arn:    aws.String("arn"),
+		Id:     aws.String("id"),
+		Name:   aws.String("lambda-tg"),
+		Status: types.TargetGroupStatusActive,
+		Type:   types.TargetGroupTypeLambda,
+		Config: &types.TargetGroupConfig{
+			LambdaEventStructureVersion: types.LambdaEventStructureVersionV2,
+		},
+	}
+
+	mockTagging.EXPECT().FindResourcesByTags(ctx, gomock.Any(), gomock.Any()).Return([]string{"arn"}, nil)
+	mockLattice.EXPECT().GetTargetGroup(ctx, gomock.Any()).Return(&tgOutput, nil)
+	mockTagging.EXPECT().UpdateTags(ctx, "arn", gomock.Any(), nil).Return(nil)
+	mockLattice.EXPECT().UpdateTargetGroup(ctx, gomock.Any()).Times(0)
+
+	tgManager := NewTargetGroupManager(gwlog.FallbackLogger, cloud, nil)
+	resp, err := tgManager.Upsert(ctx, &model.TargetGroup{Spec: tgSpec})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "arn", resp.Arn)
+	assert.Equal(t, "id", resp.Id)
+}
+
 // target group status is create-in-progress before creation, return Retry
 func Test_CreateTargetGroup_ExistingTG_Status_Retry(t *testing.T) {
 	c := gomock.NewController(t)
@@ -51,6 +51,13 @@ type TargetGroupSynthesizer struct {
 	stack              core.Stack
 	svcExportTgBuilder gateway.SvcExportTargetGroupModelBuilder
 	svcBuilder         gateway.LatticeServiceBuilder
+	fastPath           *TargetsFastPath
+}
+
+// WithTargetsFastPath stops the fast path from tracking the target groups this synthesizer deletes
+func (t *TargetGroupSynthesizer) WithTargetsFastPath(fastPath *TargetsFastPath) *TargetGroupSynthesizer {
+	t.fastPath = fastPath
+	return t
 }
 
 func (t *TargetGroupSynthesizer) Synthesize(ctx context.Context) error {
@@ -89,6 +96,9 @@ func (t *TargetGroupSynthesizer) SynthesizeCreate(ctx context.Context) error {
 		tgStatus, err := t.targetGroupManager.Upsert(ctx, resTargetGroup)
 		if err == nil {
 			resTargetGroup.Status = &tgStatus
+			if err := resolver.SyncPolicyStatus(ctx, resTargetGroup); err != nil {
+				t.log.Infof(ctx, "Failed to list target group %s in TargetGroupPolicy status due to %s", prefix, err)
+			}
 		} else {
 			t.log.Debugf(ctx, "Failed TargetGroupManager.Upsert %s due to %s", prefix, err)
 			if firstError == nil {
@@ -122,6 +132,8 @@ func (t *TargetGroupSynthesizer) SynthesizeDelete(ctx context.Context) error {
 		if err != nil {
 			prefix := model.TgNamePrefix(resTargetGroup.Spec)
 			retErr = errors.Join(retErr, fmt.Errorf("failed TargetGroupManager.Delete %s due to %w", prefix, err))
+		} else if resTargetGroup.Status != nil {
+			t.fastPath.Untrack(resTargetGroup.Status.Id)
 		}
 	}
 
@@ -161,6 +173,9 @@ func (t *TargetGroupSynthesizer) SynthesizeUnusedDelete(ctx context.Context) ([]
 		}
 
 		err := t.targetGroupManager.Delete(ctx, &modelTg)
+		if err == nil {
+			t.fastPath.Untrack(modelStatus.Id)
+		}
 		results[i] = DeleteUnusedResult{
 			Arn: modelTg.Status.Arn,
 			Err: err,
@@ -261,7 +276,8 @@ func (t *TargetGroupSynthesizer) shouldDeleteSvcExportTg(
 	if int64(modelTg.Spec.Port) != int64(aws.ToInt32(latticeTg.tgSummary.Port)) ||
 		modelTg.Spec.Protocol != string(latticeTg.tgSummary.Protocol) ||
 		modelTg.Spec.ProtocolVersion != tagFields.K8SProtocolVersion ||
-		modelTg.Spec.IpAddressType != string(latticeTg.tgSummary.IpAddressType) {
+		modelTg.Spec.IpAddressType != string(latticeTg.tgSummary.IpAddressType) ||
+		string(modelTg.Spec.Type) != string(latticeTg.tgSummary.Type) {
 
 		// one or more immutable fields differ from the source, so the TG is out of date
 		t.log.Infof(ctx, "Will delete TargetGroup %s (%s) - fields differ from source service/service export",
@@ -278,6 +294,10 @@ func (t *TargetGroupSynthesizer) shouldDeleteSvcExportTg(
 func (t *TargetGroupSynthesizer) shouldDeleteRouteTg(
 	ctx context.Context, latticeTg tgListOutput, tagFields model.TargetGroupTagFields) bool {
 
+	if tagFields.IsShared() {
+		return t.shouldDeleteSharedRouteTg(ctx, latticeTg, tagFields)
+	}
+
 	routeName := apitypes.NamespacedName{
 		Namespace: tagFields.K8SRouteNamespace,
 		Name:      tagFields.K8SRouteName,
@@ -285,7 +305,10 @@ func (t *TargetGroupSynthesizer) shouldDeleteRouteTg(
 
 	var err error
 	var route core.Route
-	if tagFields.K8SProtocolVersion == string(types.TargetGroupProtocolVersionGrpc) {
+	if latticeTg.tgSummary.Type == types.TargetGroupTypeLambda || latticeTg.tgSummary.Type == types.TargetGroupTypeAlb {
+		// LambdaFunction and ApplicationLoadBalancer backendRefs are only supported by HTTPRoute
+		route, err = core.GetHTTPRoute(ctx, t.client, routeName)
+	} else if tagFields.K8SProtocolVersion == string(types.TargetGroupProtocolVersionGrpc) {
 		route, err = core.GetGRPCRoute(ctx, t.client, routeName)
 	} else if string(latticeTg.tgSummary.Protocol) == string(types.TargetGroupProtocolTcp) {
 		route, err = core.GetTLSRoute(ctx, t.client, routeName)
@@ -353,6 +376,90 @@ func (t *TargetGroupSynthesizer) shouldDeleteRouteTg(
 	return false
 }
 
+// shouldDeleteSharedRouteTg counts the routes still referencing a shared target group, by rebuilding the routes
+// referencing its service, and deletes the target group once there are none
+func (t *TargetGroupSynthesizer) shouldDeleteSharedRouteTg(
+	ctx context.Context, latticeTg tgListOutput, tagFields model.TargetGroupTagFields) bool {
+
+	var routes []core.Route
+	var err error
+	switch tagFields.K8SSourceType {
+	case model.SourceTypeGRPCRoute:
+		routes, err = core.ListGRPCRoutes(ctx, t.client)
+	case model.SourceTypeTLSRoute:
+		routes, err = core.ListTLSRoutes(ctx, t.client)
+	default:
+		routes, err = core.ListHTTPRoutes(ctx, t.client)
+	}
+	if err != nil {
+		t.log.Infof(ctx, "Received unexpected API error listing routes %s", err)
+		return false
+	}
+
+	svcName := apitypes.NamespacedName{
+		Namespace: tagFields.K8SServiceNamespace,
+		Name:      tagFields.K8SServiceName,
+	}
+	references := 0
+	for _, route := range routes {
+		if !route.DeletionTimestamp().IsZero() || !routeReferencesService(route, svcName) {
+			continue
+		}
+
+		routeStack, err := t.svcBuilder.Build(ctx, route)
+		if err != nil {
+			// the route may still reference the target group, keep it until the route builds again
+			t.log.Infof(ctx, "Received error building route model %s", err)
+			return false
+		}
+
+		var resTargetGroups []*model.TargetGroup
+		if err := routeStack.ListResources(&resTargetGroups); err != nil {
+			t.log.Infof(ctx, "Error listing stack target groups %s", err)
+			return false
+		}
+		for _, modelTg := range resTargetGroups {
+			match, err := t.targetGroupManager.IsTargetGroupMatch(ctx, modelTg, &latticeTg.tgSummary, &tagFields)
+			if err == nil && match {
+				references++
+				break
+			}
+		}
+	}
+
+	if references == 0 {
+		t.log.Debugf(ctx, "Will delete shared TargetGroup %s (%s) - no route references it",
+			*latticeTg.tgSummary.Arn, *latticeTg.tgSummary.Name)
+		return true
+	}
+
+	t.log.Debugf(ctx, "Shared TargetGroup %s (%s) is referenced by %d routes",
+		*latticeTg.tgSummary.Arn, *latticeTg.tgSummary.Name, references)
+	return false
+}
+
+// routeReferencesService tells whether a route has a backendRef to the given service
+func routeReferencesService(route core.Route, svcName apitypes.NamespacedName) bool {
+	for _, rule := range route.Spec().Rules() {
+		for _, backendRef := range rule.BackendRefs() {
+			if backendRef.Kind() != nil && string(*backendRef.Kind()) != "Service" {
+				continue
+			}
+			if backendRef.Group() != nil && string(*backendRef.Group()) != "" {
+				continue
+			}
+			namespace := route.Namespace()
+			if backendRef.Namespace() != nil {
+				namespace = string(*backendRef.Namespace())
+			}
+			if string(backendRef.Name()) == svcName.Name && namespace == svcName.Namespace {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (t *TargetGroupSynthesizer) hasTags(latticeTg tgListOutput) bool {
 	if latticeTg.tags == nil {
 		t.log.Debugf(context.TODO(), "Ignoring target group %s (%s) because tag fetch was not successful",
@@ -363,6 +470,10 @@ func (t *TargetGroupSynthesizer) hasTags(latticeTg tgListOutput) bool {
 }
 
 func (t *TargetGroupSynthesizer) vpcMatchesConfig(latticeTg tgListOutput) bool {
+	if latticeTg.tgSummary.Type == types.TargetGroupTypeLambda {
+		// lambda target groups have no VPC, the cluster name tag still scopes them to this controller
+		return true
+	}
 	if aws.ToString(latticeTg.tgSummary.VpcIdentifier) != config.VpcID {
 		t.log.Debugf(context.TODO(), "Ignoring target group %s (%s) because it is not configured for this VPC",
 			*latticeTg.tgSummary.Arn, *latticeTg.tgSummary.Name)
@@ -386,8 +497,9 @@ func (t *TargetGroupSynthesizer) hasExpectedTags(latticeTg tgListOutput, tagFiel
 		return false
 	}
 
-	// route-based TGs should have the additional route keys
-	if tagFields.IsSourceTypeRoute() && (tagFields.K8SRouteName == "" || tagFields.K8SRouteNamespace == "") {
+	// route-based TGs should have the additional route keys, unless shared by several routes
+	if tagFields.IsSourceTypeRoute() && !tagFields.IsShared() &&
+		(tagFields.K8SRouteName == "" || tagFields.K8SRouteNamespace == "") {
 		t.log.Infof(context.TODO(), "Ignoring route-based target group %s (%s) as one or more required tags are missing",
 			*latticeTg.tgSummary.Arn, *latticeTg.tgSummary.Name)
 		return false
@@ -12,6 +12,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apitypes "k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	mock_client "github.com/aws/aws-application-networking-k8s/mocks/controller-runtime/client"
 	"github.com/aws/aws-application-networking-k8s/pkg/config"
@@ -162,6 +163,7 @@ func getBaseTg() tgListOutput {
 			Port:          aws.Int32(80),
 			Protocol:      types.TargetGroupProtocolHttp,
 			IpAddressType: types.IpAddressTypeIpv4,
+			Type:          types.TargetGroupTypeIp,
 		},
 		tags: make(map[string]string),
 	}
@@ -362,6 +364,44 @@ func Test_DeleteServiceExport_DeleteCases(t *testing.T) {
 		_, err := synthesizer.SynthesizeUnusedDelete(ctx)
 		assert.Nil(t, err)
 	})
+
+	t.Run("Service Export target type differs", func(t *testing.T) {
+		modelTg := model.TargetGroup{
+			Spec: model.TargetGroupSpec{
+				VpcId:           "vpc-id",
+				Type:            "INSTANCE", // <-- important bit, the policy switched to instance targets
+				Port:            80,
+				Protocol:        "HTTP",
+				ProtocolVersion: "HTTP1",
+				IpAddressType:   "IPV4",
+				TargetGroupTagFields: model.TargetGroupTagFields{
+					K8SClusterName:      "cluster-name",
+					K8SServiceName:      "svc",
+					K8SServiceNamespace: "ns",
+					K8SSourceType:       model.SourceTypeSvcExport,
+				},
+			},
+		}
+
+		mockClient.EXPECT().Get(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, name apitypes.NamespacedName, svcExport client.Object, _ ...interface{}) error {
+				svcExport.SetName("svc")
+				svcExport.SetNamespace("ns")
+				return nil
+			},
+		)
+
+		mockSvcExportTgBuilder.EXPECT().BuildTargetGroup(ctx, gomock.Any()).Return(&modelTg, nil)
+
+		mockTGManager.EXPECT().List(ctx).Return(deleteTgs, nil)
+		mockTGManager.EXPECT().Delete(ctx, gomock.Any()).Return(nil)
+
+		synthesizer := NewTargetGroupSynthesizer(
+			gwlog.FallbackLogger, nil, mockClient, mockTGManager, mockSvcExportTgBuilder, nil, nil)
+
+		_, err := synthesizer.SynthesizeUnusedDelete(ctx)
+		assert.Nil(t, err)
+	})
 }
 
 func Test_DeleteRoute_DeleteCases(t *testing.T) {
@@ -479,6 +519,127 @@ func Test_DeleteRoute_DeleteCases(t *testing.T) {
 	})
 }
 
+// LAMBDA target groups have no VPC and ALB target groups may use HTTP2, both are still matched to their HTTPRoute
+func Test_DeleteRoute_LambdaAndAlbTargetGroups(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+	ctx := context.TODO()
+	mockTGManager := NewMockTargetGroupManager(c)
+	mockClient := mock_client.NewMockClient(c)
+
+	config.VpcID = "vpc-id"
+	config.ClusterName = "cluster-name"
+
+	lambdaTg := getBaseTg()
+	lambdaTg.tgSummary.Arn = aws.String("tg-lambda-arn")
+	lambdaTg.tgSummary.Type = types.TargetGroupTypeLambda
+	lambdaTg.tgSummary.VpcIdentifier = nil
+	lambdaTg.tgSummary.Port = nil
+	lambdaTg.tgSummary.Protocol = ""
+	lambdaTg.tags[model.K8SSourceTypeKey] = string(model.SourceTypeHTTPRoute)
+	lambdaTg.tags[model.K8SRouteNameKey] = "route"
+	lambdaTg.tags[model.K8SRouteNamespaceKey] = "route-ns"
+	lambdaTg.tags[model.K8SProtocolVersionKey] = ""
+
+	albTg := copyTgOutput(lambdaTg)
+	albTg.tgSummary.Arn = aws.String("tg-alb-arn")
+	albTg.tgSummary.Type = types.TargetGroupTypeAlb
+	albTg.tgSummary.VpcIdentifier = aws.String("vpc-id")
+	albTg.tgSummary.Port = aws.Int32(443)
+	albTg.tgSummary.Protocol = types.TargetGroupProtocolHttps
+	albTg.tags[model.K8SProtocolVersionKey] = "HTTP2"
+
+	mockTGManager.EXPECT().List(ctx).Return([]tgListOutput{lambdaTg, albTg}, nil)
+	mockClient.EXPECT().Get(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, name apitypes.NamespacedName, route client.Object, _ ...interface{}) error {
+			assert.IsType(t, &gwv1.HTTPRoute{}, route)
+			return &apierrors.StatusError{
+				ErrStatus: metav1.Status{
+					Code:   http.StatusNotFound,
+					Reason: metav1.StatusReasonNotFound,
+				},
+			}
+		},
+	).Times(2)
+	mockTGManager.EXPECT().Delete(ctx, gomock.Any()).Return(nil).Times(2)
+
+	synthesizer := NewTargetGroupSynthesizer(gwlog.FallbackLogger, nil, mockClient, mockTGManager, nil, nil, nil)
+	results, err := synthesizer.SynthesizeUnusedDelete(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, results, 2)
+}
+
+// shared target groups are only deleted once no route references them
+func Test_DeleteRoute_SharedTargetGroup(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+	ctx := context.TODO()
+	mockTGManager := NewMockTargetGroupManager(c)
+	mockClient := mock_client.NewMockClient(c)
+	mockSvcBuilder := gateway.NewMockLatticeServiceBuilder(c)
+
+	config.VpcID = "vpc-id"
+	config.ClusterName = "cluster-name"
+
+	sharedTg := getBaseTg()
+	sharedTg.tgSummary.Arn = aws.String("tg-shared-arn")
+	sharedTg.tags[model.K8SSourceTypeKey] = string(model.SourceTypeHTTPRoute)
+	sharedTg.tags[model.K8SSharedKeyKey] = "8080-abcdef12"
+
+	httpRoute := func(name, backendName string) gwv1.HTTPRoute {
+		return gwv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+			Spec: gwv1.HTTPRouteSpec{
+				Rules: []gwv1.HTTPRouteRule{{
+					BackendRefs: []gwv1.HTTPBackendRef{{
+						BackendRef: gwv1.BackendRef{
+							BackendObjectReference: gwv1.BackendObjectReference{Name: gwv1.ObjectName(backendName)},
+						},
+					}},
+				}},
+			},
+		}
+	}
+	listRoutes := func(routes ...gwv1.HTTPRoute) {
+		mockClient.EXPECT().List(ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, routeList *gwv1.HTTPRouteList, _ ...interface{}) error {
+				routeList.Items = routes
+				return nil
+			},
+		)
+	}
+
+	t.Run("Referenced by a route", func(t *testing.T) {
+		mockTGManager.EXPECT().List(ctx).Return([]tgListOutput{sharedTg}, nil)
+		listRoutes(httpRoute("other-route", "other-svc"), httpRoute("route", "svc"))
+
+		stack := core.NewDefaultStack(core.StackID{Name: "route", Namespace: "ns"})
+		modelTg := &model.TargetGroup{ResourceMeta: core.NewResourceMeta(stack, "AWS:VPCServiceNetwork::TargetGroup", "tg-id")}
+		stack.AddResource(modelTg)
+		// only the route referencing the service is rebuilt
+		mockSvcBuilder.EXPECT().Build(ctx, gomock.Any()).Return(stack, nil)
+		mockTGManager.EXPECT().IsTargetGroupMatch(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+
+		synthesizer := NewTargetGroupSynthesizer(
+			gwlog.FallbackLogger, nil, mockClient, mockTGManager, nil, mockSvcBuilder, nil)
+		results, err := synthesizer.SynthesizeUnusedDelete(ctx)
+		assert.Nil(t, err)
+		assert.Len(t, results, 0)
+	})
+
+	t.Run("Referenced by no route", func(t *testing.T) {
+		mockTGManager.EXPECT().List(ctx).Return([]tgListOutput{sharedTg}, nil)
+		listRoutes(httpRoute("other-route", "other-svc"))
+		mockTGManager.EXPECT().Delete(ctx, gomock.Any()).Return(nil)
+
+		synthesizer := NewTargetGroupSynthesizer(
+			gwlog.FallbackLogger, nil, mockClient, mockTGManager, nil, mockSvcBuilder, nil)
+		results, err := synthesizer.SynthesizeUnusedDelete(ctx)
+		assert.Nil(t, err)
+		assert.Len(t, results, 1)
+	})
+}
+
 // TODO: Error cases should not delete
 
 func Test_SynthesizeCreate_WithServiceExportTargetGroup(t *testing.T) {
@@ -0,0 +1,284 @@
+package lattice
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aws/aws-application-networking-k8s/pkg/gateway"
+	"github.com/aws/aws-application-networking-k8s/pkg/model/core"
+	model "github.com/aws/aws-application-networking-k8s/pkg/model/lattice"
+	"github.com/aws/aws-application-networking-k8s/pkg/utils"
+	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
+)
+
+const (
+	targetsFastPathWorkers    = 4
+	targetsFastPathMaxRetries = 5
+)
+
+// TargetsFastPath keeps the targets of the target groups deployed by route and service export reconciles in
+// sync with endpoint changes alone, without rebuilding services, listeners and rules. The endpoint changes of a
+// service are coalesced per target group over a short window, and the targets are then diffed against the ones
+// last registered, so that only the changed targets are registered or deregistered.
+//
+// Target groups are tracked once their targets are synthesized by a full reconcile, which also refreshes the
+// registered targets from VPC Lattice. A target group that keeps failing to sync is no longer tracked, and the
+// endpoint changes of its service go through full reconciles again.
+type TargetsFastPath struct {
+	log            gwlog.Logger
+	client         client.Client
+	targetsManager TargetsManager
+	window         time.Duration
+	queue          workqueue.TypedRateLimitingInterface[string]
+
+	lock         sync.Mutex
+	targetGroups map[string]*fastPathTargetGroup
+}
+
+// fastPathTargetGroup is a target group tracked by its VPC Lattice id
+type fastPathTargetGroup struct {
+	stackTgId  string
+	spec       model.TargetGroupSpec
+	status     model.TargetGroupStatus
+	source     model.TargetsSource
+	registered utils.Set[model.Target]
+}
+
+func NewTargetsFastPath(
+	log gwlog.Logger,
+	client client.Client,
+	targetsManager TargetsManager,
+	window time.Duration,
+) *TargetsFastPath {
+	return &TargetsFastPath{
+		log:            log,
+		client:         client,
+		targetsManager: targetsManager,
+		window:         window,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "targets-fast-path"}),
+		targetGroups: make(map[string]*fastPathTargetGroup),
+	}
+}
+
+// Track records the targets of a target group as synthesized by a full reconcile. Targets that were not built
+// from a service, like the ones of LAMBDA and ALB target groups, are left out.
+func (f *TargetsFastPath) Track(tg *model.TargetGroup, targets *model.Targets) {
+	if f == nil || targets.Source == nil || tg.Status == nil || tg.Status.Id == "" {
+		return
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.targetGroups[tg.Status.Id] = &fastPathTargetGroup{
+		stackTgId:  tg.ID(),
+		spec:       tg.Spec,
+		status:     *tg.Status,
+		source:     *targets.Source,
+		registered: registeredTargets(targets.Spec.TargetList),
+	}
+}
+
+// Enqueue takes the endpoint changes of a service for its tracked target groups of the given source type. It
+// reports whether there were any, in which case the changes need no full reconcile. Target groups whose health check
+// is derived from readiness probes that changed are left to a full reconcile, which builds the new health check.
+func (f *TargetsFastPath) Enqueue(ctx context.Context, svc types.NamespacedName, sourceType model.K8SSourceType) bool {
+	f.lock.Lock()
+	matching := map[string]*fastPathTargetGroup{}
+	for tgId, tracked := range f.targetGroups {
+		if tracked.source.Service == svc && tracked.spec.K8SSourceType == sourceType {
+			matching[tgId] = tracked
+		}
+	}
+	f.lock.Unlock()
+	if len(matching) == 0 {
+		return false
+	}
+
+	for tgId, tracked := range matching {
+		if changed, err := f.readinessProbeChanged(ctx, tracked); err != nil || changed {
+			f.log.Debugf(ctx, "Readiness probe health check of target group %s may have changed, reconciling", tgId)
+			return false
+		}
+	}
+	for tgId := range matching {
+		// changes coming in before the target group is processed are coalesced into a single sync
+		f.queue.AddAfter(tgId, f.window)
+	}
+	return true
+}
+
+func (f *TargetsFastPath) readinessProbeChanged(ctx context.Context, tracked *fastPathTargetGroup) (bool, error) {
+	if tracked.spec.ReadinessProbe == nil {
+		return false, nil
+	}
+	svc := &corev1.Service{}
+	if err := f.client.Get(ctx, tracked.source.Service, svc); err != nil {
+		return false, err
+	}
+	return gateway.ReadinessProbeHealthCheckChanged(ctx, f.client, svc, tracked.spec.Type, tracked.spec.ReadinessProbe)
+}
+
+// Start processes the queued target groups until the context is done
+func (f *TargetsFastPath) Start(ctx context.Context) error {
+	f.log.Infof(ctx, "Starting targets fast path, coalescing endpoint changes over %s", f.window)
+	var wg sync.WaitGroup
+	for i := 0; i < targetsFastPathWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for f.processNext(ctx) {
+			}
+		}()
+	}
+	<-ctx.Done()
+	f.queue.ShutDown()
+	wg.Wait()
+	return nil
+}
+
+func (f *TargetsFastPath) processNext(ctx context.Context) bool {
+	tgId, shutdown := f.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer f.queue.Done(tgId)
+
+	requeue, err := f.sync(ctx, tgId)
+	switch {
+	case err == nil:
+		f.queue.Forget(tgId)
+		if requeue {
+			f.queue.AddAfter(tgId, f.window)
+		}
+	case f.queue.NumRequeues(tgId) < targetsFastPathMaxRetries:
+		f.log.Infof(ctx, "Failed to sync targets of target group %s, retrying: %s", tgId, err)
+		f.queue.AddRateLimited(tgId)
+	default:
+		f.log.Errorf(ctx, "Failed to sync targets of target group %s, leaving it to full reconciles: %s", tgId, err)
+		f.queue.Forget(tgId)
+		f.Untrack(tgId)
+	}
+	return true
+}
+
+// sync registers and deregisters the targets of a tracked target group that changed since they were last
+// registered, then updates the readiness gates and the draining of the changed targets. It reports whether
+// some of them are still pending.
+func (f *TargetsFastPath) sync(ctx context.Context, tgId string) (bool, error) {
+	f.lock.Lock()
+	tracked, ok := f.targetGroups[tgId]
+	f.lock.Unlock()
+	if !ok {
+		return false, nil
+	}
+
+	stack := core.NewDefaultStack(core.StackID(tracked.source.Service))
+	status := tracked.status
+	tg := &model.TargetGroup{
+		ResourceMeta: core.NewResourceMeta(stack, "AWS:VPCServiceNetwork::TargetGroup", tracked.stackTgId),
+		Spec:         tracked.spec,
+		Status:       &status,
+	}
+	stack.AddResource(tg)
+
+	if _, err := gateway.NewTargetsBuilder(f.log, f.client, stack).BuildForSource(ctx, &tracked.source, tg.ID()); err != nil {
+		if apierrors.IsNotFound(err) {
+			// the service is gone, deleting its target groups is up to full reconciles
+			f.Untrack(tgId)
+			return false, nil
+		}
+		return false, err
+	}
+	var resTargets []*model.Targets
+	stack.ListResources(&resTargets)
+	if len(resTargets) == 0 {
+		f.Untrack(tgId)
+		return false, nil
+	}
+	targetList := resTargets[0].Spec.TargetList
+
+	desired := registeredTargets(targetList)
+	var toRegister, toDeregister []model.Target
+	for _, target := range desired.Items() {
+		if !tracked.registered.Contains(target) {
+			toRegister = append(toRegister, target)
+		}
+	}
+	for _, target := range tracked.registered.Items() {
+		if !desired.Contains(target) {
+			toDeregister = append(toDeregister, target)
+		}
+	}
+
+	if len(toRegister) > 0 || len(toDeregister) > 0 {
+		f.log.Infof(ctx, "Syncing targets of target group %s, registering %d and deregistering %d",
+			tgId, len(toRegister), len(toDeregister))
+		if err := f.targetsManager.Patch(ctx, tg, toRegister, toDeregister); err != nil {
+			return false, err
+		}
+	}
+
+	f.lock.Lock()
+	// unless a full reconcile has tracked the target group again in the meantime
+	if f.targetGroups[tgId] == tracked {
+		tracked.registered = desired
+	}
+	f.lock.Unlock()
+
+	// only the targets just registered, or still pending, need their pods updated
+	registeredNow := utils.NewSet(toRegister...)
+	pendingTargets := utils.SliceFilter(targetList, func(t model.Target) bool {
+		key := model.Target{TargetIP: t.TargetIP, Port: t.Port}
+		return t.TargetRef.Name != "" && (!t.Ready || t.Draining || registeredNow.Contains(key))
+	})
+	if len(pendingTargets) == 0 {
+		return false, nil
+	}
+
+	latticeTargets, err := f.targetsManager.List(ctx, tg)
+	if err != nil {
+		return false, err
+	}
+	synthesizer := NewTargetsSynthesizer(f.log, f.client, f.targetsManager, stack, nil)
+	statusPending, err := synthesizer.syncStatus(ctx, pendingTargets, latticeTargets)
+	if err != nil {
+		return false, err
+	}
+	drainingPending, err := synthesizer.syncDraining(ctx, tg, pendingTargets, latticeTargets)
+	if err != nil {
+		return false, err
+	}
+	return statusPending || drainingPending, nil
+}
+
+// Untrack stops tracking a target group, once it is deleted. Service changes no longer skip the full reconcile
+// because of it.
+func (f *TargetsFastPath) Untrack(tgId string) {
+	if f == nil || tgId == "" {
+		return
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	delete(f.targetGroups, tgId)
+}
+
+// registeredTargets is the set of targets to be registered, by id and port. Draining targets deregister.
+func registeredTargets(targetList []model.Target) utils.Set[model.Target] {
+	registered := utils.NewSet[model.Target]()
+	for _, target := range targetList {
+		if !target.Draining {
+			registered.Put(model.Target{TargetIP: target.TargetIP, Port: target.Port})
+		}
+	}
+	return registered
+}
@@ -0,0 +1,241 @@
+package lattice
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-application-networking-k8s/pkg/gateway"
+	"github.com/aws/aws-application-networking-k8s/pkg/model/core"
+	model "github.com/aws/aws-application-networking-k8s/pkg/model/lattice"
+	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_TargetsFastPath_Enqueue(t *testing.T) {
+	svc := apitypes.NamespacedName{Namespace: "ns", Name: "svc"}
+	tests := []struct {
+		name       string
+		source     *model.TargetsSource
+		svc        apitypes.NamespacedName
+		sourceType model.K8SSourceType
+		want       bool
+	}{
+		{
+			name:       "tracked service",
+			source:     &model.TargetsSource{Service: svc, Ports: []int32{80}},
+			svc:        svc,
+			sourceType: model.SourceTypeHTTPRoute,
+			want:       true,
+		},
+		{
+			name:       "other source type",
+			source:     &model.TargetsSource{Service: svc, Ports: []int32{80}},
+			svc:        svc,
+			sourceType: model.SourceTypeSvcExport,
+		},
+		{
+			name:       "other service",
+			source:     &model.TargetsSource{Service: svc, Ports: []int32{80}},
+			svc:        apitypes.NamespacedName{Namespace: "ns", Name: "other"},
+			sourceType: model.SourceTypeHTTPRoute,
+		},
+		{
+			// like the targets of LAMBDA target groups
+			name:       "targets built from no service are not tracked",
+			svc:        svc,
+			sourceType: model.SourceTypeHTTPRoute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fastPath := NewTargetsFastPath(gwlog.FallbackLogger, nil, nil, time.Hour)
+			assert.False(t, fastPath.Enqueue(context.TODO(), tt.svc, tt.sourceType))
+
+			stack := core.NewDefaultStack(core.StackID{Name: "route", Namespace: "ns"})
+			tg := &model.TargetGroup{
+				ResourceMeta: core.NewResourceMeta(stack, "AWS:VPCServiceNetwork::TargetGroup", "tg-stack-id"),
+				Spec: model.TargetGroupSpec{
+					TargetGroupTagFields: model.TargetGroupTagFields{K8SSourceType: model.SourceTypeHTTPRoute},
+				},
+				Status: &model.TargetGroupStatus{Name: "tg-name", Arn: "tg-arn", Id: "tg-id"},
+			}
+			stack.AddResource(tg)
+			targets, _ := model.NewTargets(stack, model.TargetsSpec{StackTargetGroupId: "tg-stack-id"})
+			targets.Source = tt.source
+			fastPath.Track(tg, targets)
+
+			// endpoint changes are coalesced until the target group is synced
+			assert.Equal(t, tt.want, fastPath.Enqueue(context.TODO(), tt.svc, tt.sourceType))
+			assert.Equal(t, tt.want, fastPath.Enqueue(context.TODO(), tt.svc, tt.sourceType))
+			if tt.source == nil {
+				assert.Empty(t, fastPath.targetGroups)
+			}
+		})
+	}
+}
+
+func Test_TargetsFastPath_UntrackDeletedTargetGroup(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+	ctx := context.TODO()
+	mockTGManager := NewMockTargetGroupManager(c)
+
+	fastPath := NewTargetsFastPath(gwlog.FallbackLogger, nil, nil, time.Hour)
+	svc := apitypes.NamespacedName{Namespace: "ns", Name: "svc"}
+
+	stack := core.NewDefaultStack(core.StackID{Name: "route", Namespace: "ns"})
+	tg := &model.TargetGroup{
+		ResourceMeta: core.NewResourceMeta(stack, "AWS:VPCServiceNetwork::TargetGroup", "tg-stack-id"),
+		Spec: model.TargetGroupSpec{
+			TargetGroupTagFields: model.TargetGroupTagFields{K8SSourceType: model.SourceTypeHTTPRoute},
+		},
+		Status: &model.TargetGroupStatus{Name: "tg-name", Arn: "tg-arn", Id: "tg-id"},
+	}
+	stack.AddResource(tg)
+	targets, _ := model.NewTargets(stack, model.TargetsSpec{StackTargetGroupId: "tg-stack-id"})
+	targets.Source = &model.TargetsSource{Service: svc, Ports: []int32{80}}
+	fastPath.Track(tg, targets)
+	assert.True(t, fastPath.Enqueue(context.TODO(), svc, model.SourceTypeHTTPRoute))
+
+	// a target group left tracked after it failed to delete keeps absorbing service changes
+	tg.IsDeleted = true
+	mockTGManager.EXPECT().Delete(ctx, tg).Return(errors.New("delete error"))
+	synthesizer := NewTargetGroupSynthesizer(gwlog.FallbackLogger, nil, nil, mockTGManager, nil, nil, stack).
+		WithTargetsFastPath(fastPath)
+	assert.Error(t, synthesizer.SynthesizeDelete(ctx))
+	assert.True(t, fastPath.Enqueue(context.TODO(), svc, model.SourceTypeHTTPRoute))
+
+	// once deleted, service changes go through the full reconcile
+	mockTGManager.EXPECT().Delete(ctx, tg).Return(nil)
+	assert.NoError(t, synthesizer.SynthesizeDelete(ctx))
+	assert.False(t, fastPath.Enqueue(context.TODO(), svc, model.SourceTypeHTTPRoute))
+	assert.Empty(t, fastPath.targetGroups)
+}
+
+func Test_TargetsFastPath_EnqueueReadinessProbeChange(t *testing.T) {
+	ctx := context.TODO()
+	newPod := func(name, path string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name, Labels: map[string]string{"app": "app"}},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{
+				Name:  "app",
+				Ports: []corev1.ContainerPort{{ContainerPort: 8080}},
+				ReadinessProbe: &corev1.Probe{ProbeHandler: corev1.ProbeHandler{
+					HTTPGet: &corev1.HTTPGetAction{Path: path, Port: intstr.FromInt32(8080)},
+				}},
+			}}},
+		}
+	}
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "svc"},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"app": "app"},
+			Ports:    []corev1.ServicePort{{Port: 80, TargetPort: intstr.FromInt32(8080)}},
+		},
+	}
+	k8sClient := testclient.NewClientBuilder().WithObjects(svc, newPod("pod1", "/healthz")).Build()
+	fastPath := NewTargetsFastPath(gwlog.FallbackLogger, k8sClient, nil, time.Hour)
+	svcName := apitypes.NamespacedName{Namespace: "ns", Name: "svc"}
+
+	stack := core.NewDefaultStack(core.StackID{Name: "route", Namespace: "ns"})
+	tg := &model.TargetGroup{
+		ResourceMeta: core.NewResourceMeta(stack, "AWS:VPCServiceNetwork::TargetGroup", "tg-stack-id"),
+		Spec: model.TargetGroupSpec{
+			Type:                 model.TargetGroupTypeIP,
+			TargetGroupTagFields: model.TargetGroupTagFields{K8SSourceType: model.SourceTypeHTTPRoute},
+		},
+		Status: &model.TargetGroupStatus{Name: "tg-name", Arn: "tg-arn", Id: "tg-id"},
+	}
+	stack.AddResource(tg)
+	probeCfg, err := gateway.BuildReadinessProbeHealthCheckConfig(ctx, k8sClient, svc, 80, model.TargetGroupTypeIP)
+	assert.NoError(t, err)
+	tg.Spec.ReadinessProbe = &model.ReadinessProbeHealthCheck{Port: 80, HealthCheckConfig: probeCfg}
+	targets, _ := model.NewTargets(stack, model.TargetsSpec{StackTargetGroupId: "tg-stack-id"})
+	targets.Source = &model.TargetsSource{Service: svcName, Ports: []int32{80}}
+	fastPath.Track(tg, targets)
+	assert.True(t, fastPath.Enqueue(ctx, svcName, model.SourceTypeHTTPRoute))
+
+	// pods rolling out another probe keep the health check, and the fast path
+	assert.NoError(t, k8sClient.Create(ctx, newPod("pod2", "/ready")))
+	assert.True(t, fastPath.Enqueue(ctx, svcName, model.SourceTypeHTTPRoute))
+
+	// once the pods agree on the new probe, the health check needs a full reconcile
+	assert.NoError(t, k8sClient.Delete(ctx, newPod("pod1", "")))
+	assert.False(t, fastPath.Enqueue(ctx, svcName, model.SourceTypeHTTPRoute))
+}
+
+func Test_TargetsFastPath_Sync(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+	ctx := context.TODO()
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "svc"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{Name: "http", Port: 80}},
+		},
+	}
+	epSlice := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "svc-1",
+			Labels:    map[string]string{discoveryv1.LabelServiceName: "svc"},
+		},
+		Ports: []discoveryv1.EndpointPort{{Name: aws.String("http"), Port: aws.Int32(8080)}},
+		Endpoints: []discoveryv1.Endpoint{
+			{Addresses: []string{"10.0.0.2"}, Conditions: discoveryv1.EndpointConditions{Ready: aws.Bool(true)}},
+			{Addresses: []string{"10.0.0.3"}, Conditions: discoveryv1.EndpointConditions{Ready: aws.Bool(true)}},
+		},
+	}
+	k8sClient := testclient.NewClientBuilder().WithObjects(svc, epSlice).Build()
+
+	mockTargetsManager := NewMockTargetsManager(c)
+	fastPath := NewTargetsFastPath(gwlog.FallbackLogger, k8sClient, mockTargetsManager, time.Hour)
+
+	stack := core.NewDefaultStack(core.StackID{Name: "route", Namespace: "ns"})
+	tg := &model.TargetGroup{
+		ResourceMeta: core.NewResourceMeta(stack, "AWS:VPCServiceNetwork::TargetGroup", "tg-stack-id"),
+		Spec: model.TargetGroupSpec{
+			TargetGroupTagFields: model.TargetGroupTagFields{K8SSourceType: model.SourceTypeHTTPRoute},
+		},
+		Status: &model.TargetGroupStatus{Name: "tg-name", Arn: "tg-arn", Id: "tg-id"},
+	}
+	stack.AddResource(tg)
+	targets, _ := model.NewTargets(stack, model.TargetsSpec{StackTargetGroupId: "tg-stack-id", TargetList: []model.Target{
+		{TargetIP: "10.0.0.1", Port: 8080, Ready: true},
+		{TargetIP: "10.0.0.2", Port: 8080, Ready: true},
+	}})
+	targets.Source = &model.TargetsSource{Service: apitypes.NamespacedName{Namespace: "ns", Name: "svc"}, Ports: []int32{80}}
+	fastPath.Track(tg, targets)
+
+	// only the changed targets are registered and deregistered, with no ListTargets call
+	mockTargetsManager.EXPECT().Patch(ctx, gomock.Any(),
+		[]model.Target{{TargetIP: "10.0.0.3", Port: 8080}},
+		[]model.Target{{TargetIP: "10.0.0.1", Port: 8080}}).Return(nil)
+
+	requeue, err := fastPath.sync(ctx, "tg-id")
+	assert.NoError(t, err)
+	assert.False(t, requeue)
+
+	// nothing changed since
+	requeue, err = fastPath.sync(ctx, "tg-id")
+	assert.NoError(t, err)
+	assert.False(t, requeue)
+
+	// the service is gone
+	assert.NoError(t, k8sClient.Delete(ctx, svc))
+	_, err = fastPath.sync(ctx, "tg-id")
+	assert.NoError(t, err)
+	assert.Empty(t, fastPath.targetGroups)
+}
@@ -26,6 +26,7 @@ const (
 type TargetsManager interface {
 	List(ctx context.Context, modelTg *model.TargetGroup) ([]types.TargetSummary, error)
 	Update(ctx context.Context, modelTargets *model.Targets, modelTg *model.TargetGroup) error
+	Patch(ctx context.Context, modelTg *model.TargetGroup, toRegister []model.Target, toDeregister []model.Target) error
 }
 
 type defaultTargetsManager struct {
@@ -77,7 +78,20 @@ func (s *defaultTargetsManager) Update(ctx context.Context, modelTargets *model.
 	}
 
 	err1 := s.deregisterTargets(ctx, modelTg, staleTargets)
-	err2 := s.registerTargets(ctx, modelTg, modelTargets.Spec.TargetList)
+	err2 := s.registerTargets(ctx, modelTg, utils.SliceFilter(modelTargets.Spec.TargetList, func(t model.Target) bool {
+		return !t.Draining
+	}))
+	return errors.Join(err1, err2)
+}
+
+// Patch registers and deregisters the given targets only, without listing the targets of the target group
+func (s *defaultTargetsManager) Patch(ctx context.Context, modelTg *model.TargetGroup, toRegister []model.Target, toDeregister []model.Target) error {
+	if modelTg.Status == nil || modelTg.Status.Id == "" {
+		return errors.New("model target group is missing id")
+	}
+
+	err1 := s.deregisterTargets(ctx, modelTg, toDeregister)
+	err2 := s.registerTargets(ctx, modelTg, toRegister)
 	return errors.Join(err1, err2)
 }
 
@@ -85,9 +99,13 @@ func (s *defaultTargetsManager) findStaleTargets(
 	modelTargets *model.Targets,
 	listTargetsOutput []types.TargetSummary) []model.Target {
 
-	// Disregard readiness information, and use IP/Port as key.
+	// Disregard readiness information, and use Id/Port as key. The Id is the target IP, or the
+	// instance ID of INSTANCE target groups. Draining targets are left out so that they deregister.
 	modelSet := utils.NewSet[model.Target]()
 	for _, target := range modelTargets.Spec.TargetList {
+		if target.Draining {
+			continue
+		}
 		targetIpPort := model.Target{
 			TargetIP: target.TargetIP,
 			Port:     target.Port,
@@ -110,6 +128,15 @@ func (s *defaultTargetsManager) findStaleTargets(
 	return staleTargets
 }
 
+// toLatticeTarget converts a model target, leaving out the port of LAMBDA targets
+func toLatticeTarget(t model.Target) types.Target {
+	target := types.Target{Id: aws.String(t.TargetIP)}
+	if t.Port != 0 {
+		target.Port = aws.Int32(int32(t.Port))
+	}
+	return target
+}
+
 func (s *defaultTargetsManager) registerTargets(
 	ctx context.Context,
 	modelTg *model.TargetGroup,
@@ -118,10 +145,7 @@ func (s *defaultTargetsManager) registerTargets(
 	if len(targets) == 0 {
 		return nil
 	}
-	latticeTargets := utils.SliceMap(targets, func(t model.Target) types.Target {
-		p := int32(t.Port)
-		return types.Target{Id: &t.TargetIP, Port: &p}
-	})
+	latticeTargets := utils.SliceMap(targets, toLatticeTarget)
 	chunks := utils.Chunks(latticeTargets, maxTargetsPerLatticeTargetsApiCall)
 	var registerTargetsError error
 	for i, chunk := range chunks {
@@ -152,10 +176,7 @@ func (s *defaultTargetsManager) deregisterTargets(
 	if len(targets) == 0 {
 		return nil
 	}
-	latticeTargets := utils.SliceMap(targets, func(t model.Target) types.Target {
-		p := int32(t.Port)
-		return types.Target{Id: &t.TargetIP, Port: &p}
-	})
+	latticeTargets := utils.SliceMap(targets, toLatticeTarget)
 
 	chunks := utils.Chunks(latticeTargets, maxTargetsPerLatticeTargetsApiCall)
 	var deregisterTargetsError error
@@ -57,6 +57,20 @@ func (mr *MockTargetsManagerMockRecorder) List(ctx, modelTg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTargetsManager)(nil).List), ctx, modelTg)
 }
 
+// Patch mocks base method.
+func (m *MockTargetsManager) Patch(ctx context.Context, modelTg *lattice.TargetGroup, toRegister, toDeregister []lattice.Target) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", ctx, modelTg, toRegister, toDeregister)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockTargetsManagerMockRecorder) Patch(ctx, modelTg, toRegister, toDeregister any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockTargetsManager)(nil).Patch), ctx, modelTg, toRegister, toDeregister)
+}
+
 // Update mocks base method.
 func (m *MockTargetsManager) Update(ctx context.Context, modelTargets *lattice.Targets, modelTg *lattice.TargetGroup) error {
 	m.ctrl.T.Helper()
@@ -192,6 +192,59 @@ func TestTargetsManager(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("lambda target is registered without a port", func(t *testing.T) {
+		functionArn := "arn:aws:lambda:us-west-2:123456789012:function:hello"
+		lambdaTargets := model.Targets{
+			Spec: model.TargetsSpec{
+				StackTargetGroupId: "tg-stack-id",
+				TargetList:         []model.Target{{TargetIP: functionArn, Ready: true}},
+			},
+		}
+		existingTargets := []types.TargetSummary{{Id: aws.String(functionArn)}}
+
+		mockLattice.EXPECT().ListTargetsAsList(ctx, gomock.Any()).Return(existingTargets, nil)
+		mockLattice.EXPECT().RegisterTargets(ctx, &vpclattice.RegisterTargetsInput{
+			TargetGroupIdentifier: aws.String("tg-id"),
+			Targets:               []types.Target{{Id: aws.String(functionArn)}},
+		}).Return(registerTargetsOutput, nil)
+
+		targetsManager := NewTargetsManager(gwlog.FallbackLogger, mockCloud)
+		err := targetsManager.Update(ctx, &lambdaTargets, &modelTg)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("draining target is deregistered and not registered", func(t *testing.T) {
+		drainingTargets := model.Targets{
+			Spec: model.TargetsSpec{
+				StackTargetGroupId: "tg-stack-id",
+				TargetList: []model.Target{
+					{TargetIP: "1.1.1.1", Port: 80, Ready: true},
+					{TargetIP: "2.2.2.2", Port: 80, Draining: true},
+				},
+			},
+		}
+		existingTargets := []types.TargetSummary{
+			{Id: aws.String("1.1.1.1"), Port: aws.Int32(80), Status: types.TargetStatusHealthy},
+			{Id: aws.String("2.2.2.2"), Port: aws.Int32(80), Status: types.TargetStatusHealthy},
+		}
+
+		mockLattice.EXPECT().ListTargetsAsList(ctx, gomock.Any()).Return(existingTargets, nil)
+		mockLattice.EXPECT().DeregisterTargets(ctx, &vpclattice.DeregisterTargetsInput{
+			TargetGroupIdentifier: aws.String("tg-id"),
+			Targets:               []types.Target{{Id: aws.String("2.2.2.2"), Port: aws.Int32(80)}},
+		}).Return(&vpclattice.DeregisterTargetsOutput{}, nil)
+		mockLattice.EXPECT().RegisterTargets(ctx, &vpclattice.RegisterTargetsInput{
+			TargetGroupIdentifier: aws.String("tg-id"),
+			Targets:               []types.Target{{Id: aws.String("1.1.1.1"), Port: aws.Int32(80)}},
+		}).Return(registerTargetsOutput, nil)
+
+		targetsManager := NewTargetsManager(gwlog.FallbackLogger, mockCloud)
+		err := targetsManager.Update(ctx, &drainingTargets, &modelTg)
+
+		assert.Nil(t, err)
+	})
+
 	t.Run("overlapping target sets does the right thing", func(t *testing.T) {
 		mt1 := model.Target{
 			TargetIP: "192.0.2.10",
@@ -3,12 +3,15 @@ package lattice
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/aws/aws-application-networking-k8s/pkg/k8s"
 	"github.com/aws/aws-application-networking-k8s/pkg/model/core"
 	model "github.com/aws/aws-application-networking-k8s/pkg/model/lattice"
 	lattice_runtime "github.com/aws/aws-application-networking-k8s/pkg/runtime"
@@ -33,12 +36,14 @@ func NewTargetsSynthesizer(
 	client client.Client,
 	tgManager TargetsManager,
 	stack core.Stack,
+	fastPath *TargetsFastPath,
 ) *targetsSynthesizer {
 	return &targetsSynthesizer{
 		log:            log,
 		client:         client,
 		targetsManager: tgManager,
 		stack:          stack,
+		fastPath:       fastPath,
 	}
 }
 
@@ -47,6 +52,7 @@ type targetsSynthesizer struct {
 	client         client.Client
 	targetsManager TargetsManager
 	stack          core.Stack
+	fastPath       *TargetsFastPath
 }
 
 func (t *targetsSynthesizer) Synthesize(ctx context.Context) error {
@@ -71,6 +77,7 @@ func (t *targetsSynthesizer) Synthesize(ctx context.Context) error {
 			}
 			return fmt.Errorf("failed to synthesize targets %s due to %s", identifier, err)
 		}
+		t.fastPath.Track(tg, targets)
 	}
 	return nil
 }
@@ -105,6 +112,12 @@ func (t *targetsSynthesizer) PostSynthesize(ctx context.Context) error {
 			return fmt.Errorf("failed post-synthesize targets %s, condition sync failure: %w", identifier, err)
 		}
 		requeueNeeded = requeueNeeded || pending
+
+		draining, err := t.syncDraining(ctx, tg, targets.Spec.TargetList, latticeTargets)
+		if err != nil {
+			return fmt.Errorf("failed post-synthesize targets %s, draining sync failure: %w", identifier, err)
+		}
+		requeueNeeded = requeueNeeded || draining
 	}
 
 	if requeueNeeded {
@@ -113,8 +126,8 @@ func (t *targetsSynthesizer) PostSynthesize(ctx context.Context) error {
 	return nil
 }
 
-func (t *targetsSynthesizer) syncStatus(ctx context.Context, modelTargets []model.Target, latticeTargets []types.TargetSummary) (bool, error) {
-	// Extract Lattice targets as a set
+// latticeTargetsByIpPort extracts Lattice targets as a set
+func latticeTargetsByIpPort(latticeTargets []types.TargetSummary) map[model.Target]*types.TargetSummary {
 	latticeTargetMap := make(map[model.Target]*types.TargetSummary)
 
 	for _, latticeTarget := range latticeTargets {
@@ -125,11 +138,16 @@ func (t *targetsSynthesizer) syncStatus(ctx context.Context, modelTargets []mode
 		lt := latticeTarget
 		latticeTargetMap[ipPort] = &lt
 	}
+	return latticeTargetMap
+}
+
+func (t *targetsSynthesizer) syncStatus(ctx context.Context, modelTargets []model.Target, latticeTargets []types.TargetSummary) (bool, error) {
+	latticeTargetMap := latticeTargetsByIpPort(latticeTargets)
 
 	var requeue bool
 	for _, target := range modelTargets {
 		// Step 0: Check if the endpoint has a valid target, and is not ready yet.
-		if target.Ready || target.TargetRef.Name == "" {
+		if target.Ready || target.Draining || target.TargetRef.Name == "" {
 			continue
 		}
 
@@ -193,3 +211,51 @@ func (t *targetsSynthesizer) syncStatus(ctx context.Context, modelTargets []mode
 	}
 	return requeue, nil
 }
+
+// syncDraining holds the deletion of the pods behind the targets when the target group has a draining timeout.
+// A terminating pod is released once its draining target is gone from the target group or unused. The pod
+// controller releases it at the draining timeout otherwise.
+func (t *targetsSynthesizer) syncDraining(ctx context.Context, tg *model.TargetGroup, modelTargets []model.Target, latticeTargets []types.TargetSummary) (bool, error) {
+	latticeTargetMap := latticeTargetsByIpPort(latticeTargets)
+	timeoutSeconds := tg.Spec.DrainingTimeoutSeconds
+
+	var requeue bool
+	for _, target := range modelTargets {
+		if target.TargetRef.Name == "" || (timeoutSeconds == 0 && !target.Draining) {
+			continue
+		}
+
+		pod := &corev1.Pod{}
+		if err := t.client.Get(ctx, target.TargetRef, pod); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return requeue, err
+		}
+
+		if !target.Draining {
+			if err := k8s.HoldPodDeletion(ctx, t.client, pod, timeoutSeconds); err != nil {
+				return requeue, err
+			}
+			continue
+		}
+		if pod.DeletionTimestamp.IsZero() || !k8s.HasFinalizer(pod, k8s.PodDrainingFinalizer) {
+			continue
+		}
+
+		latticeTarget, ok := latticeTargetMap[model.Target{TargetIP: target.TargetIP, Port: target.Port}]
+		drained := !ok || latticeTarget.Status == types.TargetStatusUnused
+		if !drained && timeoutSeconds > 0 && time.Now().Before(k8s.DrainingDeadline(pod)) {
+			t.log.Debugf(ctx, "Target %s:%d of pod %s is %s, holding pod deletion",
+				target.TargetIP, target.Port, target.TargetRef, latticeTarget.Status)
+			requeue = true
+			continue
+		}
+
+		t.log.Infof(ctx, "Releasing deletion of pod %s, target %s:%d is drained", target.TargetRef, target.TargetIP, target.Port)
+		if err := k8s.ReleasePodDeletion(ctx, t.client, pod); err != nil {
+			return requeue, err
+		}
+	}
+	return requeue, nil
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-application-networking-k8s/pkg/k8s"
 	"github.com/aws/aws-application-networking-k8s/pkg/model/core"
 	model "github.com/aws/aws-application-networking-k8s/pkg/model/lattice"
 	lattice_runtime "github.com/aws/aws-application-networking-k8s/pkg/runtime"
@@ -15,6 +17,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apitypes "k8s.io/apimachinery/pkg/types"
 	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -67,7 +70,7 @@ func Test_SynthesizeTargets(t *testing.T) {
 
 	mockTargetsManager.EXPECT().Update(ctx, gomock.Any(), gomock.Any()).Return(nil)
 
-	synthesizer := NewTargetsSynthesizer(gwlog.FallbackLogger, nil, mockTargetsManager, stack)
+	synthesizer := NewTargetsSynthesizer(gwlog.FallbackLogger, nil, mockTargetsManager, stack, nil)
 	err := synthesizer.Synthesize(ctx)
 	assert.Nil(t, err)
 }
@@ -248,7 +251,7 @@ func Test_PostSynthesize_Conditions(t *testing.T) {
 			k8sClient := testclient.NewClientBuilder().Build()
 			assert.NoError(t, k8sClient.Create(ctx, tt.pod))
 
-			synthesizer := NewTargetsSynthesizer(gwlog.FallbackLogger, k8sClient, mockTargetsManager, stack)
+			synthesizer := NewTargetsSynthesizer(gwlog.FallbackLogger, k8sClient, mockTargetsManager, stack, nil)
 			err := synthesizer.PostSynthesize(ctx)
 
 			if tt.requeue {
@@ -268,3 +271,156 @@ func Test_PostSynthesize_Conditions(t *testing.T) {
 		})
 	}
 }
+
+func Test_PostSynthesize_Draining(t *testing.T) {
+	newPod := func(deletedAgo time.Duration, drainingTimeout string) *corev1.Pod {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "pod1",
+			},
+		}
+		if drainingTimeout != "" {
+			pod.Finalizers = []string{k8s.PodDrainingFinalizer}
+			pod.Annotations = map[string]string{k8s.DrainingTimeoutAnnotation: drainingTimeout}
+			deletionTimestamp := metav1.NewTime(time.Now().Add(-deletedAgo))
+			pod.DeletionTimestamp = &deletionTimestamp
+		}
+		return pod
+	}
+	newLatticeTarget := func(status types.TargetStatus) []types.TargetSummary {
+		return []types.TargetSummary{{Id: aws.String("10.10.1.1"), Port: aws.Int32(8675), Status: status}}
+	}
+	target := model.Target{
+		TargetIP:  "10.10.1.1",
+		Port:      8675,
+		Ready:     true,
+		TargetRef: apitypes.NamespacedName{Namespace: "ns", Name: "pod1"},
+	}
+	drainingTarget := model.Target{
+		TargetIP:  "10.10.1.1",
+		Port:      8675,
+		Draining:  true,
+		TargetRef: apitypes.NamespacedName{Namespace: "ns", Name: "pod1"},
+	}
+
+	tests := []struct {
+		name           string
+		timeout        int64
+		model          model.Target
+		lattice        []types.TargetSummary
+		pod            *corev1.Pod
+		wantFinalizer  bool
+		wantPodDeleted bool
+		requeue        bool
+	}{
+		{
+			name:          "running pod is held",
+			timeout:       60,
+			model:         target,
+			lattice:       newLatticeTarget(types.TargetStatusHealthy),
+			pod:           newPod(0, ""),
+			wantFinalizer: true,
+		},
+		{
+			name:          "running pod is not held without draining timeout",
+			model:         target,
+			lattice:       newLatticeTarget(types.TargetStatusHealthy),
+			pod:           newPod(0, ""),
+			wantFinalizer: false,
+		},
+		{
+			name:          "terminating pod is held while its target drains",
+			timeout:       60,
+			model:         drainingTarget,
+			lattice:       newLatticeTarget(types.TargetStatusDraining),
+			pod:           newPod(10*time.Second, "60"),
+			wantFinalizer: true,
+			requeue:       true,
+		},
+		{
+			name:           "terminating pod is released once its target is gone",
+			timeout:        60,
+			model:          drainingTarget,
+			pod:            newPod(10*time.Second, "60"),
+			wantPodDeleted: true,
+		},
+		{
+			name:           "terminating pod is released once its target is unused",
+			timeout:        60,
+			model:          drainingTarget,
+			lattice:        newLatticeTarget(types.TargetStatusUnused),
+			pod:            newPod(10*time.Second, "60"),
+			wantPodDeleted: true,
+		},
+		{
+			name:           "terminating pod is released after the draining timeout",
+			timeout:        60,
+			model:          drainingTarget,
+			lattice:        newLatticeTarget(types.TargetStatusDraining),
+			pod:            newPod(2*time.Minute, "60"),
+			wantPodDeleted: true,
+		},
+		{
+			name:           "terminating pod is released when the draining timeout is removed",
+			model:          drainingTarget,
+			lattice:        newLatticeTarget(types.TargetStatusDraining),
+			pod:            newPod(10*time.Second, "60"),
+			wantPodDeleted: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			ctx := context.TODO()
+
+			mockTargetsManager := NewMockTargetsManager(c)
+
+			stack := core.NewDefaultStack(core.StackID{Name: "foo", Namespace: "bar"})
+
+			modelTg := model.TargetGroup{
+				ResourceMeta: core.NewResourceMeta(stack, "AWS:VPCServiceNetwork::TargetGroup", "tg-stack-id"),
+				Spec:         model.TargetGroupSpec{DrainingTimeoutSeconds: tt.timeout},
+				Status: &model.TargetGroupStatus{
+					Name: "tg-name",
+					Arn:  "tg-arn",
+					Id:   "tg-id",
+				},
+			}
+			assert.NoError(t, stack.AddResource(&modelTg))
+
+			model.NewTargets(stack, model.TargetsSpec{
+				StackTargetGroupId: modelTg.ID(),
+				TargetList:         []model.Target{tt.model},
+			})
+
+			mockTargetsManager.EXPECT().List(ctx, gomock.Any()).Return(tt.lattice, nil)
+
+			k8sClient := testclient.NewClientBuilder().WithObjects(tt.pod).Build()
+
+			synthesizer := NewTargetsSynthesizer(gwlog.FallbackLogger, k8sClient, mockTargetsManager, stack, nil)
+			err := synthesizer.PostSynthesize(ctx)
+
+			if tt.requeue {
+				var requeueNeededAfter *lattice_runtime.RequeueNeededAfter
+				assert.True(t, errors.As(err, &requeueNeededAfter))
+			} else {
+				assert.Nil(t, err)
+			}
+
+			pod := &corev1.Pod{}
+			err = k8sClient.Get(ctx, apitypes.NamespacedName{Namespace: "ns", Name: "pod1"}, pod)
+			if tt.wantPodDeleted {
+				assert.True(t, apierrors.IsNotFound(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantFinalizer, k8s.HasFinalizer(pod, k8s.PodDrainingFinalizer))
+			if tt.wantFinalizer {
+				assert.Equal(t, "60", pod.Annotations[k8s.DrainingTimeoutAnnotation])
+			}
+		})
+	}
+}
@@ -58,6 +58,7 @@ type latticeServiceStackDeployer struct {
 	dnsEndpointManager    externaldns.DnsEndpointManager
 	svcExportTgBuilder    gateway.SvcExportTargetGroupModelBuilder
 	svcBuilder            gateway.LatticeServiceBuilder
+	targetsFastPath       *lattice.TargetsFastPath
 }
 
 var tgGcOnce sync.Once
@@ -67,6 +68,7 @@ func NewLatticeServiceStackDeploy(
 	log gwlog.Logger,
 	cloud pkg_aws.Cloud,
 	k8sClient client.Client,
+	targetsFastPath *lattice.TargetsFastPath,
 ) *latticeServiceStackDeployer {
 	brTgBuilder := gateway.NewBackendRefTargetGroupBuilder(log, k8sClient)
 
@@ -78,7 +80,8 @@ func NewLatticeServiceStackDeploy(
 		// TODO: need to refactor TG synthesizer. Remove stack from constructor
 		// arguments and use it as Synth argument. That will help with Synth
 		// reuse for GC purposes
-		tgGcSynth := lattice.NewTargetGroupSynthesizer(log, cloud, k8sClient, tgMgr, tgSvcExpBuilder, svcBuilder, nil)
+		tgGcSynth := lattice.NewTargetGroupSynthesizer(log, cloud, k8sClient, tgMgr, tgSvcExpBuilder, svcBuilder, nil).
+			WithTargetsFastPath(targetsFastPath)
 		tgGcFn := NewTgGcFn(tgGcSynth)
 		tgGc = &TgGc{
 			lock:    sync.RWMutex{},
@@ -103,6 +106,7 @@ func NewLatticeServiceStackDeploy(
 		dnsEndpointManager:    externaldns.NewDnsEndpointManager(log, k8sClient),
 		svcExportTgBuilder:    tgSvcExpBuilder,
 		svcBuilder:            svcBuilder,
+		targetsFastPath:       targetsFastPath,
 	}
 }
 
@@ -183,8 +187,9 @@ func (gc *TgGc) cycle() {
 }
 
 func (d *latticeServiceStackDeployer) Deploy(ctx context.Context, stack core.Stack) error {
-	targetGroupSynthesizer := lattice.NewTargetGroupSynthesizer(d.log, d.cloud, d.k8sClient, d.targetGroupManager, d.svcExportTgBuilder, d.svcBuilder, stack)
-	targetsSynthesizer := lattice.NewTargetsSynthesizer(d.log, d.k8sClient, d.targetsManager, stack)
+	targetGroupSynthesizer := lattice.NewTargetGroupSynthesizer(d.log, d.cloud, d.k8sClient, d.targetGroupManager, d.svcExportTgBuilder, d.svcBuilder, stack).
+		WithTargetsFastPath(d.targetsFastPath)
+	targetsSynthesizer := lattice.NewTargetsSynthesizer(d.log, d.k8sClient, d.targetsManager, stack, d.targetsFastPath)
 	serviceSynthesizer := lattice.NewServiceSynthesizer(d.log, d.latticeServiceManager, d.dnsEndpointManager, stack)
 	listenerSynthesizer := lattice.NewListenerSynthesizer(d.log, d.listenerManager, d.targetGroupManager, stack)
 	ruleSynthesizer := lattice.NewRuleSynthesizer(d.log, d.ruleManager, d.targetGroupManager, stack)
@@ -239,6 +244,7 @@ type latticeTargetGroupStackDeployer struct {
 	targetGroupManager lattice.TargetGroupManager
 	svcExportTgBuilder gateway.SvcExportTargetGroupModelBuilder
 	svcBuilder         gateway.LatticeServiceBuilder
+	targetsFastPath    *lattice.TargetsFastPath
 }
 
 // triggered by service export
@@ -246,6 +252,7 @@ func NewTargetGroupStackDeploy(
 	log gwlog.Logger,
 	cloud pkg_aws.Cloud,
 	k8sClient client.Client,
+	targetsFastPath *lattice.TargetsFastPath,
 ) *latticeTargetGroupStackDeployer {
 	brTgBuilder := gateway.NewBackendRefTargetGroupBuilder(log, k8sClient)
 
@@ -256,6 +263,7 @@ func NewTargetGroupStackDeploy(
 		targetGroupManager: lattice.NewTargetGroupManager(log, cloud, k8sClient),
 		svcExportTgBuilder: gateway.NewSvcExportTargetGroupBuilder(log, k8sClient),
 		svcBuilder:         gateway.NewLatticeServiceBuilder(log, k8sClient, brTgBuilder, nil, nil),
+		targetsFastPath:    targetsFastPath,
 	}
 }
 
@@ -266,8 +274,9 @@ func (d *latticeTargetGroupStackDeployer) Deploy(ctx context.Context, stack core
 	tgGc.lock.RLock()
 
 	synthesizers := []ResourceSynthesizer{
-		lattice.NewTargetGroupSynthesizer(d.log, d.cloud, d.k8sclient, d.targetGroupManager, d.svcExportTgBuilder, d.svcBuilder, stack),
-		lattice.NewTargetsSynthesizer(d.log, d.k8sclient, lattice.NewTargetsManager(d.log, d.cloud), stack),
+		lattice.NewTargetGroupSynthesizer(d.log, d.cloud, d.k8sclient, d.targetGroupManager, d.svcExportTgBuilder, d.svcBuilder, stack).
+			WithTargetsFastPath(d.targetsFastPath),
+		lattice.NewTargetsSynthesizer(d.log, d.k8sclient, lattice.NewTargetsManager(d.log, d.cloud), stack, d.targetsFastPath),
 	}
 	return deploy(ctx, stack, synthesizers)
 }
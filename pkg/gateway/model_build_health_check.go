@@ -0,0 +1,254 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	corev1 "k8s.io/api/core/v1"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aws/aws-application-networking-k8s/pkg/config"
+	model "github.com/aws/aws-application-networking-k8s/pkg/model/lattice"
+	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
+)
+
+// kubelet considers any status code from 200 to 399 a successful httpGet probe
+const readinessProbeStatusMatch = "200-399"
+
+// ReadinessProbeConflictError is returned when the pods behind a service port disagree on their readiness probe
+type ReadinessProbeConflictError struct {
+	Service apitypes.NamespacedName
+	Port    int32
+	Pods    [2]string
+}
+
+func (e *ReadinessProbeConflictError) Error() string {
+	return fmt.Sprintf("pods %s and %s behind service %s port %d disagree on their readiness probe",
+		e.Pods[0], e.Pods[1], e.Service, e.Port)
+}
+
+// ReadinessProbeHealthCheckEnabled tells whether the health check of a service is derived from the readiness probe
+// of its pods. The policy health check overrides the controller default
+func ReadinessProbeHealthCheckEnabled(tgp *TGP) bool {
+	if tgp != nil && tgp.Spec.HealthCheck != nil && tgp.Spec.HealthCheck.FromReadinessProbe != nil {
+		return *tgp.Spec.HealthCheck.FromReadinessProbe
+	}
+	return config.ReadinessProbeHealthChecks
+}
+
+// buildHealthCheckConfig lays the policy health check over the one derived from readiness probes, when enabled,
+// and returns the readiness probe health check it was derived from. When the pods disagree on their readiness probe,
+// the policy health check applies alone to new target groups, and deployed ones keep their health check
+func buildHealthCheckConfig(ctx context.Context, log gwlog.Logger, c client.Client, tgp *TGP, svc *corev1.Service,
+	port int32, tgType model.TargetGroupType, policyCfg *types.HealthCheckConfig) (*types.HealthCheckConfig, *model.ReadinessProbeHealthCheck, error) {
+	if svc == nil || !ReadinessProbeHealthCheckEnabled(tgp) {
+		return policyCfg, nil, nil
+	}
+	probeCfg, err := BuildReadinessProbeHealthCheckConfig(ctx, c, svc, port, tgType)
+	if err != nil {
+		conflict := &ReadinessProbeConflictError{}
+		if !errors.As(err, &conflict) {
+			return nil, nil, err
+		}
+		log.Warnf(ctx, "Not deriving health check from readiness probes, %s", err)
+		return policyCfg, &model.ReadinessProbeHealthCheck{Port: port, Conflict: true}, nil
+	}
+	return MergeHealthCheckConfig(probeCfg, policyCfg), &model.ReadinessProbeHealthCheck{Port: port, HealthCheckConfig: probeCfg}, nil
+}
+
+// ReadinessProbeHealthCheckChanged tells whether the pods behind a service port agree on another readiness probe
+// than the one a target group health check was derived from. Pods that disagree, while rolling out, leave it as is
+func ReadinessProbeHealthCheckChanged(ctx context.Context, c client.Client, svc *corev1.Service,
+	tgType model.TargetGroupType, probe *model.ReadinessProbeHealthCheck) (bool, error) {
+	probeCfg, err := BuildReadinessProbeHealthCheckConfig(ctx, c, svc, probe.Port, tgType)
+	if err != nil {
+		conflict := &ReadinessProbeConflictError{}
+		if errors.As(err, &conflict) {
+			return false, nil
+		}
+		return false, err
+	}
+	return probe.Conflict || !reflect.DeepEqual(probeCfg, probe.HealthCheckConfig), nil
+}
+
+// BuildReadinessProbeHealthCheckConfig translates the httpGet readiness probe of the pods behind a service port into
+// a health check. Port 0 stands for the only port of the service. It returns nil when the pods define no such probe,
+// and ReadinessProbeConflictError when they do not all define the same one
+func BuildReadinessProbeHealthCheckConfig(ctx context.Context, c client.Client, svc *corev1.Service, port int32,
+	tgType model.TargetGroupType) (*types.HealthCheckConfig, error) {
+	servicePort := findServicePort(svc, port)
+	if servicePort == nil || len(svc.Spec.Selector) == 0 {
+		return nil, nil
+	}
+	targetPort := servicePort.TargetPort
+	if targetPort.Type == intstr.Int && targetPort.IntVal == 0 {
+		targetPort = intstr.FromInt32(servicePort.Port)
+	}
+
+	pods := &corev1.PodList{}
+	if err := c.List(ctx, pods, client.InNamespace(svc.Namespace), client.MatchingLabels(svc.Spec.Selector)); err != nil {
+		return nil, err
+	}
+	sort.Slice(pods.Items, func(i, j int) bool {
+		return pods.Items[i].Name < pods.Items[j].Name
+	})
+
+	var cfg *types.HealthCheckConfig
+	cfgPod := ""
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if !pod.DeletionTimestamp.IsZero() || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		podCfg := podReadinessProbeHealthCheckConfig(pod, targetPort, tgType)
+		if cfgPod == "" {
+			cfg, cfgPod = podCfg, pod.Name
+			continue
+		}
+		if !reflect.DeepEqual(cfg, podCfg) {
+			return nil, &ReadinessProbeConflictError{
+				Service: apitypes.NamespacedName{Namespace: svc.Namespace, Name: svc.Name},
+				Port:    servicePort.Port,
+				Pods:    [2]string{cfgPod, pod.Name},
+			}
+		}
+	}
+	return cfg, nil
+}
+
+func findServicePort(svc *corev1.Service, port int32) *corev1.ServicePort {
+	if port == undefinedPort {
+		if len(svc.Spec.Ports) != 1 {
+			return nil
+		}
+		return &svc.Spec.Ports[0]
+	}
+	for i, servicePort := range svc.Spec.Ports {
+		if servicePort.Port == port {
+			return &svc.Spec.Ports[i]
+		}
+	}
+	return nil
+}
+
+// podReadinessProbeHealthCheckConfig derives the health check from the readiness probe of the container serving the
+// target port. Pods with a single container may serve a numeric target port they do not declare
+func podReadinessProbeHealthCheckConfig(pod *corev1.Pod, targetPort intstr.IntOrString, tgType model.TargetGroupType) *types.HealthCheckConfig {
+	for _, container := range pod.Spec.Containers {
+		for _, containerPort := range container.Ports {
+			if (targetPort.Type == intstr.String && containerPort.Name == targetPort.StrVal) ||
+				(targetPort.Type == intstr.Int && containerPort.ContainerPort == targetPort.IntVal) {
+				return probeHealthCheckConfig(&container, containerPort.ContainerPort, tgType)
+			}
+		}
+	}
+	if targetPort.Type == intstr.Int && len(pod.Spec.Containers) == 1 {
+		return probeHealthCheckConfig(&pod.Spec.Containers[0], targetPort.IntVal, tgType)
+	}
+	return nil
+}
+
+func probeHealthCheckConfig(container *corev1.Container, trafficPort int32, tgType model.TargetGroupType) *types.HealthCheckConfig {
+	probe := container.ReadinessProbe
+	if probe == nil || probe.HTTPGet == nil {
+		return nil
+	}
+
+	probePort := probe.HTTPGet.Port.IntVal
+	if probe.HTTPGet.Port.Type == intstr.String {
+		probePort = 0
+		for _, containerPort := range container.Ports {
+			if containerPort.Name == probe.HTTPGet.Port.StrVal {
+				probePort = containerPort.ContainerPort
+			}
+		}
+		if probePort == 0 {
+			return nil
+		}
+	}
+
+	path := probe.HTTPGet.Path
+	if path == "" {
+		path = "/"
+	}
+	protocol := types.TargetGroupProtocolHttp
+	if probe.HTTPGet.Scheme == corev1.URISchemeHTTPS {
+		protocol = types.TargetGroupProtocolHttps
+	}
+
+	// probe settings out of the VPC Lattice ranges are clamped, kubelet defaults apply to unset ones
+	cfg := &types.HealthCheckConfig{
+		Enabled:                    aws.Bool(true),
+		Path:                       aws.String(path),
+		Protocol:                   protocol,
+		Matcher:                    &types.MatcherMemberHttpCode{Value: readinessProbeStatusMatch},
+		HealthCheckIntervalSeconds: probeSetting(probe.PeriodSeconds, 10, 5, 300),
+		HealthCheckTimeoutSeconds:  probeSetting(probe.TimeoutSeconds, 1, 1, 120),
+		HealthyThresholdCount:      probeSetting(probe.SuccessThreshold, 1, 2, 10),
+		UnhealthyThresholdCount:    probeSetting(probe.FailureThreshold, 3, 2, 10),
+	}
+	if probePort != trafficPort {
+		// instance targets are only reachable on the service NodePort
+		if tgType == model.TargetGroupTypeInstance {
+			return nil
+		}
+		cfg.Port = aws.Int32(probePort)
+	}
+	return cfg
+}
+
+func probeSetting(value, defaultValue, minValue, maxValue int32) *int32 {
+	if value == 0 {
+		value = defaultValue
+	}
+	return aws.Int32(min(max(value, minValue), maxValue))
+}
+
+// MergeHealthCheckConfig overlays the fields set in override on top of base
+func MergeHealthCheckConfig(base, override *types.HealthCheckConfig) *types.HealthCheckConfig {
+	if base == nil {
+		return override
+	}
+	if override == nil {
+		return base
+	}
+	merged := *base
+	if override.Enabled != nil {
+		merged.Enabled = override.Enabled
+	}
+	if override.HealthCheckIntervalSeconds != nil {
+		merged.HealthCheckIntervalSeconds = override.HealthCheckIntervalSeconds
+	}
+	if override.HealthCheckTimeoutSeconds != nil {
+		merged.HealthCheckTimeoutSeconds = override.HealthCheckTimeoutSeconds
+	}
+	if override.HealthyThresholdCount != nil {
+		merged.HealthyThresholdCount = override.HealthyThresholdCount
+	}
+	if override.UnhealthyThresholdCount != nil {
+		merged.UnhealthyThresholdCount = override.UnhealthyThresholdCount
+	}
+	if override.Matcher != nil {
+		merged.Matcher = override.Matcher
+	}
+	if override.Path != nil {
+		merged.Path = override.Path
+	}
+	if override.Port != nil {
+		merged.Port = override.Port
+	}
+	if override.Protocol != "" {
+		merged.Protocol = override.Protocol
+	}
+	if override.ProtocolVersion != "" {
+		merged.ProtocolVersion = override.ProtocolVersion
+	}
+	return &merged
+}
@@ -0,0 +1,290 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	anv1alpha1 "github.com/aws/aws-application-networking-k8s/pkg/apis/applicationnetworking/v1alpha1"
+	"github.com/aws/aws-application-networking-k8s/pkg/config"
+	model "github.com/aws/aws-application-networking-k8s/pkg/model/lattice"
+	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
+)
+
+func Test_BuildReadinessProbeHealthCheckConfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		targetPort intstr.IntOrString
+		tgType     model.TargetGroupType
+		// probes are the readiness probes of the pods of the service, one pod each
+		probes      []*corev1.Probe
+		expected    *types.HealthCheckConfig
+		expectedErr bool
+	}{
+		{
+			name:       "probe on the traffic port uses kubelet defaults",
+			targetPort: intstr.FromInt32(8080),
+			tgType:     model.TargetGroupTypeIP,
+			probes: []*corev1.Probe{
+				{ProbeHandler: corev1.ProbeHandler{HTTPGet: &corev1.HTTPGetAction{Path: "/healthz", Port: intstr.FromInt32(8080)}}},
+				{ProbeHandler: corev1.ProbeHandler{HTTPGet: &corev1.HTTPGetAction{Path: "/healthz", Port: intstr.FromInt32(8080)}}},
+			},
+			expected: &types.HealthCheckConfig{
+				Enabled:                    aws.Bool(true),
+				Path:                       aws.String("/healthz"),
+				Protocol:                   types.TargetGroupProtocolHttp,
+				Matcher:                    &types.MatcherMemberHttpCode{Value: "200-399"},
+				HealthCheckIntervalSeconds: aws.Int32(10),
+				HealthCheckTimeoutSeconds:  aws.Int32(1),
+				HealthyThresholdCount:      aws.Int32(2),
+				UnhealthyThresholdCount:    aws.Int32(3),
+			},
+		},
+		{
+			name:       "named ports, scheme and clamped settings",
+			targetPort: intstr.FromString("http"),
+			tgType:     model.TargetGroupTypeIP,
+			probes: []*corev1.Probe{{
+				ProbeHandler: corev1.ProbeHandler{
+					HTTPGet: &corev1.HTTPGetAction{Path: "/ready", Port: intstr.FromString("http"), Scheme: corev1.URISchemeHTTPS},
+				},
+				PeriodSeconds:    1,
+				TimeoutSeconds:   3,
+				SuccessThreshold: 1,
+				FailureThreshold: 20,
+			}},
+			expected: &types.HealthCheckConfig{
+				Enabled:                    aws.Bool(true),
+				Path:                       aws.String("/ready"),
+				Protocol:                   types.TargetGroupProtocolHttps,
+				Matcher:                    &types.MatcherMemberHttpCode{Value: "200-399"},
+				HealthCheckIntervalSeconds: aws.Int32(5),
+				HealthCheckTimeoutSeconds:  aws.Int32(3),
+				HealthyThresholdCount:      aws.Int32(2),
+				UnhealthyThresholdCount:    aws.Int32(10),
+			},
+		},
+		{
+			name:       "probe on another port",
+			targetPort: intstr.FromInt32(8080),
+			tgType:     model.TargetGroupTypeIP,
+			probes: []*corev1.Probe{
+				{ProbeHandler: corev1.ProbeHandler{HTTPGet: &corev1.HTTPGetAction{Port: intstr.FromString("admin")}}},
+			},
+			expected: &types.HealthCheckConfig{
+				Enabled:                    aws.Bool(true),
+				Path:                       aws.String("/"),
+				Port:                       aws.Int32(9090),
+				Protocol:                   types.TargetGroupProtocolHttp,
+				Matcher:                    &types.MatcherMemberHttpCode{Value: "200-399"},
+				HealthCheckIntervalSeconds: aws.Int32(10),
+				HealthCheckTimeoutSeconds:  aws.Int32(1),
+				HealthyThresholdCount:      aws.Int32(2),
+				UnhealthyThresholdCount:    aws.Int32(3),
+			},
+		},
+		{
+			name:       "probe on another port is unreachable on instance targets",
+			targetPort: intstr.FromInt32(8080),
+			tgType:     model.TargetGroupTypeInstance,
+			probes: []*corev1.Probe{
+				{ProbeHandler: corev1.ProbeHandler{HTTPGet: &corev1.HTTPGetAction{Path: "/", Port: intstr.FromInt32(9090)}}},
+			},
+			expected: nil,
+		},
+		{
+			name:       "no httpGet probe",
+			targetPort: intstr.FromInt32(8080),
+			tgType:     model.TargetGroupTypeIP,
+			probes: []*corev1.Probe{
+				{ProbeHandler: corev1.ProbeHandler{TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt32(8080)}}},
+			},
+			expected: nil,
+		},
+		{
+			name:       "pods disagree",
+			targetPort: intstr.FromInt32(8080),
+			tgType:     model.TargetGroupTypeIP,
+			probes: []*corev1.Probe{
+				{ProbeHandler: corev1.ProbeHandler{HTTPGet: &corev1.HTTPGetAction{Path: "/healthz", Port: intstr.FromInt32(8080)}}},
+				{ProbeHandler: corev1.ProbeHandler{HTTPGet: &corev1.HTTPGetAction{Path: "/ready", Port: intstr.FromInt32(8080)}}},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			objs := []client.Object{}
+			for i, probe := range tt.probes {
+				objs = append(objs, &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      fmt.Sprintf("pod%d", i+1),
+						Namespace: "ns1",
+						Labels:    map[string]string{"app": "app1"},
+					},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name: "app",
+								Ports: []corev1.ContainerPort{
+									{Name: "http", ContainerPort: 8080},
+									{Name: "admin", ContainerPort: 9090},
+								},
+								ReadinessProbe: probe,
+							},
+						},
+					},
+				})
+			}
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).WithObjects(objs...).Build()
+			svc := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "svc1", Namespace: "ns1"},
+				Spec: corev1.ServiceSpec{
+					Selector: map[string]string{"app": "app1"},
+					Ports:    []corev1.ServicePort{{Port: 80, TargetPort: tt.targetPort}},
+				},
+			}
+
+			cfg, err := BuildReadinessProbeHealthCheckConfig(ctx, k8sClient, svc, 80, tt.tgType)
+			if tt.expectedErr {
+				conflict := &ReadinessProbeConflictError{}
+				assert.ErrorAs(t, err, &conflict)
+				assert.Equal(t, [2]string{"pod1", "pod2"}, conflict.Pods)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func Test_BuildHealthCheckConfigFromReadinessProbe(t *testing.T) {
+	ctx := context.TODO()
+	k8sSchema := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sSchema)
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "svc1", Namespace: "ns1"},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"app": "app1"},
+			Ports:    []corev1.ServicePort{{Port: 80, TargetPort: intstr.FromInt32(8080)}},
+		},
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod1", Namespace: "ns1", Labels: map[string]string{"app": "app1"}},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{
+			Name:  "app",
+			Ports: []corev1.ContainerPort{{Name: "http", ContainerPort: 8080}},
+			ReadinessProbe: &corev1.Probe{ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{Path: "/healthz", Port: intstr.FromInt32(8080)},
+			}},
+		}}},
+	}
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).WithObjects(pod.DeepCopy()).Build()
+	policyCfg := &types.HealthCheckConfig{
+		HealthCheckIntervalSeconds: aws.Int32(30),
+	}
+	tgp := &anv1alpha1.TargetGroupPolicy{
+		Spec: anv1alpha1.TargetGroupPolicySpec{
+			HealthCheck: &anv1alpha1.HealthCheckConfig{FromReadinessProbe: aws.Bool(true)},
+		},
+	}
+
+	// disabled by default
+	cfg, _, err := buildHealthCheckConfig(ctx, gwlog.FallbackLogger, k8sClient, nil, svc, 80, model.TargetGroupTypeIP, policyCfg)
+	assert.NoError(t, err)
+	assert.Equal(t, policyCfg, cfg)
+
+	// the policy fields take precedence over the readiness probe
+	cfg, _, err = buildHealthCheckConfig(ctx, gwlog.FallbackLogger, k8sClient, tgp, svc, 80, model.TargetGroupTypeIP, policyCfg)
+	assert.NoError(t, err)
+	assert.Equal(t, aws.String("/healthz"), cfg.Path)
+	assert.Equal(t, aws.Int32(30), cfg.HealthCheckIntervalSeconds)
+	assert.Equal(t, aws.Int32(3), cfg.UnhealthyThresholdCount)
+
+	// the policy disables the controller default
+	config.ReadinessProbeHealthChecks = true
+	defer func() { config.ReadinessProbeHealthChecks = false }()
+	tgp.Spec.HealthCheck.FromReadinessProbe = aws.Bool(false)
+	cfg, _, err = buildHealthCheckConfig(ctx, gwlog.FallbackLogger, k8sClient, tgp, svc, 80, model.TargetGroupTypeIP, policyCfg)
+	assert.NoError(t, err)
+	assert.Equal(t, policyCfg, cfg)
+
+	cfg, _, err = buildHealthCheckConfig(ctx, gwlog.FallbackLogger, k8sClient, nil, svc, 80, model.TargetGroupTypeIP, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, aws.String("/healthz"), cfg.Path)
+}
+
+func Test_ReadinessProbeHealthCheckChanged(t *testing.T) {
+	ctx := context.TODO()
+	k8sSchema := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sSchema)
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "svc1", Namespace: "ns1"},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"app": "app1"},
+			Ports:    []corev1.ServicePort{{Port: 80, TargetPort: intstr.FromInt32(8080)}},
+		},
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod1", Namespace: "ns1", Labels: map[string]string{"app": "app1"}},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{
+			Name:  "app",
+			Ports: []corev1.ContainerPort{{Name: "http", ContainerPort: 8080}},
+			ReadinessProbe: &corev1.Probe{ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{Path: "/healthz", Port: intstr.FromInt32(8080)},
+			}},
+		}}},
+	}
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).WithObjects(pod.DeepCopy()).Build()
+
+	tgp := &anv1alpha1.TargetGroupPolicy{
+		Spec: anv1alpha1.TargetGroupPolicySpec{
+			HealthCheck: &anv1alpha1.HealthCheckConfig{FromReadinessProbe: aws.Bool(true)},
+		},
+	}
+	_, probe, err := buildHealthCheckConfig(ctx, gwlog.FallbackLogger, k8sClient, tgp, svc, 80, model.TargetGroupTypeIP, nil)
+	assert.NoError(t, err)
+	assert.False(t, probe.Conflict)
+
+	changed, err := ReadinessProbeHealthCheckChanged(ctx, k8sClient, svc, model.TargetGroupTypeIP, probe)
+	assert.NoError(t, err)
+	assert.False(t, changed)
+
+	// pods rolling out another probe disagree, the health check is left as is
+	pod2 := pod.DeepCopy()
+	pod2.Name = "pod2"
+	pod2.Spec.Containers[0].ReadinessProbe.HTTPGet.Path = "/ready"
+	assert.NoError(t, k8sClient.Create(ctx, pod2))
+	changed, err = ReadinessProbeHealthCheckChanged(ctx, k8sClient, svc, model.TargetGroupTypeIP, probe)
+	assert.NoError(t, err)
+	assert.False(t, changed)
+
+	cfg, conflictProbe, err := buildHealthCheckConfig(ctx, gwlog.FallbackLogger, k8sClient, tgp, svc, 80, model.TargetGroupTypeIP, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, cfg)
+	assert.True(t, conflictProbe.Conflict)
+
+	// once they agree on the new probe, the health check changes
+	assert.NoError(t, k8sClient.Delete(ctx, pod))
+	changed, err = ReadinessProbeHealthCheckChanged(ctx, k8sClient, svc, model.TargetGroupTypeIP, probe)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	changed, err = ReadinessProbeHealthCheckChanged(ctx, k8sClient, svc, model.TargetGroupTypeIP, conflictProbe)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+}
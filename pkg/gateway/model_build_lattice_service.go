@@ -2,8 +2,10 @@ package gateway
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -18,6 +20,7 @@ import (
 	"github.com/aws/aws-application-networking-k8s/pkg/k8s"
 	"github.com/aws/aws-application-networking-k8s/pkg/model/core"
 	model "github.com/aws/aws-application-networking-k8s/pkg/model/lattice"
+	"github.com/aws/aws-application-networking-k8s/pkg/utils"
 )
 
 //go:generate mockgen -destination model_build_lattice_service_mock.go -package gateway github.com/aws/aws-application-networking-k8s/pkg/gateway LatticeServiceBuilder
@@ -91,9 +94,30 @@ func (t *latticeServiceModelBuildTask) buildModel(ctx context.Context) error {
 		return nil
 	}
 
-	err = t.buildListeners(ctx, modelSvc.ID())
+	hostnameSvcs, err := t.buildHostnameServices(ctx, modelSvc)
 	if err != nil {
-		return fmt.Errorf("failed to build listener due to %w", err)
+		return err
+	}
+
+	ruleParts, err := t.ruleParts(ctx)
+	if err != nil {
+		return err
+	}
+	partSvcs, err := t.buildRulePartServices(ctx, modelSvc, len(ruleParts))
+	if err != nil {
+		return err
+	}
+
+	// every service gets the same listeners and rules, sharing target groups. Only the default action of
+	// TLS_PASSTHROUGH listeners differs, following the rules serving the service hostname, and the services
+	// of a split route each get the rules of their part
+	svcRuleParts := make(map[string]int)
+	for _, svc := range append(append([]*model.Service{modelSvc}, hostnameSvcs...), partSvcs...) {
+		svcRuleParts[svc.ID()] = svc.Spec.RulePart
+		err = t.buildListenersForHostname(ctx, svc.ID(), svc.Spec.CustomerDomainName)
+		if err != nil {
+			return fmt.Errorf("failed to build listener due to %w", err)
+		}
 	}
 
 	var modelListeners []*model.Listener
@@ -110,7 +134,11 @@ func (t *latticeServiceModelBuildTask) buildModel(ctx context.Context) error {
 
 		// building rules will also build target groups and targets as needed
 		// even on delete we try to build everything we may then need to remove
-		err = t.buildRules(ctx, modelListener.ID())
+		if ruleParts != nil {
+			err = t.buildRulesForPart(ctx, modelListener.ID(), ruleParts[svcRuleParts[modelListener.Spec.StackServiceId]])
+		} else {
+			err = t.buildRules(ctx, modelListener.ID())
+		}
 		if err != nil {
 			return fmt.Errorf("failed to build rules due to %w", err)
 		}
@@ -246,8 +274,159 @@ func (t *latticeServiceModelBuildTask) buildLatticeService(ctx context.Context)
 	return svc, nil
 }
 
+// buildHostnameServices builds a service for each route hostname after the first, when the route has one
+// service per hostname. Services built for hostnames the route no longer serves are added as deleted.
+func (t *latticeServiceModelBuildTask) buildHostnameServices(ctx context.Context, primary *model.Service) ([]*model.Service, error) {
+	hostnames := t.route.Spec().Hostnames()
+	served := make(map[string]struct{})
+	if len(hostnames) > 0 {
+		served[string(hostnames[0])] = struct{}{}
+	}
+
+	var svcs []*model.Service
+	if k8s.IsServicePerHostnameEnabled(t.route) {
+		for _, hostname := range hostnames {
+			if _, ok := served[string(hostname)]; ok {
+				continue
+			}
+			served[string(hostname)] = struct{}{}
+
+			certArn, err := t.getACMCertArnForHostname(ctx, string(hostname))
+			if err != nil {
+				return nil, err
+			}
+			spec := primary.Spec
+			spec.CustomerDomainName = string(hostname)
+			spec.CustomerCertARN = certArn
+			spec.AdditionalHostname = true
+			svc, err := model.NewLatticeService(t.stack, spec)
+			if err != nil {
+				return nil, err
+			}
+			svc.IsDeleted = primary.IsDeleted
+			t.log.Debugf(ctx, "Added service %s for hostname %s to the stack", svc.LatticeServiceName(), hostname)
+			svcs = append(svcs, svc)
+		}
+	}
+
+	for _, hostname := range t.previousServiceHostnames(ctx) {
+		if _, ok := served[hostname]; ok {
+			continue
+		}
+		spec := primary.Spec
+		spec.CustomerDomainName = hostname
+		spec.CustomerCertARN = ""
+		spec.AdditionalHostname = true
+		svc, err := model.NewLatticeService(t.stack, spec)
+		if err != nil {
+			return nil, err
+		}
+		svc.IsDeleted = true
+		t.log.Infof(ctx, "Deleting service %s of hostname %s no longer served by route %s-%s",
+			svc.LatticeServiceName(), hostname, t.route.Name(), t.route.Namespace())
+	}
+	return svcs, nil
+}
+
+// buildRulePartServices builds a service for each rule part after the first, when the route rules are split
+// across services. Services built for parts the route no longer needs are added as deleted.
+func (t *latticeServiceModelBuildTask) buildRulePartServices(ctx context.Context, primary *model.Service, partCount int) ([]*model.Service, error) {
+	var svcs []*model.Service
+	for part := 1; part < partCount; part++ {
+		spec := primary.Spec
+		spec.RulePart = part
+		if primary.Spec.CustomerDomainName != "" {
+			spec.CustomerDomainName = utils.RulePartHostname(primary.Spec.CustomerDomainName, part)
+			certArn, err := t.getACMCertArnForHostname(ctx, spec.CustomerDomainName)
+			if err != nil {
+				return nil, err
+			}
+			spec.CustomerCertARN = certArn
+		}
+		svc, err := model.NewLatticeService(t.stack, spec)
+		if err != nil {
+			return nil, err
+		}
+		svc.IsDeleted = primary.IsDeleted
+		t.log.Debugf(ctx, "Added service %s for rule part %d to the stack", svc.LatticeServiceName(), part)
+		svcs = append(svcs, svc)
+	}
+
+	for _, part := range t.previousRuleParts(ctx) {
+		if part < partCount {
+			continue
+		}
+		spec := primary.Spec
+		spec.RulePart = part
+		spec.CustomerCertARN = ""
+		if primary.Spec.CustomerDomainName != "" {
+			spec.CustomerDomainName = utils.RulePartHostname(primary.Spec.CustomerDomainName, part)
+		}
+		svc, err := model.NewLatticeService(t.stack, spec)
+		if err != nil {
+			return nil, err
+		}
+		svc.IsDeleted = true
+		t.log.Infof(ctx, "Deleting service %s of rule part %d no longer needed by route %s-%s",
+			svc.LatticeServiceName(), part, t.route.Name(), t.route.Namespace())
+	}
+	return svcs, nil
+}
+
+// previousRuleParts returns the rule parts of the services recorded in the route status
+func (t *latticeServiceModelBuildTask) previousRuleParts(ctx context.Context) []int {
+	value := t.route.K8sObject().GetAnnotations()[k8s.LatticeServicePartsAnnotation]
+	if value == "" {
+		return nil
+	}
+	var svcs map[int]model.ServiceStatus
+	if err := json.Unmarshal([]byte(value), &svcs); err != nil {
+		t.log.Infof(ctx, "Ignoring invalid %s annotation on route %s-%s: %s",
+			k8s.LatticeServicePartsAnnotation, t.route.Name(), t.route.Namespace(), err)
+		return nil
+	}
+	parts := make([]int, 0, len(svcs))
+	for part := range svcs {
+		if part > 0 {
+			parts = append(parts, part)
+		}
+	}
+	sort.Ints(parts)
+	return parts
+}
+
+// previousServiceHostnames returns the hostnames of the services recorded in the route status
+func (t *latticeServiceModelBuildTask) previousServiceHostnames(ctx context.Context) []string {
+	value := t.route.K8sObject().GetAnnotations()[k8s.LatticeServicesAnnotation]
+	if value == "" {
+		return nil
+	}
+	var svcs map[string]model.ServiceStatus
+	if err := json.Unmarshal([]byte(value), &svcs); err != nil {
+		t.log.Infof(ctx, "Ignoring invalid %s annotation on route %s-%s: %s",
+			k8s.LatticeServicesAnnotation, t.route.Name(), t.route.Namespace(), err)
+		return nil
+	}
+	hostnames := make([]string, 0, len(svcs))
+	for hostname := range svcs {
+		hostnames = append(hostnames, hostname)
+	}
+	sort.Strings(hostnames)
+	return hostnames
+}
+
 // returns empty string if not found
 func (t *latticeServiceModelBuildTask) getACMCertArn(ctx context.Context) (string, error) {
+	hostname := ""
+	if len(t.route.Spec().Hostnames()) > 0 {
+		// the first hostname is the custom domain of the route's service
+		hostname = string(t.route.Spec().Hostnames()[0])
+	}
+	return t.getACMCertArnForHostname(ctx, hostname)
+}
+
+// returns empty string if not found
+func (t *latticeServiceModelBuildTask) getACMCertArnForHostname(ctx context.Context, hostname string) (string, error) {
 	// when a service is associate to multiple service network(s), all listener config MUST be same
 	// so here we are only using the 1st gateway
 	gw, err := t.findGateway(ctx)
@@ -284,9 +463,7 @@ func (t *latticeServiceModelBuildTask) getACMCertArn(ctx context.Context) (strin
 
 	// Automatic certificate discovery fallback
 	if hasTLSTerminateListener && t.certDiscovery != nil &&
-		len(t.route.Spec().Hostnames()) > 0 && t.route.DeletionTimestamp().IsZero() {
-		// VPC Lattice supports one custom domain per service, so only the first hostname is used
-		hostname := string(t.route.Spec().Hostnames()[0])
+		hostname != "" && t.route.DeletionTimestamp().IsZero() {
 		t.log.Debugf(ctx, "Attempting automatic certificate discovery for hostname %s", hostname)
 		certArn, err := t.certDiscovery.Discover(ctx, hostname)
 		if err != nil {
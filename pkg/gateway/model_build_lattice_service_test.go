@@ -2413,3 +2413,293 @@ func Test_getACMCertArn(t *testing.T) {
 		})
 	}
 }
+
+type hostnameCertDiscovery map[string]string
+
+func (h hostnameCertDiscovery) Discover(_ context.Context, hostname string) (string, error) {
+	return h[hostname], nil
+}
+
+func Test_buildHostnameServices(t *testing.T) {
+	tlsSectionName := gwv1.SectionName("tls")
+	tlsModeTerminate := gwv1.TLSModeTerminate
+	namespace := gwv1.Namespace("default")
+
+	gwClass := gwv1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "gwClass"},
+		Spec:       gwv1.GatewayClassSpec{ControllerName: config.LatticeGatewayControllerName},
+	}
+	gw := gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: "default"},
+		Spec: gwv1.GatewaySpec{
+			GatewayClassName: gwv1.ObjectName(gwClass.Name),
+			Listeners: []gwv1.Listener{
+				{
+					Name:     "tls",
+					Port:     443,
+					Protocol: "HTTPS",
+					TLS:      &gwv1.ListenerTLSConfig{Mode: &tlsModeTerminate},
+				},
+			},
+		},
+	}
+	certDiscovery := hostnameCertDiscovery{
+		"legacy.example.com": "legacy-cert",
+		"new.example.com":    "new-cert",
+	}
+
+	makeRoute := func(annotations map[string]string) core.Route {
+		route := core.NewHTTPRoute(gwv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "route1",
+				Namespace:   "default",
+				Annotations: annotations,
+			},
+			Spec: gwv1.HTTPRouteSpec{
+				CommonRouteSpec: gwv1.CommonRouteSpec{
+					ParentRefs: []gwv1.ParentReference{
+						{Name: "gw", Namespace: &namespace, SectionName: &tlsSectionName},
+					},
+				},
+				Hostnames: []gwv1.Hostname{"legacy.example.com", "new.example.com", "legacy.example.com"},
+			},
+		})
+		route.Status().SetParents([]gwv1.RouteParentStatus{
+			{
+				ParentRef: gwv1.ParentReference{Name: "gw", Namespace: &namespace, SectionName: &tlsSectionName},
+				Conditions: []metav1.Condition{
+					{Type: string(gwv1.RouteConditionAccepted), Status: metav1.ConditionTrue},
+				},
+			},
+		})
+		return route
+	}
+
+	tests := []struct {
+		name            string
+		annotations     map[string]string
+		wantHostnames   []string
+		wantDeletedSvcs []string
+	}{
+		{
+			name:        "disabled",
+			annotations: nil,
+		},
+		{
+			name: "one service per additional hostname",
+			annotations: map[string]string{
+				k8s.ServicePerHostnameAnnotation: "true",
+			},
+			wantHostnames: []string{"new.example.com"},
+		},
+		{
+			name: "removed hostname is deleted",
+			annotations: map[string]string{
+				k8s.ServicePerHostnameAnnotation: "true",
+				k8s.LatticeServicesAnnotation:    `{"legacy.example.com":{"arn":"arn1"},"old.example.com":{"arn":"arn2"}}`,
+			},
+			wantHostnames:   []string{"new.example.com"},
+			wantDeletedSvcs: []string{"old.example.com"},
+		},
+		{
+			name: "disabled after being enabled",
+			annotations: map[string]string{
+				k8s.ServicePerHostnameAnnotation: "false",
+				k8s.LatticeServicesAnnotation:    `{"legacy.example.com":{"arn":"arn1"},"new.example.com":{"arn":"arn2"}}`,
+			},
+			wantDeletedSvcs: []string{"new.example.com"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			gwv1.Install(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			assert.NoError(t, k8sClient.Create(ctx, gwClass.DeepCopy()))
+			assert.NoError(t, k8sClient.Create(ctx, gw.DeepCopy()))
+
+			route := makeRoute(tt.annotations)
+			stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(route.K8sObject())))
+			task := &latticeServiceModelBuildTask{
+				log:           gwlog.FallbackLogger,
+				route:         route,
+				stack:         stack,
+				client:        k8sClient,
+				certDiscovery: certDiscovery,
+			}
+
+			primary, err := model.NewLatticeService(stack, model.ServiceSpec{
+				ServiceTagFields: model.ServiceTagFields{
+					RouteName:      "route1",
+					RouteNamespace: "default",
+					RouteType:      core.HttpRouteType,
+				},
+				CustomerDomainName: "legacy.example.com",
+				CustomerCertARN:    "legacy-cert",
+			})
+			assert.NoError(t, err)
+
+			svcs, err := task.buildHostnameServices(ctx, primary)
+			assert.NoError(t, err)
+
+			var hostnames []string
+			for _, svc := range svcs {
+				hostnames = append(hostnames, svc.Spec.CustomerDomainName)
+				assert.True(t, svc.Spec.AdditionalHostname)
+				assert.False(t, svc.IsDeleted)
+				assert.Equal(t, certDiscovery[svc.Spec.CustomerDomainName], svc.Spec.CustomerCertARN)
+				assert.NotEqual(t, primary.LatticeServiceName(), svc.LatticeServiceName())
+			}
+			assert.Equal(t, tt.wantHostnames, hostnames)
+
+			var stackSvcs []*model.Service
+			assert.NoError(t, stack.ListResources(&stackSvcs))
+			var deleted []string
+			for _, svc := range stackSvcs {
+				if svc.IsDeleted {
+					assert.True(t, svc.Spec.AdditionalHostname)
+					deleted = append(deleted, svc.Spec.CustomerDomainName)
+				}
+			}
+			assert.Equal(t, tt.wantDeletedSvcs, deleted)
+			assert.Len(t, stackSvcs, 1+len(tt.wantHostnames)+len(tt.wantDeletedSvcs))
+		})
+	}
+}
+
+func Test_buildRulePartServices(t *testing.T) {
+	tlsSectionName := gwv1.SectionName("tls")
+	tlsModeTerminate := gwv1.TLSModeTerminate
+	namespace := gwv1.Namespace("default")
+
+	gwClass := gwv1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "gwClass"},
+		Spec:       gwv1.GatewayClassSpec{ControllerName: config.LatticeGatewayControllerName},
+	}
+	gw := gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: "default"},
+		Spec: gwv1.GatewaySpec{
+			GatewayClassName: gwv1.ObjectName(gwClass.Name),
+			Listeners: []gwv1.Listener{
+				{
+					Name:     "tls",
+					Port:     443,
+					Protocol: "HTTPS",
+					TLS:      &gwv1.ListenerTLSConfig{Mode: &tlsModeTerminate},
+				},
+			},
+		},
+	}
+	certDiscovery := hostnameCertDiscovery{
+		"part1.api.example.com": "part1-cert",
+		"part2.api.example.com": "part2-cert",
+	}
+
+	tests := []struct {
+		name          string
+		annotations   map[string]string
+		partCount     int
+		wantParts     []int
+		wantDeleted   []int
+		wantHostnames []string
+	}{
+		{
+			name:      "not split",
+			partCount: 0,
+		},
+		{
+			name:          "one service per part after the first",
+			partCount:     3,
+			wantParts:     []int{1, 2},
+			wantHostnames: []string{"part1.api.example.com", "part2.api.example.com"},
+		},
+		{
+			name: "parts no longer needed are deleted",
+			annotations: map[string]string{
+				k8s.LatticeServicePartsAnnotation: `{"1":{"arn":"arn1"},"2":{"arn":"arn2"},"3":{"arn":"arn3"}}`,
+			},
+			partCount:     2,
+			wantParts:     []int{1},
+			wantDeleted:   []int{2, 3},
+			wantHostnames: []string{"part1.api.example.com"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			gwv1.Install(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			assert.NoError(t, k8sClient.Create(ctx, gwClass.DeepCopy()))
+			assert.NoError(t, k8sClient.Create(ctx, gw.DeepCopy()))
+
+			route := core.NewHTTPRoute(gwv1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "route1",
+					Namespace:   "default",
+					Annotations: tt.annotations,
+				},
+				Spec: gwv1.HTTPRouteSpec{
+					CommonRouteSpec: gwv1.CommonRouteSpec{
+						ParentRefs: []gwv1.ParentReference{
+							{Name: "gw", Namespace: &namespace, SectionName: &tlsSectionName},
+						},
+					},
+					Hostnames: []gwv1.Hostname{"api.example.com"},
+				},
+			})
+			stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(route.K8sObject())))
+			task := &latticeServiceModelBuildTask{
+				log:           gwlog.FallbackLogger,
+				route:         route,
+				stack:         stack,
+				client:        k8sClient,
+				certDiscovery: certDiscovery,
+			}
+
+			primary, err := model.NewLatticeService(stack, model.ServiceSpec{
+				ServiceTagFields: model.ServiceTagFields{
+					RouteName:      "route1",
+					RouteNamespace: "default",
+					RouteType:      core.HttpRouteType,
+				},
+				CustomerDomainName: "api.example.com",
+				CustomerCertARN:    "api-cert",
+			})
+			assert.NoError(t, err)
+
+			svcs, err := task.buildRulePartServices(ctx, primary, tt.partCount)
+			assert.NoError(t, err)
+
+			var parts []int
+			var hostnames []string
+			for _, svc := range svcs {
+				parts = append(parts, svc.Spec.RulePart)
+				hostnames = append(hostnames, svc.Spec.CustomerDomainName)
+				assert.False(t, svc.IsDeleted)
+				assert.Equal(t, certDiscovery[svc.Spec.CustomerDomainName], svc.Spec.CustomerCertARN)
+				assert.NotEqual(t, primary.LatticeServiceName(), svc.LatticeServiceName())
+			}
+			assert.Equal(t, tt.wantParts, parts)
+			assert.Equal(t, tt.wantHostnames, hostnames)
+
+			var stackSvcs []*model.Service
+			assert.NoError(t, stack.ListResources(&stackSvcs))
+			var deleted []int
+			for _, svc := range stackSvcs {
+				if svc.IsDeleted {
+					deleted = append(deleted, svc.Spec.RulePart)
+				}
+			}
+			assert.Equal(t, tt.wantDeleted, deleted)
+			assert.Len(t, stackSvcs, 1+len(tt.wantParts)+len(tt.wantDeleted))
+		})
+	}
+}
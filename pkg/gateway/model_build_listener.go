@@ -3,6 +3,8 @@ package gateway
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
@@ -54,6 +56,16 @@ func (t *latticeServiceModelBuildTask) findGateway(ctx context.Context) (*gwv1.G
 }
 
 func (t *latticeServiceModelBuildTask) buildListeners(ctx context.Context, stackSvcId string) error {
+	hostname := ""
+	if len(t.route.Spec().Hostnames()) > 0 {
+		hostname = string(t.route.Spec().Hostnames()[0])
+	}
+	return t.buildListenersForHostname(ctx, stackSvcId, hostname)
+}
+
+// buildListenersForHostname builds the listeners of the service serving the given route hostname.
+// The hostname selects the rules of TLS_PASSTHROUGH listeners, other listeners are the same for every hostname
+func (t *latticeServiceModelBuildTask) buildListenersForHostname(ctx context.Context, stackSvcId string, hostname string) error {
 	if !t.route.DeletionTimestamp().IsZero() {
 		t.log.Debugf(ctx, "Route %s-%s is deleted, skipping listener build", t.route.Name(), t.route.Namespace())
 		return nil
@@ -106,7 +118,7 @@ func (t *latticeServiceModelBuildTask) buildListeners(ctx context.Context, stack
 	}
 
 	for _, listenerConfig := range listenersToCreate {
-		defaultAction, err := t.getListenerDefaultAction(ctx, listenerConfig.Protocol)
+		defaultAction, err := t.getListenerDefaultAction(ctx, listenerConfig.Protocol, hostname)
 		if err != nil {
 			return err
 		}
@@ -134,23 +146,26 @@ func (t *latticeServiceModelBuildTask) buildListeners(ctx context.Context, stack
 	return nil
 }
 
-func (t *latticeServiceModelBuildTask) getListenerDefaultAction(ctx context.Context, modelListenerProtocol string) (
+func (t *latticeServiceModelBuildTask) getListenerDefaultAction(ctx context.Context, modelListenerProtocol string, hostname string) (
 	*model.DefaultAction, error,
 ) {
 	if modelListenerProtocol != string(types.ListenerProtocolTlsPassthrough) {
-		return &model.DefaultAction{
-			FixedResponseStatusCode: aws.Int64(model.DefaultActionFixedResponseStatusCode),
-		}, nil
+		return t.getHttpListenerDefaultAction(ctx)
 	}
 
-	if len(t.route.Spec().Rules()) != 1 {
-		return nil, fmt.Errorf("only support exactly 1 rule for TLSRoute %s/%s, but got %d", t.route.Namespace(), t.route.Name(), len(t.route.Spec().Rules()))
-	}
-	modelRouteRule := t.route.Spec().Rules()[0]
-	ruleTgList, err := t.getTargetGroupsForRuleAction(ctx, modelRouteRule)
+	// a TLS_PASSTHROUGH listener has no rules, so the rules serving the hostname share its default action
+	hostnameRules, err := t.tlsRulesForHostname(hostname)
 	if err != nil {
 		return nil, err
 	}
+	var ruleTgList []*model.RuleTargetGroup
+	for _, rule := range hostnameRules {
+		tgs, err := t.getTargetGroupsForRuleAction(ctx, rule)
+		if err != nil {
+			return nil, err
+		}
+		ruleTgList = append(ruleTgList, tgs...)
+	}
 
 	return &model.DefaultAction{
 		Forward: &model.RuleAction{
@@ -159,6 +174,108 @@ func (t *latticeServiceModelBuildTask) getListenerDefaultAction(ctx context.Cont
 	}, nil
 }
 
+// tlsRulesForHostname returns the TLSRoute rules serving the given hostname. A rule with the rule-{index}-hostnames
+// annotation serves the listed hostnames, rules without it serve the hostnames not listed by any rule
+func (t *latticeServiceModelBuildTask) tlsRulesForHostname(hostname string) ([]core.RouteRule, error) {
+	routeHostnames := make(map[string]struct{})
+	for _, h := range t.route.Spec().Hostnames() {
+		routeHostnames[string(h)] = struct{}{}
+	}
+
+	var selected, unannotated []core.RouteRule
+	claimed := false
+	for i, rule := range t.route.Spec().Rules() {
+		value, ok := t.route.K8sObject().GetAnnotations()[fmt.Sprintf(k8s.RuleHostnamesAnnotationFormat, i)]
+		if !ok {
+			unannotated = append(unannotated, rule)
+			continue
+		}
+		for _, h := range strings.Split(value, ",") {
+			h = strings.TrimSpace(h)
+			if _, ok := routeHostnames[h]; !ok {
+				return nil, fmt.Errorf("rule %d of TLSRoute %s/%s serves hostname %q, which is not a hostname of the route",
+					i, t.route.Namespace(), t.route.Name(), h)
+			}
+			if h == hostname {
+				claimed = true
+				selected = append(selected, rule)
+			}
+		}
+	}
+	if !claimed {
+		selected = unannotated
+	}
+	if len(selected) == 0 {
+		return nil, fmt.Errorf("no rule of TLSRoute %s/%s serves hostname %s",
+			t.route.Namespace(), t.route.Name(), hostname)
+	}
+	return selected, nil
+}
+
+// getHttpListenerDefaultAction returns the action for requests matching no rule of an HTTP or HTTPS listener.
+// The route's default rule is used when set, otherwise a fixed response with the annotated status code or 404
+func (t *latticeServiceModelBuildTask) getHttpListenerDefaultAction(ctx context.Context) (*model.DefaultAction, error) {
+	defaultRuleIndex, err := t.defaultRuleIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	if defaultRuleIndex >= 0 {
+		defaultRule := t.route.Spec().Rules()[defaultRuleIndex]
+		filters, err := t.resolveRuleFilters(ctx, defaultRule)
+		if err != nil {
+			return nil, err
+		}
+		if filters.fixedResponseStatusCode != nil {
+			return &model.DefaultAction{
+				FixedResponseStatusCode: aws.Int64(int64(*filters.fixedResponseStatusCode)),
+			}, nil
+		}
+
+		ruleTgList, err := t.getTargetGroupsForRuleAction(ctx, defaultRule)
+		if err != nil {
+			return nil, err
+		}
+		return &model.DefaultAction{
+			Forward: &model.RuleAction{
+				TargetGroups: ruleTgList,
+			},
+		}, nil
+	}
+
+	statusCode := int64(model.DefaultActionFixedResponseStatusCode)
+	if statusCodeStr, ok := t.route.K8sObject().GetAnnotations()[k8s.DefaultActionStatusCodeAnnotation]; ok {
+		statusCode, err = strconv.ParseInt(statusCodeStr, 10, 64)
+		if err != nil || (statusCode != 404 && statusCode != 500) {
+			return nil, fmt.Errorf("invalid %s annotation %q on route %s/%s, VPC Lattice only supports 404 and 500",
+				k8s.DefaultActionStatusCodeAnnotation, statusCodeStr, t.route.Namespace(), t.route.Name())
+		}
+	}
+	return &model.DefaultAction{
+		FixedResponseStatusCode: aws.Int64(statusCode),
+	}, nil
+}
+
+// defaultRuleIndex returns the index of the route rule used as listener default action, or -1 when there is none.
+// The rule must not have matches, since it answers every request that matches no other rule
+func (t *latticeServiceModelBuildTask) defaultRuleIndex() (int, error) {
+	indexStr, ok := t.route.K8sObject().GetAnnotations()[k8s.DefaultRuleAnnotation]
+	if !ok {
+		return -1, nil
+	}
+	rules := t.route.Spec().Rules()
+	index, err := strconv.Atoi(indexStr)
+	if err != nil || index < 0 || index >= len(rules) {
+		return -1, fmt.Errorf("invalid %s annotation %q on route %s/%s, must be the index of a rule",
+			k8s.DefaultRuleAnnotation, indexStr, t.route.Namespace(), t.route.Name())
+	}
+	if len(rules[index].Matches()) > 0 {
+		return -1, fmt.Errorf("default rule %d of route %s/%s must not have matches",
+			index, t.route.Namespace(), t.route.Name())
+	}
+	return index, nil
+}
+
 // matchedListeners returns the Gateway listeners that a parentRef matches,
 // using the standard Gateway API matching logic: port filter, sectionName filter,
 // and IsRouteAllowedByListener (route kind, hostname intersection, allowedRoutes policy).
@@ -322,10 +322,11 @@ func Test_ListenerModelBuild(t *testing.T) {
 			},
 		},
 		{
-			name:                    "TLSRoute has more than one rule, build TLS_PASSTHROUGH listener failed",
-			wantErrIsNil:            false,
+			name:                    "TLSRoute has more than one rule, TLS_PASSTHROUGH listener forwards to the backendRefs of all rules",
+			wantErrIsNil:            true,
 			k8sGetGatewayCall:       true,
 			k8sGetServiceImportCall: false,
+			brTgBuilderBuildCall:    true,
 			gw: vpcLatticeGatewayWithListeners(
 				gwv1.Listener{
 					Port:     443,
@@ -402,16 +403,11 @@ func Test_ListenerModelBuild(t *testing.T) {
 							TargetGroups: []*model.RuleTargetGroup{
 								{
 									StackTargetGroupId: "k8s-service1",
-									Weight:             10,
+									Weight:             1,
 								},
 								{
-									SvcImportTG: &model.SvcImportTargetGroup{
-										K8SServiceNamespace: "default",
-										K8SServiceName:      "k8s-service2",
-										VpcId:               "vpc-123",
-										K8SClusterName:      "eks-cluster",
-									},
-									Weight: 90,
+									StackTargetGroupId: "k8s-service2",
+									Weight:             1,
 								},
 							},
 						},
@@ -1668,3 +1664,258 @@ func Test_ListenerModelBuild_TLSPassthrough_ExternalTargetGroup(t *testing.T) {
 		})
 	}
 }
+
+func Test_getHttpListenerDefaultAction(t *testing.T) {
+	var serviceKind gwv1.Kind = "Service"
+	backendRefs := []gwv1.HTTPBackendRef{
+		{
+			BackendRef: gwv1.BackendRef{
+				BackendObjectReference: gwv1.BackendObjectReference{
+					Name: "targetgroup1",
+					Kind: &serviceKind,
+				},
+			},
+		},
+	}
+	pathPrefix := gwv1.PathMatchPathPrefix
+	matchedRule := gwv1.HTTPRouteRule{
+		Matches: []gwv1.HTTPRouteMatch{
+			{
+				Path: &gwv1.HTTPPathMatch{
+					Type:  &pathPrefix,
+					Value: aws.String("/api"),
+				},
+			},
+		},
+		BackendRefs: backendRefs,
+	}
+	fixedResponseRule := gwv1.HTTPRouteRule{
+		Filters: []gwv1.HTTPRouteFilter{
+			{
+				Type: gwv1.HTTPRouteFilterExtensionRef,
+				ExtensionRef: &gwv1.LocalObjectReference{
+					Group: anv1alpha1.GroupName,
+					Kind:  anv1alpha1.FixedResponseKind,
+					Name:  "unavailable",
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name               string
+		annotations        map[string]string
+		rules              []gwv1.HTTPRouteRule
+		expectedStatusCode *int64
+		expectedForward    bool
+		wantErr            bool
+	}{
+		{
+			name:               "no annotations returns 404",
+			rules:              []gwv1.HTTPRouteRule{matchedRule},
+			expectedStatusCode: aws.Int64(404),
+		},
+		{
+			name:               "status code annotation",
+			annotations:        map[string]string{k8s.DefaultActionStatusCodeAnnotation: "500"},
+			rules:              []gwv1.HTTPRouteRule{matchedRule},
+			expectedStatusCode: aws.Int64(500),
+		},
+		{
+			name:        "unsupported status code annotation",
+			annotations: map[string]string{k8s.DefaultActionStatusCodeAnnotation: "503"},
+			rules:       []gwv1.HTTPRouteRule{matchedRule},
+			wantErr:     true,
+		},
+		{
+			name:            "default rule forwards to its backendRefs",
+			annotations:     map[string]string{k8s.DefaultRuleAnnotation: "1"},
+			rules:           []gwv1.HTTPRouteRule{matchedRule, {BackendRefs: backendRefs}},
+			expectedForward: true,
+		},
+		{
+			name: "default rule wins over status code annotation",
+			annotations: map[string]string{
+				k8s.DefaultRuleAnnotation:             "1",
+				k8s.DefaultActionStatusCodeAnnotation: "500",
+			},
+			rules:           []gwv1.HTTPRouteRule{matchedRule, {BackendRefs: backendRefs}},
+			expectedForward: true,
+		},
+		{
+			name:               "default rule with fixed response",
+			annotations:        map[string]string{k8s.DefaultRuleAnnotation: "1"},
+			rules:              []gwv1.HTTPRouteRule{matchedRule, fixedResponseRule},
+			expectedStatusCode: aws.Int64(500),
+		},
+		{
+			name:        "default rule with matches",
+			annotations: map[string]string{k8s.DefaultRuleAnnotation: "0"},
+			rules:       []gwv1.HTTPRouteRule{matchedRule},
+			wantErr:     true,
+		},
+		{
+			name:        "default rule out of range",
+			annotations: map[string]string{k8s.DefaultRuleAnnotation: "2"},
+			rules:       []gwv1.HTTPRouteRule{matchedRule, {BackendRefs: backendRefs}},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			anv1alpha1.Install(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			assert.NoError(t, k8sClient.Create(ctx, &anv1alpha1.FixedResponse{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "unavailable",
+					Namespace: "default",
+				},
+				Spec: anv1alpha1.FixedResponseSpec{StatusCode: 500},
+			}))
+
+			route := core.NewHTTPRoute(gwv1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "service1",
+					Namespace:   "default",
+					Annotations: tt.annotations,
+				},
+				Spec: gwv1.HTTPRouteSpec{
+					Rules: tt.rules,
+				},
+			})
+
+			task := &latticeServiceModelBuildTask{
+				log:         gwlog.FallbackLogger,
+				route:       route,
+				stack:       core.NewDefaultStack(core.StackID(k8s.NamespacedName(route.K8sObject()))),
+				client:      k8sClient,
+				brTgBuilder: &dummyTgBuilder{},
+			}
+
+			defaultAction, err := task.getListenerDefaultAction(ctx, string(types.ListenerProtocolHttp), "")
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatusCode, defaultAction.FixedResponseStatusCode)
+			if tt.expectedForward {
+				assert.NotNil(t, defaultAction.Forward)
+				assert.Len(t, defaultAction.Forward.TargetGroups, 1)
+			} else {
+				assert.Nil(t, defaultAction.Forward)
+			}
+
+			spec := model.ListenerSpec{Protocol: string(types.ListenerProtocolHttp), DefaultAction: defaultAction}
+			assert.NoError(t, spec.Validate())
+		})
+	}
+}
+
+func Test_tlsRulesForHostname(t *testing.T) {
+	rule := func(name string) gwv1.TLSRouteRule {
+		return gwv1.TLSRouteRule{
+			BackendRefs: []gwv1.BackendRef{
+				{BackendObjectReference: gwv1.BackendObjectReference{Name: gwv1.ObjectName(name)}},
+			},
+		}
+	}
+	hostnames := []gwv1.Hostname{"a.example.com", "b.example.com", "c.example.com"}
+
+	tests := []struct {
+		name             string
+		annotations      map[string]string
+		rules            []gwv1.TLSRouteRule
+		hostname         string
+		expectedBackends []string
+		wantErr          bool
+	}{
+		{
+			name:             "single rule serves every hostname",
+			rules:            []gwv1.TLSRouteRule{rule("svc-a")},
+			hostname:         "b.example.com",
+			expectedBackends: []string{"svc-a"},
+		},
+		{
+			name:             "rules without annotation are combined",
+			rules:            []gwv1.TLSRouteRule{rule("svc-a"), rule("svc-b")},
+			hostname:         "a.example.com",
+			expectedBackends: []string{"svc-a", "svc-b"},
+		},
+		{
+			name: "annotated rule serves its hostnames",
+			annotations: map[string]string{
+				"application-networking.k8s.aws/rule-1-hostnames": "b.example.com, c.example.com",
+			},
+			rules:            []gwv1.TLSRouteRule{rule("svc-a"), rule("svc-b")},
+			hostname:         "c.example.com",
+			expectedBackends: []string{"svc-b"},
+		},
+		{
+			name: "rules without annotation serve the other hostnames",
+			annotations: map[string]string{
+				"application-networking.k8s.aws/rule-1-hostnames": "b.example.com",
+			},
+			rules:            []gwv1.TLSRouteRule{rule("svc-a"), rule("svc-b")},
+			hostname:         "a.example.com",
+			expectedBackends: []string{"svc-a"},
+		},
+		{
+			name: "hostname without rule",
+			annotations: map[string]string{
+				"application-networking.k8s.aws/rule-0-hostnames": "a.example.com",
+			},
+			rules:    []gwv1.TLSRouteRule{rule("svc-a")},
+			hostname: "b.example.com",
+			wantErr:  true,
+		},
+		{
+			name: "annotated hostname is not a route hostname",
+			annotations: map[string]string{
+				"application-networking.k8s.aws/rule-0-hostnames": "d.example.com",
+			},
+			rules:    []gwv1.TLSRouteRule{rule("svc-a"), rule("svc-b")},
+			hostname: "a.example.com",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			route := core.NewTLSRoute(gwv1.TLSRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "service1",
+					Namespace:   "default",
+					Annotations: tt.annotations,
+				},
+				Spec: gwv1.TLSRouteSpec{
+					Hostnames: hostnames,
+					Rules:     tt.rules,
+				},
+			})
+			task := &latticeServiceModelBuildTask{
+				log:   gwlog.FallbackLogger,
+				route: route,
+			}
+
+			rules, err := task.tlsRulesForHostname(tt.hostname)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			var backends []string
+			for _, r := range rules {
+				for _, br := range r.BackendRefs() {
+					backends = append(backends, string(br.Name()))
+				}
+			}
+			assert.Equal(t, tt.expectedBackends, backends)
+		})
+	}
+}
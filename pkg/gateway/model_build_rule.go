@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp/syntax"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -26,24 +28,139 @@ import (
 )
 
 const (
-	LATTICE_NO_SUPPORT_FOR_MULTIPLE_MATCHES = "LATTICE_NO_SUPPORT_FOR_MULTIPLE_MATCHES"
-	LATTICE_EXCEED_MAX_HEADER_MATCHES       = "LATTICE_EXCEED_MAX_HEADER_MATCHES"
-	LATTICE_UNSUPPORTED_MATCH_TYPE          = "LATTICE_UNSUPPORTED_MATCH_TYPE"
-	LATTICE_UNSUPPORTED_HEADER_MATCH_TYPE   = "LATTICE_UNSUPPORTED_HEADER_MATCH_TYPE"
-	LATTICE_UNSUPPORTED_PATH_MATCH_TYPE     = "LATTICE_UNSUPPORTED_PATH_MATCH_TYPE"
-	LATTICE_MAX_HEADER_MATCHES              = 5
+	LATTICE_RULE_PRIORITY_CONFLICT        = "LATTICE_RULE_PRIORITY_CONFLICT"
+	LATTICE_EXCEED_MAX_HEADER_MATCHES     = "LATTICE_EXCEED_MAX_HEADER_MATCHES"
+	LATTICE_UNSUPPORTED_MATCH_TYPE        = "LATTICE_UNSUPPORTED_MATCH_TYPE"
+	LATTICE_UNSUPPORTED_HEADER_MATCH_TYPE = "LATTICE_UNSUPPORTED_HEADER_MATCH_TYPE"
+	LATTICE_UNSUPPORTED_PATH_MATCH_TYPE   = "LATTICE_UNSUPPORTED_PATH_MATCH_TYPE"
+	LATTICE_MAX_HEADER_MATCHES            = 5
 )
 
+// ErrUnsupportedMatch is returned for route matches that cannot be expressed as VPC Lattice rule matches
+var ErrUnsupportedMatch = errors.New("unsupported route match")
+
+// ErrRuleQuotaExceeded is returned for routes needing more lattice rules than a listener allows
+var ErrRuleQuotaExceeded = errors.New("listener rule quota exceeded")
+
+// indexedRouteRule keeps track of a route rule's position in the route spec, since
+// the priority queue reorders rules and the index is needed to group expanded rules
+type indexedRouteRule struct {
+	index int
+	rule  core.RouteRule
+}
+
+// ruleMatchCount is the number of lattice rules a route rule expands to. Every match
+// becomes its own lattice rule, a rule without matches still produces one catch-all rule
+func ruleMatchCount(rule core.RouteRule) int32 {
+	return int32(max(len(rule.Matches()), 1))
+}
+
+// ruleParts checks that the lattice rules built for the route fit in a listener. When they do not and rule
+// splitting is enabled, the route rule indexes are divided in parts that each fit, one part per service.
+// Expanded matches of a route rule stay in the same part. It returns nil when a single service is enough
+func (t *latticeServiceModelBuildTask) ruleParts(ctx context.Context) ([][]int, error) {
+	if _, ok := t.route.(*core.TLSRoute); ok {
+		return nil, nil
+	}
+	if !t.route.DeletionTimestamp().IsZero() {
+		// services of previous parts are deleted with the route
+		return nil, nil
+	}
+
+	included, err := t.includedRuleIndexes(nil)
+	if err != nil {
+		return nil, err
+	}
+	rules := t.route.Spec().Rules()
+	total := 0
+	for i, rule := range rules {
+		if included[i] {
+			total += int(ruleMatchCount(rule))
+		}
+	}
+	if total <= model.MaxRulePriority {
+		return nil, nil
+	}
+	if !k8s.IsRuleSplittingEnabled(t.route) {
+		return nil, fmt.Errorf("%w: route %s/%s needs %d rules, listener allows %d",
+			ErrRuleQuotaExceeded, t.route.Namespace(), t.route.Name(), total, model.MaxRulePriority)
+	}
+	if k8s.IsServicePerHostnameEnabled(t.route) {
+		return nil, fmt.Errorf("%w: route %s/%s needs %d rules, rule splitting cannot be combined with one service per hostname",
+			ErrRuleQuotaExceeded, t.route.Namespace(), t.route.Name(), total)
+	}
+
+	var parts [][]int
+	var part []int
+	count := 0
+	for i, rule := range rules {
+		if !included[i] {
+			continue
+		}
+		n := int(ruleMatchCount(rule))
+		if count+n > model.MaxRulePriority && len(part) > 0 {
+			parts = append(parts, part)
+			part, count = nil, 0
+		}
+		part = append(part, i)
+		count += n
+	}
+	parts = append(parts, part)
+	t.log.Infof(ctx, "Splitting %d rules of route %s/%s across %d services",
+		total, t.route.Namespace(), t.route.Name(), len(parts))
+	return parts, nil
+}
+
+// includedRuleIndexes returns the indexes of the route rules built as lattice rules. The default rule is
+// left out since it becomes the listener default action, and with ruleIndexes set only those are included
+func (t *latticeServiceModelBuildTask) includedRuleIndexes(ruleIndexes []int) (map[int]bool, error) {
+	defaultRuleIndex, err := t.defaultRuleIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	included := make(map[int]bool)
+	if ruleIndexes == nil {
+		for i := range t.route.Spec().Rules() {
+			included[i] = true
+		}
+	} else {
+		for _, i := range ruleIndexes {
+			included[i] = true
+		}
+	}
+	delete(included, defaultRuleIndex)
+	return included, nil
+}
+
 func (t *latticeServiceModelBuildTask) buildRules(ctx context.Context, stackListenerId string) error {
+	return t.buildRulesForPart(ctx, stackListenerId, nil)
+}
+
+// buildRulesForPart builds the lattice rules of the route rules at the given indexes, or of all
+// route rules when ruleIndexes is nil
+func (t *latticeServiceModelBuildTask) buildRulesForPart(ctx context.Context, stackListenerId string, ruleIndexes []int) error {
+	if k8s.IsPrecedenceRuleOrderingEnabled(t.route) {
+		return t.buildRulesByPrecedence(ctx, stackListenerId, ruleIndexes)
+	}
+
 	// note we only build rules for non-deleted routes
 	t.log.Debugf(ctx, "Processing %d rules", len(t.route.Spec().Rules()))
 
+	included, err := t.includedRuleIndexes(ruleIndexes)
+	if err != nil {
+		return err
+	}
+
 	// Track rules with and without priority
-	rulesWithoutPriority := make([]core.RouteRule, 0)
+	rulesWithoutPriority := make([]indexedRouteRule, 0)
 	priorityQueue := make(utils.PriorityQueue, 0)
 
 	// First pass: build all rules and add them to priority queue
 	for i, rule := range t.route.Spec().Rules() {
+		if !included[i] {
+			continue
+		}
 		// Default priority is index + 1
 		priority := int64(i + 1)
 
@@ -57,25 +174,27 @@ func (t *latticeServiceModelBuildTask) buildRules(ctx context.Context, stackList
 			}
 
 			priorityQueue.Push(&utils.Item{
-				Value:    rule,
+				Value:    indexedRouteRule{index: i, rule: rule},
 				Priority: int32(priority),
 			})
 
 		} else {
-			rulesWithoutPriority = append(rulesWithoutPriority, rule)
+			rulesWithoutPriority = append(rulesWithoutPriority, indexedRouteRule{index: i, rule: rule})
 		}
 	}
 
 	// Assign rules without a manually assigned priority a priority in sequential order following the greatest
-	// manually assigned priority
+	// manually assigned priority. A rule with multiple matches takes one priority per match, so the next
+	// rule starts after the last expanded match of the previous one
 	for _, ruleSpec := range rulesWithoutPriority {
 		// No manually assigned priorities
 		topItem, err := priorityQueue.Peek()
 		if err == nil {
-			t.log.Debugf(ctx, "Setting default rule priority set to: %d", topItem.Priority+1)
+			nextPriority := topItem.Priority + ruleMatchCount(topItem.Value.(indexedRouteRule).rule)
+			t.log.Debugf(ctx, "Setting default rule priority set to: %d", nextPriority)
 			priorityQueue.Push(&utils.Item{
 				Value:    ruleSpec,
-				Priority: topItem.Priority + 1,
+				Priority: nextPriority,
 			})
 		} else {
 			t.log.Debugf(ctx, "Setting default rule priority set to: %d", 1)
@@ -86,54 +205,148 @@ func (t *latticeServiceModelBuildTask) buildRules(ctx context.Context, stackList
 		}
 	}
 
+	var ruleSpecs []model.RuleSpec
 	for _, item := range priorityQueue {
-		rule := item.Value.(core.RouteRule)
-		ruleSpec := model.RuleSpec{
-			StackListenerId: stackListenerId,
-			Priority:        int64(item.Priority),
+		indexedRule := item.Value.(indexedRouteRule)
+		specs, err := t.buildRuleSpecsWithAction(ctx, indexedRule, int64(item.Priority))
+		if err != nil {
+			return err
 		}
+		ruleSpecs = append(ruleSpecs, specs...)
+	}
 
-		if len(rule.Matches()) > 1 {
-			// only support 1 match today
-			return errors.New(LATTICE_NO_SUPPORT_FOR_MULTIPLE_MATCHES)
-		} else if len(rule.Matches()) > 0 {
-			t.log.Debugf(ctx, "Processing rule match")
-			match := rule.Matches()[0]
+	return t.addRulesToStack(ctx, stackListenerId, ruleSpecs)
+}
 
-			switch m := match.(type) {
-			case *core.HTTPRouteMatch:
-				if err := t.updateRuleSpecForHttpRoute(m, &ruleSpec); err != nil {
-					return err
-				}
-			case *core.GRPCRouteMatch:
-				if err := t.updateRuleSpecForGrpcRoute(m, &ruleSpec); err != nil {
-					return err
-				}
-			default:
-				return fmt.Errorf("unsupported rule match: %T", m)
-			}
+// buildRulesByPrecedence assigns lattice rule priorities following the Gateway API match precedence
+// instead of the rule index. Precedence applies per match, so the expanded rules of one route rule
+// may be interleaved with others. Rules with a priority annotation keep their annotated priorities
+// and the remaining rules take the lowest free priorities in precedence order
+func (t *latticeServiceModelBuildTask) buildRulesByPrecedence(ctx context.Context, stackListenerId string, ruleIndexes []int) error {
+	t.log.Debugf(ctx, "Processing %d rules ordered by match precedence", len(t.route.Spec().Rules()))
 
-			if err := t.updateRuleSpecWithHeaderMatches(match, &ruleSpec); err != nil {
-				return err
+	included, err := t.includedRuleIndexes(ruleIndexes)
+	if err != nil {
+		return err
+	}
+
+	var annotatedSpecs, orderedSpecs []model.RuleSpec
+	for i, rule := range t.route.Spec().Rules() {
+		if !included[i] {
+			continue
+		}
+		indexedRule := indexedRouteRule{index: i, rule: rule}
+
+		priority, annotated := int64(0), false
+		if priorityStr, ok := t.route.K8sObject().GetAnnotations()[fmt.Sprintf("application-networking.k8s.aws/rule-%d-priority", i)]; ok {
+			if p, err := strconv.ParseInt(priorityStr, 10, 64); err == nil {
+				priority, annotated = p, true
+				t.log.Debugf(ctx, "Using priority %d from annotation for rule %d", priority, i)
+			} else {
+				t.log.Warnf(ctx, "Invalid priority value in annotation for rule %d: %s", i, priorityStr)
 			}
+		}
+
+		specs, err := t.buildRuleSpecsWithAction(ctx, indexedRule, priority)
+		if err != nil {
+			return err
+		}
+		if annotated {
+			annotatedSpecs = append(annotatedSpecs, specs...)
 		} else {
-			// Match every traffic on no matches
-			ruleSpec.PathMatchValue = "/"
-			ruleSpec.PathMatchPrefix = true
-			if _, ok := rule.(*core.GRPCRouteRule); ok {
-				ruleSpec.Method = string(gwv1.HTTPMethodPost)
+			orderedSpecs = append(orderedSpecs, specs...)
+		}
+	}
+
+	usedPriorities := make(map[int64]struct{})
+	for _, spec := range annotatedSpecs {
+		usedPriorities[spec.Priority] = struct{}{}
+	}
+
+	// stable sort, so ties are resolved by rule and match order as required by the spec
+	sort.SliceStable(orderedSpecs, func(i, j int) bool {
+		return hasHigherMatchPrecedence(&orderedSpecs[i], &orderedSpecs[j])
+	})
+
+	nextPriority := int64(1)
+	for i := range orderedSpecs {
+		for {
+			if _, used := usedPriorities[nextPriority]; !used {
+				break
 			}
+			nextPriority++
 		}
+		orderedSpecs[i].Priority = nextPriority
+		nextPriority++
+	}
 
-		ruleTgList, err := t.getTargetGroupsForRuleAction(ctx, rule)
+	return t.addRulesToStack(ctx, stackListenerId, append(annotatedSpecs, orderedSpecs...))
+}
+
+// hasHigherMatchPrecedence compares two rule specs using the Gateway API HTTPRoute match precedence:
+// exact path before prefix, longer path before shorter, method match, then the number of header matches.
+// Remaining ties keep their relative order
+func hasHigherMatchPrecedence(a, b *model.RuleSpec) bool {
+	if a.PathMatchExact != b.PathMatchExact {
+		return a.PathMatchExact
+	}
+	if aLen, bLen := pathMatchLength(a), pathMatchLength(b); aLen != bLen {
+		return aLen > bLen
+	}
+	if (a.Method != "") != (b.Method != "") {
+		return a.Method != ""
+	}
+	return len(a.MatchedHeaders) > len(b.MatchedHeaders)
+}
+
+// pathMatchLength returns the length of the matched path, a match without a path matches
+// every request the same way the "/" prefix does
+func pathMatchLength(spec *model.RuleSpec) int {
+	if !spec.PathMatchExact && !spec.PathMatchPrefix {
+		return len("/")
+	}
+	return len(spec.PathMatchValue)
+}
+
+// buildRuleSpecsWithAction builds the target groups for a route rule once, and expands the rule
+// into rule specs that all share the resulting action
+func (t *latticeServiceModelBuildTask) buildRuleSpecsWithAction(ctx context.Context, indexedRule indexedRouteRule, basePriority int64) ([]model.RuleSpec, error) {
+	filters, err := t.resolveRuleFilters(ctx, indexedRule.rule)
+	if err != nil {
+		return nil, err
+	}
+
+	var action model.RuleAction
+	if filters.fixedResponseStatusCode != nil {
+		action.FixedResponseStatusCode = filters.fixedResponseStatusCode
+	} else {
+		ruleTgList, err := t.getTargetGroupsForRuleAction(ctx, indexedRule.rule)
 		if err != nil {
-			return err
+			return nil, err
 		}
+		action.TargetGroups = ruleTgList
+	}
 
-		ruleSpec.Action = model.RuleAction{
-			TargetGroups: ruleTgList,
+	ruleSpecs, err := t.buildRuleSpecsForMatches(ctx, indexedRule, filters, basePriority)
+	if err != nil {
+		return nil, err
+	}
+	for i := range ruleSpecs {
+		ruleSpecs[i].Action = action
+	}
+	return ruleSpecs, nil
+}
+
+func (t *latticeServiceModelBuildTask) addRulesToStack(ctx context.Context, stackListenerId string, ruleSpecs []model.RuleSpec) error {
+	usedPriorities := make(map[int64]int)
+	for _, ruleSpec := range ruleSpecs {
+		if owner, ok := usedPriorities[ruleSpec.Priority]; ok {
+			return fmt.Errorf("%s: rules %d and %d both resolve to priority %d",
+				LATTICE_RULE_PRIORITY_CONFLICT, owner, ruleSpec.RouteRuleIndex, ruleSpec.Priority)
 		}
+		usedPriorities[ruleSpec.Priority] = ruleSpec.RouteRuleIndex
 
+		ruleSpec.StackListenerId = stackListenerId
 		ruleSpec.AdditionalTags = k8s.GetAdditionalTagsFromAnnotations(ctx, t.route.K8sObject())
 
 		// don't bother adding rules on delete, these will be removed automatically with the owning route/lattice service
@@ -143,7 +356,8 @@ func (t *latticeServiceModelBuildTask) buildRules(ctx context.Context, stackList
 			if err != nil {
 				return err
 			}
-			t.log.Debugf(ctx, "Added rule %d to the stack (ID %s)", stackRule.Spec.Priority, stackRule.ID())
+			t.log.Debugf(ctx, "Added rule %d (route rule %d, match %d) to the stack (ID %s)",
+				stackRule.Spec.Priority, ruleSpec.RouteRuleIndex, ruleSpec.MatchIndex, stackRule.ID())
 		} else {
 			t.log.Debugf(ctx, "Skipping adding rule %d to the stack since the route is deleted", ruleSpec.Priority)
 		}
@@ -152,6 +366,61 @@ func (t *latticeServiceModelBuildTask) buildRules(ctx context.Context, stackList
 	return nil
 }
 
+// buildRuleSpecsForMatches expands a route rule into one rule spec per match. Gateway API
+// matches within a rule are ORed, which VPC Lattice can only express as separate rules, so
+// each match gets its own contiguous priority starting from basePriority
+func (t *latticeServiceModelBuildTask) buildRuleSpecsForMatches(ctx context.Context, indexedRule indexedRouteRule, filters ruleFilters, basePriority int64) ([]model.RuleSpec, error) {
+	rule := indexedRule.rule
+
+	if len(rule.Matches()) == 0 {
+		// Match every traffic on no matches
+		ruleSpec := model.RuleSpec{
+			Priority:        basePriority,
+			RouteRuleIndex:  indexedRule.index,
+			PathMatchValue:  "/",
+			PathMatchPrefix: true,
+		}
+		if _, ok := rule.(*core.GRPCRouteRule); ok {
+			ruleSpec.Method = string(gwv1.HTTPMethodPost)
+		}
+		return []model.RuleSpec{ruleSpec}, nil
+	}
+
+	if len(rule.Matches()) > 1 {
+		t.log.Debugf(ctx, "Expanding rule %d with %d matches into multiple lattice rules",
+			indexedRule.index, len(rule.Matches()))
+	}
+
+	var ruleSpecs []model.RuleSpec
+	for j, match := range rule.Matches() {
+		t.log.Debugf(ctx, "Processing rule match")
+		ruleSpec := model.RuleSpec{
+			Priority:       basePriority + int64(j),
+			RouteRuleIndex: indexedRule.index,
+			MatchIndex:     j,
+		}
+
+		switch m := match.(type) {
+		case *core.HTTPRouteMatch:
+			if err := t.updateRuleSpecForHttpRoute(m, &ruleSpec); err != nil {
+				return nil, err
+			}
+		case *core.GRPCRouteMatch:
+			if err := t.updateRuleSpecForGrpcRoute(m, &ruleSpec); err != nil {
+				return nil, fmt.Errorf("%w in rules[%d].matches[%d]: %s", ErrUnsupportedMatch, indexedRule.index, j, err)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported rule match: %T", m)
+		}
+
+		if err := t.updateRuleSpecWithHeaderMatches(match, filters.caseInsensitiveHeaders, &ruleSpec); err != nil {
+			return nil, err
+		}
+		ruleSpecs = append(ruleSpecs, ruleSpec)
+	}
+	return ruleSpecs, nil
+}
+
 func (t *latticeServiceModelBuildTask) updateRuleSpecForHttpRoute(m *core.HTTPRouteMatch, ruleSpec *model.RuleSpec) error {
 	hasPath := m.Path() != nil
 	hasType := hasPath && m.Path().Type != nil
@@ -222,13 +491,114 @@ func (t *latticeServiceModelBuildTask) updateRuleSpecForGrpcRoute(m *core.GRPCRo
 			ruleSpec.PathMatchExact = true
 			ruleSpec.PathMatchValue = fmt.Sprintf("/%s/%s", *method.Service, *method.Method)
 		}
+	case gwv1.GRPCMethodMatchRegularExpression:
+		path, isPrefix, err := grpcPathMatchFromRegex(method.Service, method.Method)
+		if err != nil {
+			return err
+		}
+		t.log.Debugf(context.TODO(), "Match gRPC regular expressions by path %s, prefix %t", path, isPrefix)
+		ruleSpec.PathMatchPrefix = isPrefix
+		ruleSpec.PathMatchExact = !isPrefix
+		ruleSpec.PathMatchValue = path
 	default:
 		return fmt.Errorf("unsupported gRPC method match type %s", *method.Type)
 	}
 	return nil
 }
 
-func (t *latticeServiceModelBuildTask) updateRuleSpecWithHeaderMatches(match core.RouteMatch, ruleSpec *model.RuleSpec) error {
+// grpcPathMatchFromRegex translates gRPC service and method regular expressions into an exact or prefix
+// match on the /service/method request path. Only regular expressions made of a literal, optionally
+// followed by .*, can be translated, and the method can only be a prefix when the service is a literal.
+func grpcPathMatchFromRegex(service, method *string) (string, bool, error) {
+	if service == nil {
+		// Lattice has no suffix match, so a method cannot be matched in any service
+		if method != nil {
+			return "", false, fmt.Errorf("gRPC method regular expression %q cannot be matched without a service", *method)
+		}
+		return "/", true, nil
+	}
+	serviceValue, serviceIsPrefix, ok := literalPrefixFromRegex(*service)
+	if !ok || (serviceValue == "" && !serviceIsPrefix) {
+		return "", false, fmt.Errorf("gRPC service regular expression %q cannot be expressed as a VPC Lattice path match", *service)
+	}
+
+	methodValue, methodIsPrefix := "", true
+	if method != nil {
+		methodValue, methodIsPrefix, ok = literalPrefixFromRegex(*method)
+		if !ok || (methodValue == "" && !methodIsPrefix) {
+			return "", false, fmt.Errorf("gRPC method regular expression %q cannot be expressed as a VPC Lattice path match", *method)
+		}
+	}
+
+	if serviceIsPrefix {
+		// anything after a service prefix matches, so the method must match anything too
+		if methodValue != "" || !methodIsPrefix {
+			return "", false, fmt.Errorf("gRPC method regular expression %q cannot be combined with service prefix %q in a VPC Lattice path match",
+				*method, *service)
+		}
+		return "/" + serviceValue, true, nil
+	}
+	return fmt.Sprintf("/%s/%s", serviceValue, methodValue), methodIsPrefix, nil
+}
+
+// literalPrefixFromRegex returns the literal matched by a regular expression, and whether the expression
+// matches any suffix after it, i.e. ends with .*. The expression is matched against the whole value, so
+// leading ^ and trailing $ anchors are allowed. ok is false for any other expression.
+func literalPrefixFromRegex(regex string) (value string, isPrefix bool, ok bool) {
+	value, anyBefore, isPrefix, ok := literalFromRegex(regex)
+	if !ok || anyBefore {
+		return "", false, false
+	}
+	return value, isPrefix, true
+}
+
+// literalFromRegex returns the literal matched by a regular expression, and whether the expression matches
+// any prefix before it and any suffix after it, i.e. starts or ends with .*. The expression is matched
+// against the whole value, so leading ^ and trailing $ anchors are allowed. ok is false for any other expression.
+func literalFromRegex(regex string) (value string, anyBefore bool, anyAfter bool, ok bool) {
+	re, err := syntax.Parse(regex, syntax.Perl)
+	if err != nil {
+		return "", false, false, false
+	}
+	re = re.Simplify()
+
+	subs := []*syntax.Regexp{re}
+	if re.Op == syntax.OpConcat {
+		subs = re.Sub
+	}
+	if len(subs) > 0 && subs[0].Op == syntax.OpBeginText {
+		subs = subs[1:]
+	}
+	if len(subs) > 0 && subs[len(subs)-1].Op == syntax.OpEndText {
+		subs = subs[:len(subs)-1]
+	}
+	if len(subs) > 0 && isAnyString(subs[len(subs)-1]) {
+		anyAfter = true
+		subs = subs[:len(subs)-1]
+	}
+	if len(subs) > 0 && isAnyString(subs[0]) {
+		anyBefore = true
+		subs = subs[1:]
+	}
+
+	switch {
+	case len(subs) == 0:
+		return "", anyBefore, anyAfter, true
+	case len(subs) == 1 && subs[0].Op == syntax.OpLiteral && subs[0].Flags&syntax.FoldCase == 0:
+		return string(subs[0].Rune), anyBefore, anyAfter, true
+	case len(subs) == 1 && subs[0].Op == syntax.OpEmptyMatch:
+		return "", anyBefore, anyAfter, true
+	}
+	return "", false, false, false
+}
+
+// isAnyString returns true for .*, which matches any string since gRPC names and header values contain no newlines
+func isAnyString(re *syntax.Regexp) bool {
+	return re.Op == syntax.OpStar && len(re.Sub) == 1 &&
+		(re.Sub[0].Op == syntax.OpAnyCharNotNL || re.Sub[0].Op == syntax.OpAnyChar)
+}
+
+func (t *latticeServiceModelBuildTask) updateRuleSpecWithHeaderMatches(match core.RouteMatch, caseInsensitive bool, ruleSpec *model.RuleSpec) error {
 	if match.Headers() == nil {
 		return nil
 	}
@@ -240,26 +610,142 @@ func (t *latticeServiceModelBuildTask) updateRuleSpecWithHeaderMatches(match cor
 	t.log.Debugf(context.TODO(), "Examining match headers for route %s-%s", t.route.Name(), t.route.Namespace())
 
 	for _, header := range match.Headers() {
-		t.log.Debugf(context.TODO(), "Examining match.Header: header.Type %s", *header.Type())
-		if header.Type() != nil && *header.Type() != gwv1.HeaderMatchExact {
-			t.log.Debugf(context.TODO(), "Unsupported header matchtype %s for httproute %s-%s",
-				*header.Type(), t.route.Name(), t.route.Namespace())
-			return errors.New(LATTICE_UNSUPPORTED_HEADER_MATCH_TYPE)
-		}
-
 		headerName := header.Name()
-
 		headerMatch := types.HeaderMatch{
-			Match: &types.HeaderMatchTypeMemberExact{Value: header.Value()},
-			Name:  &headerName,
+			Name: &headerName,
 		}
 
+		if header.Type() == nil || *header.Type() == gwv1.HeaderMatchExact {
+			headerMatch.Match = &types.HeaderMatchTypeMemberExact{Value: header.Value()}
+		} else if *header.Type() == gwv1.HeaderMatchRegularExpression {
+			matchType, ok := headerMatchFromRegex(header.Value())
+			if !ok {
+				t.log.Debugf(context.TODO(), "Unsupported header regular expression %s for route %s-%s",
+					header.Value(), t.route.Name(), t.route.Namespace())
+				return fmt.Errorf("%w: %s, regular expression %s of header %s", ErrUnsupportedMatch,
+					LATTICE_UNSUPPORTED_HEADER_MATCH_TYPE, header.Value(), headerName)
+			}
+			headerMatch.Match = matchType
+			// a regular expression is case-sensitive
+			headerMatch.CaseSensitive = aws.Bool(true)
+		} else {
+			t.log.Debugf(context.TODO(), "Unsupported header matchtype %s for route %s-%s",
+				*header.Type(), t.route.Name(), t.route.Namespace())
+			return fmt.Errorf("%w: %s, type %s of header %s", ErrUnsupportedMatch,
+				LATTICE_UNSUPPORTED_HEADER_MATCH_TYPE, *header.Type(), headerName)
+		}
+
+		if caseInsensitive {
+			headerMatch.CaseSensitive = aws.Bool(false)
+		}
 		ruleSpec.MatchedHeaders = append(ruleSpec.MatchedHeaders, headerMatch)
 	}
 
 	return nil
 }
 
+// headerMatchFromRegex translates a regular expression made of a literal into a Lattice exact match, or into a
+// prefix or contains match when followed by .*, or surrounded by .*
+func headerMatchFromRegex(regex string) (types.HeaderMatchType, bool) {
+	value, anyBefore, anyAfter, ok := literalFromRegex(regex)
+	if !ok || value == "" {
+		return nil, false
+	}
+	switch {
+	case anyBefore && anyAfter:
+		return &types.HeaderMatchTypeMemberContains{Value: value}, true
+	case anyAfter:
+		return &types.HeaderMatchTypeMemberPrefix{Value: value}, true
+	case !anyBefore:
+		return &types.HeaderMatchTypeMemberExact{Value: value}, true
+	}
+	// Lattice has no suffix match
+	return nil, false
+}
+
+// ruleFilters holds the extensionRef filters of a route rule, resolved from their resources
+type ruleFilters struct {
+	// set by a FixedResponse filter, or to 500 when a filter cannot be found,
+	// since Gateway API does not allow skipping unresolved filters
+	fixedResponseStatusCode *int32
+	caseInsensitiveHeaders  bool
+}
+
+// ruleExtensionRefs returns the extensionRef filters of a route rule
+func ruleExtensionRefs(rule core.RouteRule) []*gwv1.LocalObjectReference {
+	var refs []*gwv1.LocalObjectReference
+	switch r := rule.(type) {
+	case *core.HTTPRouteRule:
+		for _, filter := range r.Filters() {
+			if filter.Type == gwv1.HTTPRouteFilterExtensionRef && filter.ExtensionRef != nil {
+				refs = append(refs, filter.ExtensionRef)
+			}
+		}
+	case *core.GRPCRouteRule:
+		for _, filter := range r.Filters() {
+			if filter.Type == gwv1.GRPCRouteFilterExtensionRef && filter.ExtensionRef != nil {
+				refs = append(refs, filter.ExtensionRef)
+			}
+		}
+	}
+	return refs
+}
+
+// resolveRuleFilters looks up the FixedResponse and HeaderMatchFilter resources referenced by
+// the rule, other extensionRef kinds are ignored. FixedResponse is only supported on HTTPRoute.
+func (t *latticeServiceModelBuildTask) resolveRuleFilters(ctx context.Context, rule core.RouteRule) (ruleFilters, error) {
+	var filters ruleFilters
+	unresolved := false
+	for _, ref := range ruleExtensionRefs(rule) {
+		if string(ref.Group) != anv1alpha1.GroupName {
+			continue
+		}
+		key := apitypes.NamespacedName{
+			Namespace: t.route.Namespace(),
+			Name:      string(ref.Name),
+		}
+
+		switch string(ref.Kind) {
+		case anv1alpha1.FixedResponseKind:
+			if _, ok := rule.(*core.HTTPRouteRule); !ok {
+				continue
+			}
+			fixedResponse := &anv1alpha1.FixedResponse{}
+			found, err := k8s.ObjExists(ctx, t.client, key, fixedResponse)
+			if err != nil {
+				return filters, err
+			}
+			if !found {
+				unresolved = true
+				continue
+			}
+			statusCode := fixedResponse.Spec.StatusCode
+			filters.fixedResponseStatusCode = &statusCode
+		case anv1alpha1.HeaderMatchFilterKind:
+			headerMatchFilter := &anv1alpha1.HeaderMatchFilter{}
+			found, err := k8s.ObjExists(ctx, t.client, key, headerMatchFilter)
+			if err != nil {
+				return filters, err
+			}
+			if !found {
+				unresolved = true
+				continue
+			}
+			filters.caseInsensitiveHeaders = headerMatchFilter.Spec.CaseInsensitive
+		default:
+			continue
+		}
+	}
+
+	if unresolved {
+		t.log.Infof(ctx, "Route %s-%s references a filter that does not exist, returning %d",
+			t.route.Name(), t.route.Namespace(), model.InvalidBackendRefFixedResponseStatusCode)
+		statusCode := int32(model.InvalidBackendRefFixedResponseStatusCode)
+		filters.fixedResponseStatusCode = &statusCode
+	}
+	return filters, nil
+}
+
 func (t *latticeServiceModelBuildTask) getTargetGroupsForRuleAction(ctx context.Context, rule core.RouteRule) ([]*model.RuleTargetGroup, error) {
 	var tgList []*model.RuleTargetGroup
 
@@ -278,6 +764,19 @@ func (t *latticeServiceModelBuildTask) getTargetGroupsForRuleAction(ctx context.
 
 		t.log.Debugf(ctx, "Processing %s backendRef %s-%s", string(*backendRef.Kind()), backendRef.Name(), namespace)
 
+		permitted, err := k8s.IsBackendRefPermitted(ctx, t.client, t.route, BackendRefGrantTarget(t.route, backendRef))
+		if err != nil {
+			return nil, err
+		}
+		if !permitted {
+			// cross-namespace reference without a ReferenceGrant - rule returns 500 per Gateway API spec
+			t.log.Infof(ctx, "backendRef %s/%s on route %s is not permitted by any ReferenceGrant, marking as invalid",
+				namespace, backendRef.Name(), t.route.Name())
+			ruleTG.StackTargetGroupId = model.InvalidBackendRefTgId
+			tgList = append(tgList, &ruleTG)
+			continue
+		}
+
 		if string(*backendRef.Kind()) == "ServiceImport" {
 			// if there's a matching top-level service import, we can get additional fields
 			svcImportName := apitypes.NamespacedName{
@@ -352,7 +851,7 @@ func (t *latticeServiceModelBuildTask) getTargetGroupsForRuleAction(ctx context.
 				ruleTG.SvcImportTG = &svcImportTg
 			}
 
-		} else if string(*backendRef.Kind()) == "Service" {
+		} else if targetGroupBackendKinds.Contains(string(*backendRef.Kind())) {
 			// generate the actual target group model for the backendRef
 			_, tg, err := t.brTgBuilder.Build(ctx, t.route, backendRef, t.stack)
 			if err != nil {
@@ -377,3 +876,25 @@ func (t *latticeServiceModelBuildTask) getTargetGroupsForRuleAction(ctx context.
 
 	return tgList, nil
 }
+
+// BackendRefGrantTarget returns the ReferenceGrant "to" side of a backendRef
+func BackendRefGrantTarget(route core.Route, backendRef core.BackendRef) k8s.ReferenceGrantTarget {
+	target := k8s.ReferenceGrantTarget{
+		Kind:      "Service",
+		Namespace: route.Namespace(),
+		Name:      string(backendRef.Name()),
+	}
+	if backendRef.Kind() != nil {
+		target.Kind = string(*backendRef.Kind())
+	}
+	if backendRef.Namespace() != nil {
+		target.Namespace = string(*backendRef.Namespace())
+	}
+	if target.Kind == "ServiceImport" {
+		// group is not required for ServiceImport backendRefs, see resourceMapper.isBackendRefUsedByRoute
+		target.Group = anv1alpha1.GroupName
+	} else if backendRef.Group() != nil {
+		target.Group = string(*backendRef.Group())
+	}
+	return target
+}
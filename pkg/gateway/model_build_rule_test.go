@@ -9,6 +9,7 @@ import (
 
 	anv1alpha1 "github.com/aws/aws-application-networking-k8s/pkg/apis/applicationnetworking/v1alpha1"
 	"github.com/aws/aws-application-networking-k8s/pkg/aws/services"
+	"github.com/aws/aws-application-networking-k8s/pkg/config"
 	"github.com/aws/aws-application-networking-k8s/pkg/k8s"
 	"github.com/aws/aws-application-networking-k8s/pkg/model/core"
 	model "github.com/aws/aws-application-networking-k8s/pkg/model/lattice"
@@ -25,6 +26,7 @@ import (
 	"k8s.io/utils/ptr"
 	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
 type dummyTgBuilder struct {
@@ -117,9 +119,30 @@ func Test_RuleModelBuild(t *testing.T) {
 		},
 	}
 
+	var targetGroup2Name = gwv1.ObjectName("targetgroup2")
+	var serviceImportGrantTo = gwv1beta1.ReferenceGrantTo{
+		Group: anv1alpha1.GroupName,
+		Kind:  serviceImportKind,
+	}
+	var namespace1Grant = gwv1beta1.ReferenceGrant{
+		ObjectMeta: apimachineryv1.ObjectMeta{
+			Name:      "allow-non-default",
+			Namespace: string(namespace),
+		},
+		Spec: gwv1beta1.ReferenceGrantSpec{
+			From: []gwv1beta1.ReferenceGrantFrom{
+				{Group: gwv1.GroupName, Kind: "HTTPRoute", Namespace: "non-default"},
+			},
+			To: []gwv1beta1.ReferenceGrantTo{
+				{Group: anv1alpha1.GroupName, Kind: serviceImportKind, Name: &targetGroup2Name},
+			},
+		},
+	}
+
 	tests := []struct {
 		name         string
 		route        core.Route
+		grants       []gwv1beta1.ReferenceGrant
 		wantErrIsNil bool
 		expectedSpec []model.RuleSpec
 	}{
@@ -339,6 +362,7 @@ func Test_RuleModelBuild(t *testing.T) {
 				},
 				{
 					StackListenerId: "listener-id",
+					RouteRuleIndex:  1,
 					PathMatchPrefix: true,
 					PathMatchValue:  path2,
 					Priority:        2,
@@ -417,6 +441,7 @@ func Test_RuleModelBuild(t *testing.T) {
 				},
 				{
 					StackListenerId: "listener-id",
+					RouteRuleIndex:  1,
 					Method:          string(httpPost),
 					Priority:        2,
 					Action: model.RuleAction{
@@ -436,6 +461,21 @@ func Test_RuleModelBuild(t *testing.T) {
 		{
 			name:         "rule, different namespace combination",
 			wantErrIsNil: true,
+			grants: []gwv1beta1.ReferenceGrant{
+				namespace1Grant,
+				{
+					ObjectMeta: apimachineryv1.ObjectMeta{
+						Name:      "allow-all-service-imports",
+						Namespace: string(namespace2),
+					},
+					Spec: gwv1beta1.ReferenceGrantSpec{
+						From: []gwv1beta1.ReferenceGrantFrom{
+							{Group: gwv1.GroupName, Kind: "HTTPRoute", Namespace: "non-default"},
+						},
+						To: []gwv1beta1.ReferenceGrantTo{serviceImportGrantTo},
+					},
+				},
+			},
 			route: core.NewHTTPRoute(gwv1.HTTPRoute{
 				ObjectMeta: apimachineryv1.ObjectMeta{
 					Name:      "service1",
@@ -516,6 +556,7 @@ func Test_RuleModelBuild(t *testing.T) {
 				},
 				{
 					StackListenerId: "listener-id",
+					RouteRuleIndex:  1,
 					PathMatchExact:  true,
 					PathMatchValue:  path2,
 					Priority:        2,
@@ -533,6 +574,7 @@ func Test_RuleModelBuild(t *testing.T) {
 				},
 				{
 					StackListenerId: "listener-id",
+					RouteRuleIndex:  2,
 					PathMatchExact:  true,
 					PathMatchValue:  path3,
 					Priority:        3,
@@ -550,6 +592,137 @@ func Test_RuleModelBuild(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:         "rule, cross namespace backendRef without ReferenceGrant",
+			wantErrIsNil: true,
+			grants: []gwv1beta1.ReferenceGrant{
+				namespace1Grant,
+				{
+					ObjectMeta: apimachineryv1.ObjectMeta{
+						Name:      "allow-other-namespace",
+						Namespace: string(namespace2),
+					},
+					Spec: gwv1beta1.ReferenceGrantSpec{
+						From: []gwv1beta1.ReferenceGrantFrom{
+							{Group: gwv1.GroupName, Kind: "HTTPRoute", Namespace: "other"},
+						},
+						To: []gwv1beta1.ReferenceGrantTo{serviceImportGrantTo},
+					},
+				},
+			},
+			route: core.NewHTTPRoute(gwv1.HTTPRoute{
+				ObjectMeta: apimachineryv1.ObjectMeta{
+					Name:      "service1",
+					Namespace: "non-default",
+				},
+				Spec: gwv1.HTTPRouteSpec{
+					CommonRouteSpec: gwv1.CommonRouteSpec{
+						ParentRefs: []gwv1.ParentReference{
+							{
+								Name:        "gw1",
+								SectionName: &httpSectionName,
+							},
+						},
+					},
+					Rules: []gwv1.HTTPRouteRule{
+						{
+							Matches: []gwv1.HTTPRouteMatch{
+								{
+									Path: &gwv1.HTTPPathMatch{
+										Value: &path1,
+										Type:  &k8sPathMatchExactType,
+									},
+								},
+							},
+							BackendRefs: []gwv1.HTTPBackendRef{
+								{
+									BackendRef: backendRef1,
+								},
+							},
+						},
+						{
+							Matches: []gwv1.HTTPRouteMatch{
+								{
+									Path: &gwv1.HTTPPathMatch{
+										Value: &path2,
+										Type:  &k8sPathMatchExactType,
+									},
+								},
+							},
+							BackendRefs: []gwv1.HTTPBackendRef{
+								{
+									BackendRef: backendRef1Namespace1,
+								},
+							},
+						},
+						{
+							Matches: []gwv1.HTTPRouteMatch{
+								{
+									Path: &gwv1.HTTPPathMatch{
+										Value: &path3,
+										Type:  &k8sPathMatchExactType,
+									},
+								},
+							},
+							BackendRefs: []gwv1.HTTPBackendRef{
+								{
+									BackendRef: backendRef1Namespace2,
+								},
+							},
+						},
+					},
+				},
+			}),
+			expectedSpec: []model.RuleSpec{
+				{
+					StackListenerId: "listener-id",
+					PathMatchExact:  true,
+					PathMatchValue:  path1,
+					Priority:        1,
+					Action: model.RuleAction{
+						TargetGroups: []*model.RuleTargetGroup{
+							{
+								StackTargetGroupId: "tg-0",
+								Weight:             int64(weight1),
+							},
+						},
+					},
+				},
+				{
+					StackListenerId: "listener-id",
+					RouteRuleIndex:  1,
+					PathMatchExact:  true,
+					PathMatchValue:  path2,
+					Priority:        2,
+					Action: model.RuleAction{
+						TargetGroups: []*model.RuleTargetGroup{
+							{
+								SvcImportTG: &model.SvcImportTargetGroup{
+									K8SServiceName:      string(backendRef1Namespace1.Name),
+									K8SServiceNamespace: string(*backendRef1Namespace1.Namespace),
+								},
+								Weight: int64(weight2),
+							},
+						},
+					},
+				},
+				{
+					StackListenerId: "listener-id",
+					RouteRuleIndex:  2,
+					PathMatchExact:  true,
+					PathMatchValue:  path3,
+					Priority:        3,
+					Action: model.RuleAction{
+						TargetGroups: []*model.RuleTargetGroup{
+							{
+								StackTargetGroupId: model.InvalidBackendRefTgId,
+								Weight:             int64(weight2),
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name:         "rule, default service import action for GRPCRoute",
 			wantErrIsNil: true,
@@ -602,6 +775,32 @@ func Test_RuleModelBuild(t *testing.T) {
 		{
 			name:         "rule, gRPC routes with methods and multiple namespaces",
 			wantErrIsNil: true,
+			grants: []gwv1beta1.ReferenceGrant{
+				{
+					ObjectMeta: apimachineryv1.ObjectMeta{
+						Name:      "allow-grpc-routes",
+						Namespace: string(namespace),
+					},
+					Spec: gwv1beta1.ReferenceGrantSpec{
+						From: []gwv1beta1.ReferenceGrantFrom{
+							{Group: gwv1.GroupName, Kind: "GRPCRoute", Namespace: "non-default"},
+						},
+						To: []gwv1beta1.ReferenceGrantTo{serviceImportGrantTo},
+					},
+				},
+				{
+					ObjectMeta: apimachineryv1.ObjectMeta{
+						Name:      "allow-grpc-routes",
+						Namespace: string(namespace2),
+					},
+					Spec: gwv1beta1.ReferenceGrantSpec{
+						From: []gwv1beta1.ReferenceGrantFrom{
+							{Group: gwv1.GroupName, Kind: "GRPCRoute", Namespace: "non-default"},
+						},
+						To: []gwv1beta1.ReferenceGrantTo{serviceImportGrantTo},
+					},
+				},
+			},
 			route: core.NewGRPCRoute(gwv1.GRPCRoute{
 				ObjectMeta: apimachineryv1.ObjectMeta{
 					Name:      "service1",
@@ -686,6 +885,7 @@ func Test_RuleModelBuild(t *testing.T) {
 				},
 				{
 					StackListenerId: "listener-id",
+					RouteRuleIndex:  1,
 					PathMatchExact:  true,
 					PathMatchValue:  "/service/method2",
 					Priority:        2,
@@ -704,6 +904,7 @@ func Test_RuleModelBuild(t *testing.T) {
 				},
 				{
 					StackListenerId: "listener-id",
+					RouteRuleIndex:  2,
 					PathMatchExact:  true,
 					PathMatchValue:  "/service/method3",
 					Priority:        3,
@@ -1074,8 +1275,8 @@ func Test_RuleModelBuild(t *testing.T) {
 			}),
 		},
 		{
-			name:         "Negative, multiple methods",
-			wantErrIsNil: false,
+			name:         "multiple matches, expanded into one rule per match",
+			wantErrIsNil: true,
 			route: core.NewHTTPRoute(gwv1.HTTPRoute{
 				ObjectMeta: apimachineryv1.ObjectMeta{
 					Name:      "service1",
@@ -1094,18 +1295,18 @@ func Test_RuleModelBuild(t *testing.T) {
 						{
 							Matches: []gwv1.HTTPRouteMatch{
 								{
-
 									Path: &gwv1.HTTPPathMatch{
 										Type:  &k8sPathMatchExactType,
 										Value: &path1,
 									},
+									Method: &httpGet,
 								},
 								{
-
 									Path: &gwv1.HTTPPathMatch{
-										Type:  &k8sPathMatchExactType,
-										Value: &path1,
+										Type:  &k8sPathMatchPrefix,
+										Value: &path2,
 									},
+									Method: &httpPost,
 								},
 							},
 							BackendRefs: []gwv1.HTTPBackendRef{
@@ -1114,41 +1315,18 @@ func Test_RuleModelBuild(t *testing.T) {
 								},
 							},
 						},
-					},
-				},
-			}),
-		},
-		{
-			name:         "GRPC match on service and method",
-			wantErrIsNil: true,
-			route: core.NewGRPCRoute(gwv1.GRPCRoute{
-				ObjectMeta: apimachineryv1.ObjectMeta{
-					Name:      "service1",
-					Namespace: "default",
-				},
-				Spec: gwv1.GRPCRouteSpec{
-					CommonRouteSpec: gwv1.CommonRouteSpec{
-						ParentRefs: []gwv1.ParentReference{
-							{
-								Name:        "gw1",
-								SectionName: &httpSectionName,
-							},
-						},
-					},
-					Rules: []gwv1.GRPCRouteRule{
 						{
-							Matches: []gwv1.GRPCRouteMatch{
+							Matches: []gwv1.HTTPRouteMatch{
 								{
-									Method: &gwv1.GRPCMethodMatch{
-										Type:    &k8sGrpcMethodMatchExactType,
-										Service: ptr.To("service"),
-										Method:  ptr.To("method"),
+									Path: &gwv1.HTTPPathMatch{
+										Type:  &k8sPathMatchPrefix,
+										Value: &path3,
 									},
 								},
 							},
-							BackendRefs: []gwv1.GRPCBackendRef{
+							BackendRefs: []gwv1.HTTPBackendRef{
 								{
-									BackendRef: backendRef1,
+									BackendRef: backendRef2,
 								},
 							},
 						},
@@ -1159,68 +1337,52 @@ func Test_RuleModelBuild(t *testing.T) {
 				{
 					StackListenerId: "listener-id",
 					PathMatchExact:  true,
-					PathMatchValue:  "/service/method",
+					PathMatchValue:  path1,
+					Method:          string(httpGet),
 					Priority:        1,
-					Method:          "POST",
+					RouteRuleIndex:  0,
+					MatchIndex:      0,
 					Action: model.RuleAction{
 						TargetGroups: []*model.RuleTargetGroup{
 							{
 								StackTargetGroupId: "tg-0",
-								Weight:             int64(*backendRef1.Weight),
+								Weight:             int64(weight1),
 							},
 						},
 					},
 				},
-			},
-		},
-		{
-			name:         "GRPC match on service",
-			wantErrIsNil: true,
-			route: core.NewGRPCRoute(gwv1.GRPCRoute{
-				ObjectMeta: apimachineryv1.ObjectMeta{
-					Name:      "service1",
-					Namespace: "default",
-				},
-				Spec: gwv1.GRPCRouteSpec{
-					CommonRouteSpec: gwv1.CommonRouteSpec{
-						ParentRefs: []gwv1.ParentReference{
+				{
+					StackListenerId: "listener-id",
+					PathMatchPrefix: true,
+					PathMatchValue:  path2,
+					Method:          string(httpPost),
+					Priority:        2,
+					RouteRuleIndex:  0,
+					MatchIndex:      1,
+					Action: model.RuleAction{
+						TargetGroups: []*model.RuleTargetGroup{
 							{
-								Name:        "gw1",
-								SectionName: &httpSectionName,
-							},
-						},
-					},
-					Rules: []gwv1.GRPCRouteRule{
-						{
-							Matches: []gwv1.GRPCRouteMatch{
-								{
-									Method: &gwv1.GRPCMethodMatch{
-										Type:    &k8sGrpcMethodMatchExactType,
-										Service: ptr.To("service"),
-									},
-								},
-							},
-							BackendRefs: []gwv1.GRPCBackendRef{
-								{
-									BackendRef: backendRef1,
-								},
+								StackTargetGroupId: "tg-0",
+								Weight:             int64(weight1),
 							},
 						},
 					},
 				},
-			}),
-			expectedSpec: []model.RuleSpec{
 				{
 					StackListenerId: "listener-id",
 					PathMatchPrefix: true,
-					PathMatchValue:  "/service/",
-					Priority:        1,
-					Method:          "POST",
+					PathMatchValue:  path3,
+					Priority:        3,
+					RouteRuleIndex:  1,
+					MatchIndex:      0,
 					Action: model.RuleAction{
 						TargetGroups: []*model.RuleTargetGroup{
 							{
-								StackTargetGroupId: "tg-0",
-								Weight:             int64(*backendRef1.Weight),
+								SvcImportTG: &model.SvcImportTargetGroup{
+									K8SServiceName:      string(backendRef2.Name),
+									K8SServiceNamespace: "default",
+								},
+								Weight: int64(weight2),
 							},
 						},
 					},
@@ -1228,14 +1390,280 @@ func Test_RuleModelBuild(t *testing.T) {
 			},
 		},
 		{
-			name:         "GRPC match on all",
+			name:         "multiple matches with annotation priority, expanded rules are contiguous",
 			wantErrIsNil: true,
-			route: core.NewGRPCRoute(gwv1.GRPCRoute{
+			route: core.NewHTTPRoute(gwv1.HTTPRoute{
 				ObjectMeta: apimachineryv1.ObjectMeta{
 					Name:      "service1",
 					Namespace: "default",
+					Annotations: map[string]string{
+						"application-networking.k8s.aws/rule-0-priority": "10",
+					},
 				},
-				Spec: gwv1.GRPCRouteSpec{
+				Spec: gwv1.HTTPRouteSpec{
+					CommonRouteSpec: gwv1.CommonRouteSpec{
+						ParentRefs: []gwv1.ParentReference{
+							{
+								Name:        "gw1",
+								SectionName: &httpSectionName,
+							},
+						},
+					},
+					Rules: []gwv1.HTTPRouteRule{
+						{
+							Matches: []gwv1.HTTPRouteMatch{
+								{
+									Path: &gwv1.HTTPPathMatch{
+										Type:  &k8sPathMatchExactType,
+										Value: &path1,
+									},
+								},
+								{
+									Path: &gwv1.HTTPPathMatch{
+										Type:  &k8sPathMatchExactType,
+										Value: &path2,
+									},
+								},
+							},
+							BackendRefs: []gwv1.HTTPBackendRef{
+								{
+									BackendRef: backendRef1,
+								},
+							},
+						},
+						{
+							BackendRefs: []gwv1.HTTPBackendRef{
+								{
+									BackendRef: backendRef1,
+								},
+							},
+						},
+					},
+				},
+			}),
+			expectedSpec: []model.RuleSpec{
+				{
+					StackListenerId: "listener-id",
+					PathMatchExact:  true,
+					PathMatchValue:  path1,
+					Priority:        10,
+					RouteRuleIndex:  0,
+					MatchIndex:      0,
+					Action: model.RuleAction{
+						TargetGroups: []*model.RuleTargetGroup{
+							{
+								StackTargetGroupId: "tg-0",
+								Weight:             int64(weight1),
+							},
+						},
+					},
+				},
+				{
+					StackListenerId: "listener-id",
+					PathMatchExact:  true,
+					PathMatchValue:  path2,
+					Priority:        11,
+					RouteRuleIndex:  0,
+					MatchIndex:      1,
+					Action: model.RuleAction{
+						TargetGroups: []*model.RuleTargetGroup{
+							{
+								StackTargetGroupId: "tg-0",
+								Weight:             int64(weight1),
+							},
+						},
+					},
+				},
+				{
+					StackListenerId: "listener-id",
+					PathMatchPrefix: true,
+					PathMatchValue:  "/",
+					Priority:        12,
+					RouteRuleIndex:  1,
+					MatchIndex:      0,
+					Action: model.RuleAction{
+						TargetGroups: []*model.RuleTargetGroup{
+							{
+								StackTargetGroupId: "tg-1",
+								Weight:             int64(weight1),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:         "Negative, expanded matches overlap annotation priority",
+			wantErrIsNil: false,
+			route: core.NewHTTPRoute(gwv1.HTTPRoute{
+				ObjectMeta: apimachineryv1.ObjectMeta{
+					Name:      "service1",
+					Namespace: "default",
+					Annotations: map[string]string{
+						"application-networking.k8s.aws/rule-0-priority": "10",
+						"application-networking.k8s.aws/rule-1-priority": "11",
+					},
+				},
+				Spec: gwv1.HTTPRouteSpec{
+					CommonRouteSpec: gwv1.CommonRouteSpec{
+						ParentRefs: []gwv1.ParentReference{
+							{
+								Name:        "gw1",
+								SectionName: &httpSectionName,
+							},
+						},
+					},
+					Rules: []gwv1.HTTPRouteRule{
+						{
+							Matches: []gwv1.HTTPRouteMatch{
+								{
+									Path: &gwv1.HTTPPathMatch{
+										Type:  &k8sPathMatchExactType,
+										Value: &path1,
+									},
+								},
+								{
+									Path: &gwv1.HTTPPathMatch{
+										Type:  &k8sPathMatchExactType,
+										Value: &path2,
+									},
+								},
+							},
+							BackendRefs: []gwv1.HTTPBackendRef{
+								{
+									BackendRef: backendRef1,
+								},
+							},
+						},
+						{
+							BackendRefs: []gwv1.HTTPBackendRef{
+								{
+									BackendRef: backendRef1,
+								},
+							},
+						},
+					},
+				},
+			}),
+		},
+		{
+			name:         "GRPC match on service and method",
+			wantErrIsNil: true,
+			route: core.NewGRPCRoute(gwv1.GRPCRoute{
+				ObjectMeta: apimachineryv1.ObjectMeta{
+					Name:      "service1",
+					Namespace: "default",
+				},
+				Spec: gwv1.GRPCRouteSpec{
+					CommonRouteSpec: gwv1.CommonRouteSpec{
+						ParentRefs: []gwv1.ParentReference{
+							{
+								Name:        "gw1",
+								SectionName: &httpSectionName,
+							},
+						},
+					},
+					Rules: []gwv1.GRPCRouteRule{
+						{
+							Matches: []gwv1.GRPCRouteMatch{
+								{
+									Method: &gwv1.GRPCMethodMatch{
+										Type:    &k8sGrpcMethodMatchExactType,
+										Service: ptr.To("service"),
+										Method:  ptr.To("method"),
+									},
+								},
+							},
+							BackendRefs: []gwv1.GRPCBackendRef{
+								{
+									BackendRef: backendRef1,
+								},
+							},
+						},
+					},
+				},
+			}),
+			expectedSpec: []model.RuleSpec{
+				{
+					StackListenerId: "listener-id",
+					PathMatchExact:  true,
+					PathMatchValue:  "/service/method",
+					Priority:        1,
+					Method:          "POST",
+					Action: model.RuleAction{
+						TargetGroups: []*model.RuleTargetGroup{
+							{
+								StackTargetGroupId: "tg-0",
+								Weight:             int64(*backendRef1.Weight),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:         "GRPC match on service",
+			wantErrIsNil: true,
+			route: core.NewGRPCRoute(gwv1.GRPCRoute{
+				ObjectMeta: apimachineryv1.ObjectMeta{
+					Name:      "service1",
+					Namespace: "default",
+				},
+				Spec: gwv1.GRPCRouteSpec{
+					CommonRouteSpec: gwv1.CommonRouteSpec{
+						ParentRefs: []gwv1.ParentReference{
+							{
+								Name:        "gw1",
+								SectionName: &httpSectionName,
+							},
+						},
+					},
+					Rules: []gwv1.GRPCRouteRule{
+						{
+							Matches: []gwv1.GRPCRouteMatch{
+								{
+									Method: &gwv1.GRPCMethodMatch{
+										Type:    &k8sGrpcMethodMatchExactType,
+										Service: ptr.To("service"),
+									},
+								},
+							},
+							BackendRefs: []gwv1.GRPCBackendRef{
+								{
+									BackendRef: backendRef1,
+								},
+							},
+						},
+					},
+				},
+			}),
+			expectedSpec: []model.RuleSpec{
+				{
+					StackListenerId: "listener-id",
+					PathMatchPrefix: true,
+					PathMatchValue:  "/service/",
+					Priority:        1,
+					Method:          "POST",
+					Action: model.RuleAction{
+						TargetGroups: []*model.RuleTargetGroup{
+							{
+								StackTargetGroupId: "tg-0",
+								Weight:             int64(*backendRef1.Weight),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:         "GRPC match on all",
+			wantErrIsNil: true,
+			route: core.NewGRPCRoute(gwv1.GRPCRoute{
+				ObjectMeta: apimachineryv1.ObjectMeta{
+					Name:      "service1",
+					Namespace: "default",
+				},
+				Spec: gwv1.GRPCRouteSpec{
 					CommonRouteSpec: gwv1.CommonRouteSpec{
 						ParentRefs: []gwv1.ParentReference{
 							{
@@ -1534,6 +1962,7 @@ func Test_RuleModelBuild(t *testing.T) {
 				},
 				{
 					StackListenerId: "listener-id",
+					RouteRuleIndex:  1,
 					PathMatchPrefix: true,
 					PathMatchValue:  "/",
 					Priority:        2,
@@ -1607,6 +2036,7 @@ func Test_RuleModelBuild(t *testing.T) {
 				},
 				{
 					StackListenerId: "listener-id",
+					RouteRuleIndex:  1,
 					PathMatchPrefix: true,
 					PathMatchValue:  "/",
 					Priority:        50,
@@ -1682,8 +2112,13 @@ func Test_RuleModelBuild(t *testing.T) {
 			k8sSchema := runtime.NewScheme()
 			k8sSchema.AddKnownTypes(anv1alpha1.SchemeGroupVersion, &anv1alpha1.ServiceImport{})
 			clientgoscheme.AddToScheme(k8sSchema)
+			gwv1beta1.Install(k8sSchema)
 			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
 
+			for _, grant := range tt.grants {
+				assert.NoError(t, k8sClient.Create(ctx, grant.DeepCopy()))
+			}
+
 			svc := corev1.Service{
 				ObjectMeta: apimachineryv1.ObjectMeta{
 					Name:      string(backendRef1.Name),
@@ -1861,6 +2296,8 @@ func validateEqual(t *testing.T, expectedRules []model.RuleSpec, actualRules []*
 		assert.Equal(t, expectedSpec.PathMatchExact, actualRule.Spec.PathMatchExact)
 		assert.Equal(t, expectedSpec.Method, actualRule.Spec.Method)
 		assert.Equal(t, expectedSpec.Priority, actualRule.Spec.Priority)
+		assert.Equal(t, expectedSpec.RouteRuleIndex, actualRule.Spec.RouteRuleIndex)
+		assert.Equal(t, expectedSpec.MatchIndex, actualRule.Spec.MatchIndex)
 		assert.True(t, reflect.DeepEqual(expectedSpec.MatchedHeaders, actualRule.Spec.MatchedHeaders))
 
 		assert.Equal(t, len(expectedSpec.Action.TargetGroups), len(actualRule.Spec.Action.TargetGroups))
@@ -2398,3 +2835,811 @@ func Test_RuleModelBuild_MissingServiceImport_WithClaim(t *testing.T) {
 		})
 	}
 }
+
+func Test_RuleModelBuild_PrecedenceOrdering(t *testing.T) {
+	var serviceKind gwv1.Kind = "Service"
+	var exact = gwv1.PathMatchExact
+	var prefix = gwv1.PathMatchPathPrefix
+	var headerExact = gwv1.HeaderMatchExact
+	var httpGet = gwv1.HTTPMethodGet
+
+	backendRefs := []gwv1.HTTPBackendRef{
+		{
+			BackendRef: gwv1.BackendRef{
+				BackendObjectReference: gwv1.BackendObjectReference{
+					Name: "targetgroup1",
+					Kind: &serviceKind,
+				},
+			},
+		},
+	}
+	pathMatch := func(matchType *gwv1.PathMatchType, value string) gwv1.HTTPRouteMatch {
+		return gwv1.HTTPRouteMatch{
+			Path: &gwv1.HTTPPathMatch{Type: matchType, Value: &value},
+		}
+	}
+	methodMatch := pathMatch(&prefix, "/api")
+	methodMatch.Method = &httpGet
+	headerMatch := pathMatch(&prefix, "/api")
+	headerMatch.Headers = []gwv1.HTTPHeaderMatch{
+		{Type: &headerExact, Name: "env", Value: "test"},
+	}
+
+	rules := []gwv1.HTTPRouteRule{
+		{BackendRefs: backendRefs}, // rule 0, catch-all
+		{BackendRefs: backendRefs, Matches: []gwv1.HTTPRouteMatch{pathMatch(&prefix, "/api")}},              // rule 1
+		{BackendRefs: backendRefs, Matches: []gwv1.HTTPRouteMatch{headerMatch, pathMatch(&exact, "/api")}},  // rule 2
+		{BackendRefs: backendRefs, Matches: []gwv1.HTTPRouteMatch{pathMatch(&prefix, "/api/v2/items")}},     // rule 3
+		{BackendRefs: backendRefs, Matches: []gwv1.HTTPRouteMatch{methodMatch}},                             // rule 4
+		{BackendRefs: backendRefs, Matches: []gwv1.HTTPRouteMatch{pathMatch(&prefix, "/api"), methodMatch}}, // rule 5
+	}
+
+	tests := []struct {
+		name              string
+		controllerDefault bool
+		annotations       map[string]string
+		// expected priority keyed by route rule index and match index
+		expected map[[2]int]int64
+	}{
+		{
+			name: "priorities follow match precedence",
+			annotations: map[string]string{
+				k8s.PrecedenceRuleOrderingAnnotation: "true",
+			},
+			expected: map[[2]int]int64{
+				{2, 1}: 1, // exact /api
+				{3, 0}: 2, // prefix /api/v2/items
+				{4, 0}: 3, // prefix /api, method
+				{5, 1}: 4, // prefix /api, method, later rule
+				{2, 0}: 5, // prefix /api, header
+				{1, 0}: 6, // prefix /api
+				{5, 0}: 7, // prefix /api, later rule
+				{0, 0}: 8, // catch-all
+			},
+		},
+		{
+			name: "annotated priorities are kept and skipped",
+			annotations: map[string]string{
+				k8s.PrecedenceRuleOrderingAnnotation:             "true",
+				"application-networking.k8s.aws/rule-0-priority": "2",
+			},
+			expected: map[[2]int]int64{
+				{2, 1}: 1,
+				{0, 0}: 2, // annotated
+				{3, 0}: 3,
+				{4, 0}: 4,
+				{5, 1}: 5,
+				{2, 0}: 6,
+				{1, 0}: 7,
+				{5, 0}: 8,
+			},
+		},
+		{
+			name:              "route annotation disables controller default",
+			controllerDefault: true,
+			annotations: map[string]string{
+				k8s.PrecedenceRuleOrderingAnnotation: "false",
+			},
+			expected: map[[2]int]int64{
+				{0, 0}: 1,
+				{1, 0}: 2,
+				{2, 0}: 3,
+				{2, 1}: 4,
+				{3, 0}: 5,
+				{4, 0}: 6,
+				{5, 0}: 7,
+				{5, 1}: 8,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			config.PrecedenceRuleOrdering = tt.controllerDefault
+			defer func() { config.PrecedenceRuleOrdering = false }()
+
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+
+			route := core.NewHTTPRoute(gwv1.HTTPRoute{
+				ObjectMeta: apimachineryv1.ObjectMeta{
+					Name:        "service1",
+					Namespace:   "default",
+					Annotations: tt.annotations,
+				},
+				Spec: gwv1.HTTPRouteSpec{
+					Rules: rules,
+				},
+			})
+			stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(route.K8sObject())))
+
+			task := &latticeServiceModelBuildTask{
+				log:         gwlog.FallbackLogger,
+				route:       route,
+				stack:       stack,
+				client:      k8sClient,
+				brTgBuilder: &dummyTgBuilder{},
+			}
+
+			assert.NoError(t, task.buildRules(ctx, "listener-id"))
+
+			var resRules []*model.Rule
+			stack.ListResources(&resRules)
+
+			actual := make(map[[2]int]int64)
+			for _, rule := range resRules {
+				actual[[2]int{rule.Spec.RouteRuleIndex, rule.Spec.MatchIndex}] = rule.Spec.Priority
+			}
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_RuleModelBuild_FixedResponseFilter(t *testing.T) {
+	var serviceKind gwv1.Kind = "Service"
+	backendRefs := []gwv1.HTTPBackendRef{
+		{
+			BackendRef: gwv1.BackendRef{
+				BackendObjectReference: gwv1.BackendObjectReference{
+					Name: "targetgroup1",
+					Kind: &serviceKind,
+				},
+			},
+		},
+	}
+	fixedResponseFilter := func(name string) gwv1.HTTPRouteFilter {
+		return gwv1.HTTPRouteFilter{
+			Type: gwv1.HTTPRouteFilterExtensionRef,
+			ExtensionRef: &gwv1.LocalObjectReference{
+				Group: anv1alpha1.GroupName,
+				Kind:  anv1alpha1.FixedResponseKind,
+				Name:  gwv1.ObjectName(name),
+			},
+		}
+	}
+
+	tests := []struct {
+		name               string
+		filters            []gwv1.HTTPRouteFilter
+		expectedStatusCode *int32
+	}{
+		{
+			name:               "no filter forwards to backendRefs",
+			expectedStatusCode: nil,
+		},
+		{
+			name: "other extensionRef kind is ignored",
+			filters: []gwv1.HTTPRouteFilter{
+				{
+					Type: gwv1.HTTPRouteFilterExtensionRef,
+					ExtensionRef: &gwv1.LocalObjectReference{
+						Group: "example.com",
+						Kind:  "Other",
+						Name:  "maintenance",
+					},
+				},
+			},
+			expectedStatusCode: nil,
+		},
+		{
+			name:               "fixed response",
+			filters:            []gwv1.HTTPRouteFilter{fixedResponseFilter("maintenance")},
+			expectedStatusCode: aws.Int32(404),
+		},
+		{
+			name:               "missing fixed response returns 500",
+			filters:            []gwv1.HTTPRouteFilter{fixedResponseFilter("does-not-exist")},
+			expectedStatusCode: aws.Int32(500),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			anv1alpha1.Install(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			assert.NoError(t, k8sClient.Create(ctx, &anv1alpha1.FixedResponse{
+				ObjectMeta: apimachineryv1.ObjectMeta{
+					Name:      "maintenance",
+					Namespace: "default",
+				},
+				Spec: anv1alpha1.FixedResponseSpec{StatusCode: 404},
+			}))
+
+			route := core.NewHTTPRoute(gwv1.HTTPRoute{
+				ObjectMeta: apimachineryv1.ObjectMeta{
+					Name:      "service1",
+					Namespace: "default",
+				},
+				Spec: gwv1.HTTPRouteSpec{
+					Rules: []gwv1.HTTPRouteRule{
+						{
+							Filters:     tt.filters,
+							BackendRefs: backendRefs,
+						},
+					},
+				},
+			})
+			stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(route.K8sObject())))
+
+			task := &latticeServiceModelBuildTask{
+				log:         gwlog.FallbackLogger,
+				route:       route,
+				stack:       stack,
+				client:      k8sClient,
+				brTgBuilder: &dummyTgBuilder{},
+			}
+
+			assert.NoError(t, task.buildRules(ctx, "listener-id"))
+
+			var resRules []*model.Rule
+			stack.ListResources(&resRules)
+			assert.Len(t, resRules, 1)
+
+			action := resRules[0].Spec.Action
+			assert.Equal(t, tt.expectedStatusCode, action.FixedResponseStatusCode)
+			if tt.expectedStatusCode != nil {
+				assert.Empty(t, action.TargetGroups)
+			} else {
+				assert.Len(t, action.TargetGroups, 1)
+			}
+		})
+	}
+}
+
+func Test_RuleModelBuild_HeaderMatches(t *testing.T) {
+	var serviceKind gwv1.Kind = "Service"
+	var headerExact = gwv1.HeaderMatchExact
+	var headerRegex = gwv1.HeaderMatchRegularExpression
+
+	backendRefs := []gwv1.HTTPBackendRef{
+		{
+			BackendRef: gwv1.BackendRef{
+				BackendObjectReference: gwv1.BackendObjectReference{
+					Name: "targetgroup1",
+					Kind: &serviceKind,
+				},
+			},
+		},
+	}
+	headerMatchFilter := gwv1.HTTPRouteFilter{
+		Type: gwv1.HTTPRouteFilterExtensionRef,
+		ExtensionRef: &gwv1.LocalObjectReference{
+			Group: anv1alpha1.GroupName,
+			Kind:  anv1alpha1.HeaderMatchFilterKind,
+			Name:  "case-insensitive",
+		},
+	}
+
+	tests := []struct {
+		name          string
+		matchType     *gwv1.HeaderMatchType
+		value         string
+		filters       []gwv1.HTTPRouteFilter
+		wantErr       bool
+		expectedMatch types.HeaderMatchType
+		expectedCase  *bool
+		expectedFixed *int32
+	}{
+		{
+			name:          "exact",
+			matchType:     &headerExact,
+			value:         "foo",
+			expectedMatch: &types.HeaderMatchTypeMemberExact{Value: "foo"},
+		},
+		{
+			name:          "regex prefix",
+			matchType:     &headerRegex,
+			value:         "^foo.*",
+			expectedMatch: &types.HeaderMatchTypeMemberPrefix{Value: "foo"},
+			expectedCase:  aws.Bool(true),
+		},
+		{
+			name:          "regex contains",
+			matchType:     &headerRegex,
+			value:         ".*foo.*",
+			expectedMatch: &types.HeaderMatchTypeMemberContains{Value: "foo"},
+			expectedCase:  aws.Bool(true),
+		},
+		{
+			name:          "regex contains, case-insensitive filter",
+			matchType:     &headerRegex,
+			value:         ".*foo.*",
+			filters:       []gwv1.HTTPRouteFilter{headerMatchFilter},
+			expectedMatch: &types.HeaderMatchTypeMemberContains{Value: "foo"},
+			expectedCase:  aws.Bool(false),
+		},
+		{
+			name:      "regex with metacharacters",
+			matchType: &headerRegex,
+			value:     "^fo+.*",
+			wantErr:   true,
+		},
+		{
+			name:          "regex exact",
+			matchType:     &headerRegex,
+			value:         "^foo$",
+			expectedMatch: &types.HeaderMatchTypeMemberExact{Value: "foo"},
+			expectedCase:  aws.Bool(true),
+		},
+		{
+			name:          "regex prefix with escaped literal",
+			matchType:     &headerRegex,
+			value:         `^v1\..*`,
+			expectedMatch: &types.HeaderMatchTypeMemberPrefix{Value: "v1."},
+			expectedCase:  aws.Bool(true),
+		},
+		{
+			name:      "regex suffix",
+			matchType: &headerRegex,
+			value:     ".*foo",
+			wantErr:   true,
+		},
+		{
+			name:      "regex with unescaped dot",
+			matchType: &headerRegex,
+			value:     "^v1.0.*",
+			wantErr:   true,
+		},
+		{
+			name:      "empty regex prefix",
+			matchType: &headerRegex,
+			value:     "^.*",
+			wantErr:   true,
+		},
+		{
+			name:      "missing filter returns 500",
+			matchType: &headerExact,
+			value:     "foo",
+			filters: []gwv1.HTTPRouteFilter{
+				{
+					Type: gwv1.HTTPRouteFilterExtensionRef,
+					ExtensionRef: &gwv1.LocalObjectReference{
+						Group: anv1alpha1.GroupName,
+						Kind:  anv1alpha1.HeaderMatchFilterKind,
+						Name:  "does-not-exist",
+					},
+				},
+			},
+			expectedMatch: &types.HeaderMatchTypeMemberExact{Value: "foo"},
+			expectedFixed: aws.Int32(500),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			anv1alpha1.Install(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			assert.NoError(t, k8sClient.Create(ctx, &anv1alpha1.HeaderMatchFilter{
+				ObjectMeta: apimachineryv1.ObjectMeta{
+					Name:      "case-insensitive",
+					Namespace: "default",
+				},
+				Spec: anv1alpha1.HeaderMatchFilterSpec{CaseInsensitive: true},
+			}))
+
+			route := core.NewHTTPRoute(gwv1.HTTPRoute{
+				ObjectMeta: apimachineryv1.ObjectMeta{
+					Name:      "service1",
+					Namespace: "default",
+				},
+				Spec: gwv1.HTTPRouteSpec{
+					Rules: []gwv1.HTTPRouteRule{
+						{
+							Matches: []gwv1.HTTPRouteMatch{
+								{
+									Headers: []gwv1.HTTPHeaderMatch{
+										{Type: tt.matchType, Name: "x-header", Value: tt.value},
+									},
+								},
+							},
+							Filters:     tt.filters,
+							BackendRefs: backendRefs,
+						},
+					},
+				},
+			})
+			stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(route.K8sObject())))
+
+			task := &latticeServiceModelBuildTask{
+				log:         gwlog.FallbackLogger,
+				route:       route,
+				stack:       stack,
+				client:      k8sClient,
+				brTgBuilder: &dummyTgBuilder{},
+			}
+
+			err := task.buildRules(ctx, "listener-id")
+			if tt.wantErr {
+				assert.ErrorContains(t, err, LATTICE_UNSUPPORTED_HEADER_MATCH_TYPE)
+				// the route is rejected rather than retried
+				assert.ErrorIs(t, err, ErrUnsupportedMatch)
+				return
+			}
+			assert.NoError(t, err)
+
+			var resRules []*model.Rule
+			stack.ListResources(&resRules)
+			assert.Len(t, resRules, 1)
+			assert.Len(t, resRules[0].Spec.MatchedHeaders, 1)
+			headerMatch := resRules[0].Spec.MatchedHeaders[0]
+			assert.Equal(t, "x-header", aws.ToString(headerMatch.Name))
+			assert.Equal(t, tt.expectedMatch, headerMatch.Match)
+			assert.Equal(t, tt.expectedCase, headerMatch.CaseSensitive)
+			assert.Equal(t, tt.expectedFixed, resRules[0].Spec.Action.FixedResponseStatusCode)
+		})
+	}
+}
+
+func Test_grpcPathMatchFromRegex(t *testing.T) {
+	tests := []struct {
+		name           string
+		service        *string
+		method         *string
+		expectedPath   string
+		expectedPrefix bool
+		wantErr        bool
+	}{
+		{
+			name:           "nil service and method",
+			expectedPath:   "/",
+			expectedPrefix: true,
+		},
+		{
+			name:           "literal service",
+			service:        aws.String(`pkg\.v1\.Foo`),
+			expectedPath:   "/pkg.v1.Foo/",
+			expectedPrefix: true,
+		},
+		{
+			name:           "literal service and method",
+			service:        aws.String(`^pkg\.v1\.Foo$`),
+			method:         aws.String("Get"),
+			expectedPath:   "/pkg.v1.Foo/Get",
+			expectedPrefix: false,
+		},
+		{
+			name:           "service prefix",
+			service:        aws.String(`pkg\.v1\..*`),
+			expectedPath:   "/pkg.v1.",
+			expectedPrefix: true,
+		},
+		{
+			name:           "service prefix, any method",
+			service:        aws.String(`pkg\.v1\..*`),
+			method:         aws.String(".*"),
+			expectedPath:   "/pkg.v1.",
+			expectedPrefix: true,
+		},
+		{
+			name:           "literal service, method prefix",
+			service:        aws.String(`pkg\.v1\.Foo`),
+			method:         aws.String("Get.*"),
+			expectedPath:   "/pkg.v1.Foo/Get",
+			expectedPrefix: true,
+		},
+		{
+			name:           "any service",
+			service:        aws.String(".*"),
+			expectedPath:   "/",
+			expectedPrefix: true,
+		},
+		{
+			name:    "service prefix with method",
+			service: aws.String(`pkg\.v1\..*`),
+			method:  aws.String("Get"),
+			wantErr: true,
+		},
+		{
+			name:    "unescaped dot",
+			service: aws.String("pkg.v1.Foo"),
+			wantErr: true,
+		},
+		{
+			name:    "alternation",
+			service: aws.String("Foo|Bar"),
+			wantErr: true,
+		},
+		{
+			name:    "case-insensitive",
+			service: aws.String("(?i)foo"),
+			wantErr: true,
+		},
+		{
+			name:    "invalid regular expression",
+			service: aws.String("foo("),
+			wantErr: true,
+		},
+		{
+			name:    "method prefix without service",
+			method:  aws.String("Get.*"),
+			wantErr: true,
+		},
+		{
+			name:    "any method without service",
+			method:  aws.String(".*"),
+			wantErr: true,
+		},
+		{
+			name:    "empty method",
+			service: aws.String("Foo"),
+			method:  aws.String(""),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, isPrefix, err := grpcPathMatchFromRegex(tt.service, tt.method)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedPath, path)
+			assert.Equal(t, tt.expectedPrefix, isPrefix)
+		})
+	}
+}
+
+func Test_RuleModelBuild_GrpcRegexMatch(t *testing.T) {
+	var serviceKind gwv1.Kind = "Service"
+	var regexType = gwv1.GRPCMethodMatchRegularExpression
+
+	newRoute := func(service, method *string) core.Route {
+		return core.NewGRPCRoute(gwv1.GRPCRoute{
+			ObjectMeta: apimachineryv1.ObjectMeta{
+				Name:      "service1",
+				Namespace: "default",
+			},
+			Spec: gwv1.GRPCRouteSpec{
+				Rules: []gwv1.GRPCRouteRule{
+					{
+						Matches: []gwv1.GRPCRouteMatch{
+							{
+								Method: &gwv1.GRPCMethodMatch{
+									Type:    &regexType,
+									Service: service,
+									Method:  method,
+								},
+							},
+						},
+						BackendRefs: []gwv1.GRPCBackendRef{
+							{
+								BackendRef: gwv1.BackendRef{
+									BackendObjectReference: gwv1.BackendObjectReference{
+										Name: "targetgroup1",
+										Kind: &serviceKind,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+	}
+
+	for _, tt := range []struct {
+		name    string
+		service *string
+		method  *string
+		wantErr bool
+	}{
+		{name: "prefix", service: aws.String(`pkg\.v1\..*`)},
+		{name: "unsupported", service: aws.String("pkg.v1|pkg.v2"), wantErr: true},
+		{name: "method without service", method: aws.String("Get.*"), wantErr: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+
+			route := newRoute(tt.service, tt.method)
+			stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(route.K8sObject())))
+			task := &latticeServiceModelBuildTask{
+				log:         gwlog.FallbackLogger,
+				route:       route,
+				stack:       stack,
+				client:      k8sClient,
+				brTgBuilder: &dummyTgBuilder{},
+			}
+
+			err := task.buildRules(ctx, "listener-id")
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrUnsupportedMatch)
+				assert.ErrorContains(t, err, "rules[0].matches[0]")
+				return
+			}
+			assert.NoError(t, err)
+
+			var resRules []*model.Rule
+			stack.ListResources(&resRules)
+			assert.Len(t, resRules, 1)
+			assert.True(t, resRules[0].Spec.PathMatchPrefix)
+			assert.Equal(t, "/pkg.v1.", resRules[0].Spec.PathMatchValue)
+			assert.Equal(t, "POST", resRules[0].Spec.Method)
+		})
+	}
+}
+
+func Test_RuleModelBuild_SkipsDefaultRule(t *testing.T) {
+	var serviceKind gwv1.Kind = "Service"
+	pathPrefix := gwv1.PathMatchPathPrefix
+	backendRefs := []gwv1.HTTPBackendRef{
+		{
+			BackendRef: gwv1.BackendRef{
+				BackendObjectReference: gwv1.BackendObjectReference{
+					Name: "targetgroup1",
+					Kind: &serviceKind,
+				},
+			},
+		},
+	}
+	rules := []gwv1.HTTPRouteRule{
+		{
+			BackendRefs: backendRefs,
+		},
+		{
+			Matches: []gwv1.HTTPRouteMatch{
+				{
+					Path: &gwv1.HTTPPathMatch{
+						Type:  &pathPrefix,
+						Value: aws.String("/api"),
+					},
+				},
+			},
+			BackendRefs: backendRefs,
+		},
+	}
+
+	for _, precedence := range []string{"false", "true"} {
+		t.Run("precedence ordering "+precedence, func(t *testing.T) {
+			ctx := context.TODO()
+			route := core.NewHTTPRoute(gwv1.HTTPRoute{
+				ObjectMeta: apimachineryv1.ObjectMeta{
+					Name:      "service1",
+					Namespace: "default",
+					Annotations: map[string]string{
+						k8s.DefaultRuleAnnotation:            "0",
+						k8s.PrecedenceRuleOrderingAnnotation: precedence,
+					},
+				},
+				Spec: gwv1.HTTPRouteSpec{
+					Rules: rules,
+				},
+			})
+			stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(route.K8sObject())))
+
+			task := &latticeServiceModelBuildTask{
+				log:         gwlog.FallbackLogger,
+				route:       route,
+				stack:       stack,
+				brTgBuilder: &dummyTgBuilder{},
+			}
+
+			assert.NoError(t, task.buildRules(ctx, "listener-id"))
+
+			var resRules []*model.Rule
+			stack.ListResources(&resRules)
+			assert.Len(t, resRules, 1)
+			assert.Equal(t, "/api", resRules[0].Spec.PathMatchValue)
+			assert.Equal(t, int64(1), resRules[0].Spec.Priority)
+		})
+	}
+}
+
+func Test_ruleParts(t *testing.T) {
+	var serviceKind gwv1.Kind = "Service"
+	pathPrefix := gwv1.PathMatchPathPrefix
+	// ruleWithMatches returns a route rule expanding to the given number of lattice rules
+	ruleWithMatches := func(n int) gwv1.HTTPRouteRule {
+		rule := gwv1.HTTPRouteRule{
+			BackendRefs: []gwv1.HTTPBackendRef{
+				{
+					BackendRef: gwv1.BackendRef{
+						BackendObjectReference: gwv1.BackendObjectReference{
+							Name: "targetgroup1",
+							Kind: &serviceKind,
+						},
+					},
+				},
+			},
+		}
+		for i := 0; i < n; i++ {
+			rule.Matches = append(rule.Matches, gwv1.HTTPRouteMatch{
+				Path: &gwv1.HTTPPathMatch{
+					Type:  &pathPrefix,
+					Value: aws.String(fmt.Sprintf("/path%d", i)),
+				},
+			})
+		}
+		return rule
+	}
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		rules       []gwv1.HTTPRouteRule
+		deleting    bool
+		wantParts   [][]int
+		wantErr     string
+	}{
+		{
+			name:  "fits in one listener",
+			rules: []gwv1.HTTPRouteRule{ruleWithMatches(60), ruleWithMatches(40)},
+		},
+		{
+			name:    "quota exceeded",
+			rules:   []gwv1.HTTPRouteRule{ruleWithMatches(60), ruleWithMatches(60), ruleWithMatches(20)},
+			wantErr: "route default/service1 needs 140 rules, listener allows 100",
+		},
+		{
+			name:        "default rule is not counted",
+			annotations: map[string]string{k8s.DefaultRuleAnnotation: "2"},
+			rules:       []gwv1.HTTPRouteRule{ruleWithMatches(60), ruleWithMatches(40), ruleWithMatches(0)},
+		},
+		{
+			name:        "split across services",
+			annotations: map[string]string{k8s.RuleSplittingAnnotation: "true"},
+			rules:       []gwv1.HTTPRouteRule{ruleWithMatches(60), ruleWithMatches(30), ruleWithMatches(20), ruleWithMatches(30)},
+			wantParts:   [][]int{{0, 1}, {2, 3}},
+		},
+		{
+			name: "split cannot be combined with one service per hostname",
+			annotations: map[string]string{
+				k8s.RuleSplittingAnnotation:      "true",
+				k8s.ServicePerHostnameAnnotation: "true",
+			},
+			rules:   []gwv1.HTTPRouteRule{ruleWithMatches(60), ruleWithMatches(60)},
+			wantErr: "rule splitting cannot be combined with one service per hostname",
+		},
+		{
+			name:     "deleted route is not checked",
+			rules:    []gwv1.HTTPRouteRule{ruleWithMatches(60), ruleWithMatches(60)},
+			deleting: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpRoute := gwv1.HTTPRoute{
+				ObjectMeta: apimachineryv1.ObjectMeta{
+					Name:        "service1",
+					Namespace:   "default",
+					Annotations: tt.annotations,
+				},
+				Spec: gwv1.HTTPRouteSpec{
+					Rules: tt.rules,
+				},
+			}
+			if tt.deleting {
+				now := apimachineryv1.Now()
+				httpRoute.DeletionTimestamp = &now
+			}
+			task := &latticeServiceModelBuildTask{
+				log:   gwlog.FallbackLogger,
+				route: core.NewHTTPRoute(httpRoute),
+			}
+
+			parts, err := task.ruleParts(context.TODO())
+			if tt.wantErr != "" {
+				assert.ErrorIs(t, err, ErrRuleQuotaExceeded)
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantParts, parts)
+		})
+	}
+}
@@ -2,6 +2,9 @@ package gateway
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -17,6 +20,7 @@ import (
 	policy "github.com/aws/aws-application-networking-k8s/pkg/k8s/policyhelper"
 	"github.com/aws/aws-application-networking-k8s/pkg/model/core"
 	model "github.com/aws/aws-application-networking-k8s/pkg/model/lattice"
+	"github.com/aws/aws-application-networking-k8s/pkg/utils"
 	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
 )
 
@@ -24,6 +28,9 @@ type (
 	TGP = anv1alpha1.TargetGroupPolicy
 )
 
+// backendRef kinds that get a target group built by BackendRefTargetGroupBuilder
+var targetGroupBackendKinds = utils.NewSet("Service", anv1alpha1.LambdaFunctionKind, anv1alpha1.ApplicationLoadBalancerKind)
+
 type InvalidBackendRefError struct {
 	BackendRef core.BackendRef
 	Reason     string
@@ -180,20 +187,30 @@ func (t *svcExportTargetGroupModelBuildTask) buildTargetGroupForExportedPort(ctx
 		}
 	}
 
+	portName := servicePortName(svc, exportedPort.Port)
+	tgp, err := policy.ResolveTargetGroupPolicy(ctx, t.tgp, t.serviceExport, portName, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var tgType model.TargetGroupType
 	var ipAddressType string
-	var err error
 	if noSvcFoundAndDeleting {
-		ipAddressType = "IPV4" // just pick a default
+		tgType, _ = buildTargetGroupType(tgp, nil)
+		if tgType == model.TargetGroupTypeIP {
+			ipAddressType = "IPV4" // just pick a default
+		}
 	} else {
-		ipAddressType, err = buildTargetGroupIpAddressType(svc)
+		tgType, err = buildTargetGroupType(tgp, svc)
 		if err != nil {
 			return nil, err
 		}
-	}
-
-	tgp, err := t.tgp.ObjResolvedPolicy(ctx, t.serviceExport)
-	if err != nil {
-		return nil, err
+		if tgType == model.TargetGroupTypeIP {
+			ipAddressType, err = buildTargetGroupIpAddressType(svc)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	// Get protocol, protocolVersion, and health check config from policy
@@ -201,6 +218,13 @@ func (t *svcExportTargetGroupModelBuildTask) buildTargetGroupForExportedPort(ctx
 	if err != nil {
 		return nil, err
 	}
+	var readinessProbe *model.ReadinessProbeHealthCheck
+	if !noSvcFoundAndDeleting {
+		healthCheckConfig, readinessProbe, err = buildHealthCheckConfig(ctx, t.log, t.client, tgp, svc, exportedPort.Port, tgType, healthCheckConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// Set default protocol and protocolVersion based on routeType
 	var protocol, protocolVersion string
@@ -234,13 +258,16 @@ func (t *svcExportTargetGroupModelBuildTask) buildTargetGroupForExportedPort(ctx
 	}
 
 	spec := model.TargetGroupSpec{
-		Type:              model.TargetGroupTypeIP,
+		Type:              tgType,
 		Port:              exportedPort.Port,
 		Protocol:          protocol,
 		ProtocolVersion:   protocolVersion,
 		IpAddressType:     ipAddressType,
 		HealthCheckConfig: healthCheckConfig,
+		ReadinessProbe:    readinessProbe,
 	}
+	spec.DrainingTimeoutSeconds = parseDrainingTimeout(tgp)
+	spec.K8SServicePortName = portName
 	spec.VpcId = config.VpcID
 	spec.K8SSourceType = model.SourceTypeSvcExport
 	spec.K8SClusterName = config.ClusterName
@@ -299,35 +326,55 @@ func (t *svcExportTargetGroupModelBuildTask) buildTargetGroup(ctx context.Contex
 		}
 	}
 
+	portName := servicePortName(svc, undefinedPort)
+	tgp, err := policy.ResolveTargetGroupPolicy(ctx, t.tgp, t.serviceExport, portName, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var tgType model.TargetGroupType
 	var ipAddressType string
-	var err error
 	if noSvcFoundAndDeleting {
-		ipAddressType = "IPV4" // Pick a default
+		tgType, _ = buildTargetGroupType(tgp, nil)
+		if tgType == model.TargetGroupTypeIP {
+			ipAddressType = "IPV4" // Pick a default
+		}
 	} else {
-		ipAddressType, err = buildTargetGroupIpAddressType(svc)
+		tgType, err = buildTargetGroupType(tgp, svc)
 		if err != nil {
 			return nil, err
 		}
-	}
-
-	tgp, err := t.tgp.ObjResolvedPolicy(ctx, t.serviceExport)
-	if err != nil {
-		return nil, err
+		if tgType == model.TargetGroupTypeIP {
+			ipAddressType, err = buildTargetGroupIpAddressType(svc)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	protocol, protocolVersion, healthCheckConfig, err := parseTargetGroupConfig(tgp)
 	if err != nil {
 		return nil, err
 	}
+	var readinessProbe *model.ReadinessProbeHealthCheck
+	if !noSvcFoundAndDeleting {
+		healthCheckConfig, readinessProbe, err = buildHealthCheckConfig(ctx, t.log, t.client, tgp, svc, undefinedPort, tgType, healthCheckConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	spec := model.TargetGroupSpec{
-		Type:              model.TargetGroupTypeIP,
+		Type:              tgType,
 		Port:              80,
 		Protocol:          protocol,
 		ProtocolVersion:   protocolVersion,
 		IpAddressType:     ipAddressType,
 		HealthCheckConfig: healthCheckConfig,
+		ReadinessProbe:    readinessProbe,
 	}
+	spec.DrainingTimeoutSeconds = parseDrainingTimeout(tgp)
+	spec.K8SServicePortName = portName
 	spec.VpcId = config.VpcID
 	spec.K8SSourceType = model.SourceTypeSvcExport
 	spec.K8SClusterName = config.ClusterName
@@ -427,6 +474,12 @@ func (t *backendRefTargetGroupModelBuildTask) buildTargets(ctx context.Context,
 		t.log.Debugf(ctx, "Service import does not manage targets, returning")
 		return nil
 	}
+	if string(*t.backendRef.Kind()) == anv1alpha1.LambdaFunctionKind {
+		return t.buildLambdaTargets(ctx, stackTgId)
+	}
+	if string(*t.backendRef.Kind()) == anv1alpha1.ApplicationLoadBalancerKind {
+		return t.buildAlbTargets(ctx, stackTgId)
+	}
 	backendRefNsName := getBackendRefNsName(t.route, t.backendRef)
 	svc := &corev1.Service{}
 	if err := t.client.Get(ctx, backendRefNsName, svc); err != nil {
@@ -447,6 +500,12 @@ func (t *backendRefTargetGroupModelBuildTask) buildTargetGroupSpec(ctx context.C
 	// note we only build target groups for backendRefs on non-deleted routes
 	backendKind := string(*t.backendRef.Kind())
 	t.log.Debugf(ctx, "buildTargetGroupSpec, kind %s", backendKind)
+	if backendKind == anv1alpha1.LambdaFunctionKind {
+		return t.buildLambdaTargetGroupSpec(ctx)
+	}
+	if backendKind == anv1alpha1.ApplicationLoadBalancerKind {
+		return t.buildAlbTargetGroupSpec(ctx)
+	}
 
 	vpc := config.VpcID
 	eksCluster := config.ClusterName
@@ -464,18 +523,36 @@ func (t *backendRefTargetGroupModelBuildTask) buildTargetGroupSpec(ctx context.C
 		}
 	}
 
-	var err error
-	ipAddressType, err := buildTargetGroupIpAddressType(svc)
+	port := undefinedPort
+	if t.backendRef.Port() != nil {
+		port = int32(*t.backendRef.Port())
+	}
+	portName := servicePortName(svc, port)
+	// gateway defaults apply to the target groups of the routes attached to them, missing gateways are ignored
+	gateways, _ := k8s.FindControlledParents(ctx, t.client, t.route)
+	tgp, err := policy.ResolveTargetGroupPolicy(ctx, t.tgp, svc, portName, gateways)
 	if err != nil {
 		return model.TargetGroupSpec{}, err
 	}
 
-	tgp, err := t.tgp.ObjResolvedPolicy(ctx, svc)
+	tgType, err := buildTargetGroupType(tgp, svc)
 	if err != nil {
 		return model.TargetGroupSpec{}, err
 	}
 
-	protocol, protocolVersion, healthCheckConfig, err := parseTargetGroupConfig(tgp)
+	var ipAddressType string
+	if tgType == model.TargetGroupTypeIP {
+		ipAddressType, err = buildTargetGroupIpAddressType(svc)
+		if err != nil {
+			return model.TargetGroupSpec{}, err
+		}
+	}
+
+	protocol, protocolVersion, policyHealthCheckConfig, err := parseTargetGroupConfig(tgp)
+	if err != nil {
+		return model.TargetGroupSpec{}, err
+	}
+	healthCheckConfig, readinessProbe, err := buildHealthCheckConfig(ctx, t.log, t.client, tgp, svc, port, tgType, policyHealthCheckConfig)
 	if err != nil {
 		return model.TargetGroupSpec{}, err
 	}
@@ -498,18 +575,141 @@ func (t *backendRefTargetGroupModelBuildTask) buildTargetGroupSpec(ctx context.C
 	}
 
 	spec := model.TargetGroupSpec{
-		Type:              model.TargetGroupTypeIP,
+		Type:              tgType,
 		Port:              80,
 		Protocol:          protocol,
 		ProtocolVersion:   protocolVersion,
 		IpAddressType:     ipAddressType,
 		HealthCheckConfig: healthCheckConfig,
+		ReadinessProbe:    readinessProbe,
 	}
+	spec.DrainingTimeoutSeconds = parseDrainingTimeout(tgp)
+	spec.K8SServicePortName = portName
 	spec.VpcId = vpc
 	spec.K8SSourceType = parentRefType
 	spec.K8SClusterName = eksCluster
 	spec.K8SServiceName = backendRefNsName.Name
 	spec.K8SServiceNamespace = backendRefNsName.Namespace
+	spec.K8SProtocolVersion = protocolVersion
+
+	if k8s.IsSharedTargetGroupEnabled(svc) {
+		// a shared target group belongs to no route, it takes the additional tags of its service instead
+		spec.K8SSharedKey, err = sharedTargetGroupKey(t.backendRef, spec, policyHealthCheckConfig)
+		if err != nil {
+			return model.TargetGroupSpec{}, err
+		}
+		spec.AdditionalTags = k8s.GetAdditionalTagsFromAnnotations(ctx, svc)
+		return spec, nil
+	}
+
+	spec.K8SRouteName = t.route.Name()
+	spec.K8SRouteNamespace = t.route.Namespace()
+
+	spec.AdditionalTags = k8s.GetAdditionalTagsFromAnnotations(ctx, t.route.K8sObject())
+
+	return spec, nil
+}
+
+// sharedTargetGroupKey identifies the target group shared by the routes referencing a service port. It is the
+// backendRef port, followed by a hash of the protocol and of the health check resolved from the policies of the
+// service, its namespace and the route gateways, so that routes of gateways with other defaults get their own target
+// group. The health check derived from readiness probes is left out, it changes while pods roll out and is updated in
+// place, only whether it is used is part of the key.
+func sharedTargetGroupKey(backendRef core.BackendRef, spec model.TargetGroupSpec,
+	policyHealthCheckConfig *types.HealthCheckConfig) (string, error) {
+	port := undefinedPort
+	if backendRef.Port() != nil {
+		port = int32(*backendRef.Port())
+	}
+	bytes, err := json.Marshal(struct {
+		Protocol        string                   `json:"protocol"`
+		ProtocolVersion string                   `json:"protocolversion"`
+		HealthCheck     *types.HealthCheckConfig `json:"healthcheck,omitempty"`
+		ReadinessProbe  bool                     `json:"readinessprobe,omitempty"`
+	}{spec.Protocol, spec.ProtocolVersion, policyHealthCheckConfig, spec.ReadinessProbe != nil})
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(bytes)
+	return fmt.Sprintf("%d-%s", port, hex.EncodeToString(hash[:])[:8]), nil
+}
+
+// buildLambdaTargetGroupSpec builds the LAMBDA target group of a LambdaFunction backendRef. Lambda target groups
+// have no VPC, port or protocol, VPC Lattice invokes the function with the configured event structure
+func (t *backendRefTargetGroupModelBuildTask) buildLambdaTargetGroupSpec(ctx context.Context) (model.TargetGroupSpec, error) {
+	lambdaFunction := &anv1alpha1.LambdaFunction{}
+	if err := t.getHttpRouteBackend(ctx, lambdaFunction); err != nil {
+		return model.TargetGroupSpec{}, err
+	}
+
+	eventStructureVersion := string(types.LambdaEventStructureVersionV2)
+	if lambdaFunction.Spec.EventStructureVersion != nil {
+		eventStructureVersion = *lambdaFunction.Spec.EventStructureVersion
+	}
+
+	spec := model.TargetGroupSpec{
+		Type:                        model.TargetGroupTypeLambda,
+		LambdaEventStructureVersion: eventStructureVersion,
+	}
+	spec.K8SSourceType = model.SourceTypeHTTPRoute
+	spec.K8SClusterName = config.ClusterName
+	spec.K8SServiceName = lambdaFunction.Name
+	spec.K8SServiceNamespace = lambdaFunction.Namespace
+	spec.K8SRouteName = t.route.Name()
+	spec.K8SRouteNamespace = t.route.Namespace()
+
+	spec.AdditionalTags = k8s.GetAdditionalTagsFromAnnotations(ctx, t.route.K8sObject())
+
+	return spec, nil
+}
+
+// buildLambdaTargets registers the function ARN as the single target of a LAMBDA target group
+func (t *backendRefTargetGroupModelBuildTask) buildLambdaTargets(ctx context.Context, stackTgId string) error {
+	lambdaFunction := &anv1alpha1.LambdaFunction{}
+	if err := t.getHttpRouteBackend(ctx, lambdaFunction); err != nil {
+		return err
+	}
+
+	_, err := model.NewTargets(t.stack, model.TargetsSpec{
+		StackTargetGroupId: stackTgId,
+		TargetList: []model.Target{
+			{
+				TargetIP: lambdaFunction.Spec.FunctionArn,
+				Ready:    true,
+			},
+		},
+	})
+	return err
+}
+
+// buildAlbTargetGroupSpec builds the ALB target group of an ApplicationLoadBalancer backendRef, forwarding to
+// the load balancer listener with the given port and protocol
+func (t *backendRefTargetGroupModelBuildTask) buildAlbTargetGroupSpec(ctx context.Context) (model.TargetGroupSpec, error) {
+	alb := &anv1alpha1.ApplicationLoadBalancer{}
+	if err := t.getHttpRouteBackend(ctx, alb); err != nil {
+		return model.TargetGroupSpec{}, err
+	}
+
+	protocol := string(types.TargetGroupProtocolHttp)
+	if alb.Spec.Protocol != nil {
+		protocol = *alb.Spec.Protocol
+	}
+	protocolVersion := string(types.TargetGroupProtocolVersionHttp1)
+	if alb.Spec.ProtocolVersion != nil {
+		protocolVersion = *alb.Spec.ProtocolVersion
+	}
+
+	spec := model.TargetGroupSpec{
+		Type:            model.TargetGroupTypeAlb,
+		Port:            alb.Spec.Port,
+		Protocol:        protocol,
+		ProtocolVersion: protocolVersion,
+	}
+	spec.VpcId = config.VpcID
+	spec.K8SSourceType = model.SourceTypeHTTPRoute
+	spec.K8SClusterName = config.ClusterName
+	spec.K8SServiceName = alb.Name
+	spec.K8SServiceNamespace = alb.Namespace
 	spec.K8SRouteName = t.route.Name()
 	spec.K8SRouteNamespace = t.route.Namespace()
 	spec.K8SProtocolVersion = protocolVersion
@@ -519,6 +719,50 @@ func (t *backendRefTargetGroupModelBuildTask) buildTargetGroupSpec(ctx context.C
 	return spec, nil
 }
 
+// buildAlbTargets registers the load balancer ARN as the single target of an ALB target group
+func (t *backendRefTargetGroupModelBuildTask) buildAlbTargets(ctx context.Context, stackTgId string) error {
+	alb := &anv1alpha1.ApplicationLoadBalancer{}
+	if err := t.getHttpRouteBackend(ctx, alb); err != nil {
+		return err
+	}
+
+	_, err := model.NewTargets(t.stack, model.TargetsSpec{
+		StackTargetGroupId: stackTgId,
+		TargetList: []model.Target{
+			{
+				TargetIP: alb.Spec.LoadBalancerArn,
+				Port:     int64(alb.Spec.Port),
+				Ready:    true,
+			},
+		},
+	})
+	return err
+}
+
+// getHttpRouteBackend gets the object of a backendRef kind that is only supported by HTTPRoute,
+// returning InvalidBackendRefError for other routes or when the object does not exist
+func (t *backendRefTargetGroupModelBuildTask) getHttpRouteBackend(ctx context.Context, obj client.Object) error {
+	kind := string(*t.backendRef.Kind())
+	if _, ok := t.route.(*core.HTTPRoute); !ok {
+		return &InvalidBackendRefError{
+			BackendRef: t.backendRef,
+			Reason:     fmt.Sprintf("%s backendRef on route %s is only supported by HTTPRoute", kind, t.route.Name()),
+		}
+	}
+
+	backendRefNsName := getBackendRefNsName(t.route, t.backendRef)
+	if err := t.client.Get(ctx, backendRefNsName, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return &InvalidBackendRefError{
+				BackendRef: t.backendRef,
+				Reason:     fmt.Sprintf("%s %s on route %s not found, backendRef invalid", kind, backendRefNsName.Name, t.route.Name()),
+			}
+		}
+		return fmt.Errorf("error finding backend %s %s due to %s", kind, backendRefNsName, err)
+	}
+	return nil
+}
+
 func getBackendRefNsName(route core.Route, backendRef core.BackendRef) apitypes.NamespacedName {
 	var namespace = route.Namespace()
 	if backendRef.Namespace() != nil {
@@ -557,6 +801,13 @@ func parseTargetGroupConfig(tgp *anv1alpha1.TargetGroupPolicy) (
 	return protocol, protocolVersion, healthCheckConfig, nil
 }
 
+func parseDrainingTimeout(tgp *anv1alpha1.TargetGroupPolicy) int64 {
+	if tgp == nil || tgp.Spec.DrainingTimeoutSeconds == nil {
+		return 0
+	}
+	return *tgp.Spec.DrainingTimeoutSeconds
+}
+
 func parseHealthCheckConfig(tgp *anv1alpha1.TargetGroupPolicy) *types.HealthCheckConfig {
 	hc := tgp.Spec.HealthCheck
 	if hc == nil {
@@ -600,6 +851,29 @@ func parseHealthCheckConfig(tgp *anv1alpha1.TargetGroupPolicy) *types.HealthChec
 	return cfg
 }
 
+// buildTargetGroupType returns the target group type selected by the policy, IP unless it asks for INSTANCE.
+// INSTANCE target groups register the nodes hosting the service endpoints on the service NodePort, so the
+// service must have one. The service is not validated when nil
+func buildTargetGroupType(tgp *anv1alpha1.TargetGroupPolicy, svc *corev1.Service) (model.TargetGroupType, error) {
+	if tgp == nil || tgp.Spec.TargetType == nil || *tgp.Spec.TargetType != string(model.TargetGroupTypeInstance) {
+		return model.TargetGroupTypeIP, nil
+	}
+	if svc != nil && svc.Spec.Type != corev1.ServiceTypeNodePort && svc.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return "", fmt.Errorf("INSTANCE target type requires a NodePort or LoadBalancer service, service %s/%s is %s",
+			svc.Namespace, svc.Name, svc.Spec.Type)
+	}
+	return model.TargetGroupTypeInstance, nil
+}
+
+// servicePortName returns the name of a service port, the section policies can be attached to
+func servicePortName(svc *corev1.Service, port int32) string {
+	servicePort := findServicePort(svc, port)
+	if servicePort == nil {
+		return ""
+	}
+	return servicePort.Name
+}
+
 func buildTargetGroupIpAddressType(svc *corev1.Service) (string, error) {
 	ipFamilies := svc.Spec.IPFamilies
 
@@ -16,6 +16,7 @@ import (
 
 	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -24,6 +25,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	anv1alpha1 "github.com/aws/aws-application-networking-k8s/pkg/apis/applicationnetworking/v1alpha1"
@@ -778,6 +780,224 @@ func Test_ServiceImportToTGBuildReturnsError(t *testing.T) {
 	}
 }
 
+func Test_LambdaFunctionToTGBuild(t *testing.T) {
+	config.VpcID = "vpc-id"
+	config.ClusterName = "cluster-name"
+
+	groupPtr := func(g string) *gwv1.Group {
+		p := gwv1.Group(g)
+		return &p
+	}
+
+	kindPtr := func(k string) *gwv1.Kind {
+		p := gwv1.Kind(k)
+		return &p
+	}
+
+	lambdaBackendRef := gwv1.BackendRef{
+		BackendObjectReference: gwv1.BackendObjectReference{
+			Name:  "hello",
+			Group: groupPtr(anv1alpha1.GroupName),
+			Kind:  kindPtr(anv1alpha1.LambdaFunctionKind),
+		},
+	}
+
+	tests := []struct {
+		name                  string
+		route                 core.Route
+		eventStructureVersion *string
+		lambdaFunctionExists  bool
+		wantEventVersion      string
+		wantInvalidBackendRef bool
+	}{
+		{
+			name: "HTTPRoute builds lambda target group with event structure version",
+			route: core.NewHTTPRoute(gwv1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Name: "route1", Namespace: "default"},
+				Spec: gwv1.HTTPRouteSpec{
+					Rules: []gwv1.HTTPRouteRule{{BackendRefs: []gwv1.HTTPBackendRef{{BackendRef: lambdaBackendRef}}}},
+				},
+			}),
+			eventStructureVersion: aws.String("V1"),
+			lambdaFunctionExists:  true,
+			wantEventVersion:      "V1",
+		},
+		{
+			name: "HTTPRoute defaults event structure version to V2",
+			route: core.NewHTTPRoute(gwv1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Name: "route1", Namespace: "default"},
+				Spec: gwv1.HTTPRouteSpec{
+					Rules: []gwv1.HTTPRouteRule{{BackendRefs: []gwv1.HTTPBackendRef{{BackendRef: lambdaBackendRef}}}},
+				},
+			}),
+			lambdaFunctionExists: true,
+			wantEventVersion:     "V2",
+		},
+		{
+			name: "missing LambdaFunction is an invalid backendRef",
+			route: core.NewHTTPRoute(gwv1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Name: "route1", Namespace: "default"},
+				Spec: gwv1.HTTPRouteSpec{
+					Rules: []gwv1.HTTPRouteRule{{BackendRefs: []gwv1.HTTPBackendRef{{BackendRef: lambdaBackendRef}}}},
+				},
+			}),
+			wantInvalidBackendRef: true,
+		},
+		{
+			name: "GRPCRoute does not support LambdaFunction backendRefs",
+			route: core.NewGRPCRoute(gwv1.GRPCRoute{
+				ObjectMeta: metav1.ObjectMeta{Name: "route1", Namespace: "default"},
+				Spec: gwv1.GRPCRouteSpec{
+					Rules: []gwv1.GRPCRouteRule{{BackendRefs: []gwv1.GRPCBackendRef{{BackendRef: lambdaBackendRef}}}},
+				},
+			}),
+			lambdaFunctionExists:  true,
+			wantInvalidBackendRef: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			anv1alpha1.Install(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+
+			functionArn := "arn:aws:lambda:us-west-2:123456789012:function:hello"
+			if tt.lambdaFunctionExists {
+				assert.NoError(t, k8sClient.Create(ctx, &anv1alpha1.LambdaFunction{
+					ObjectMeta: metav1.ObjectMeta{Name: "hello", Namespace: "default"},
+					Spec: anv1alpha1.LambdaFunctionSpec{
+						FunctionArn:           functionArn,
+						EventStructureVersion: tt.eventStructureVersion,
+					},
+				}))
+			}
+
+			stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(tt.route.K8sObject())))
+			backendRef := tt.route.Spec().Rules()[0].BackendRefs()[0]
+
+			builder := NewBackendRefTargetGroupBuilder(gwlog.FallbackLogger, k8sClient)
+			_, stackTg, err := builder.Build(ctx, tt.route, backendRef, stack)
+			if tt.wantInvalidBackendRef {
+				ibre := &InvalidBackendRefError{}
+				assert.ErrorAs(t, err, &ibre)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, model.TargetGroupTypeLambda, stackTg.Spec.Type)
+			assert.Equal(t, tt.wantEventVersion, stackTg.Spec.LambdaEventStructureVersion)
+			assert.Equal(t, "", stackTg.Spec.VpcId)
+			assert.Equal(t, int32(0), stackTg.Spec.Port)
+			assert.Equal(t, "", stackTg.Spec.Protocol)
+			assert.Equal(t, "hello", stackTg.Spec.K8SServiceName)
+			assert.Equal(t, "default", stackTg.Spec.K8SServiceNamespace)
+			assert.Equal(t, "route1", stackTg.Spec.K8SRouteName)
+			assert.Equal(t, model.SourceTypeHTTPRoute, stackTg.Spec.K8SSourceType)
+
+			var stackTargets []*model.Targets
+			assert.NoError(t, stack.ListResources(&stackTargets))
+			assert.Len(t, stackTargets, 1)
+			assert.Equal(t, stackTg.ID(), stackTargets[0].Spec.StackTargetGroupId)
+			assert.Equal(t, []model.Target{{TargetIP: functionArn, Ready: true}}, stackTargets[0].Spec.TargetList)
+		})
+	}
+}
+
+func Test_ApplicationLoadBalancerToTGBuild(t *testing.T) {
+	config.VpcID = "vpc-id"
+	config.ClusterName = "cluster-name"
+
+	group := gwv1.Group(anv1alpha1.GroupName)
+	kind := gwv1.Kind(anv1alpha1.ApplicationLoadBalancerKind)
+	route := core.NewHTTPRoute(gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "route1", Namespace: "default"},
+		Spec: gwv1.HTTPRouteSpec{
+			Rules: []gwv1.HTTPRouteRule{
+				{
+					BackendRefs: []gwv1.HTTPBackendRef{
+						{
+							BackendRef: gwv1.BackendRef{
+								BackendObjectReference: gwv1.BackendObjectReference{
+									Name:  "legacy",
+									Group: &group,
+									Kind:  &kind,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	albArn := "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/legacy/50dc6c495c0c9188"
+
+	tests := []struct {
+		name                string
+		spec                anv1alpha1.ApplicationLoadBalancerSpec
+		wantProtocol        string
+		wantProtocolVersion string
+	}{
+		{
+			name:                "defaults to HTTP and HTTP1",
+			spec:                anv1alpha1.ApplicationLoadBalancerSpec{LoadBalancerArn: albArn, Port: 80},
+			wantProtocol:        "HTTP",
+			wantProtocolVersion: "HTTP1",
+		},
+		{
+			name: "HTTPS listener with HTTP2",
+			spec: anv1alpha1.ApplicationLoadBalancerSpec{
+				LoadBalancerArn: albArn,
+				Port:            443,
+				Protocol:        aws.String("HTTPS"),
+				ProtocolVersion: aws.String("HTTP2"),
+			},
+			wantProtocol:        "HTTPS",
+			wantProtocolVersion: "HTTP2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			anv1alpha1.Install(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			assert.NoError(t, k8sClient.Create(ctx, &anv1alpha1.ApplicationLoadBalancer{
+				ObjectMeta: metav1.ObjectMeta{Name: "legacy", Namespace: "default"},
+				Spec:       tt.spec,
+			}))
+
+			stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(route.K8sObject())))
+			backendRef := route.Spec().Rules()[0].BackendRefs()[0]
+
+			builder := NewBackendRefTargetGroupBuilder(gwlog.FallbackLogger, k8sClient)
+			_, stackTg, err := builder.Build(ctx, route, backendRef, stack)
+			assert.NoError(t, err)
+
+			assert.Equal(t, model.TargetGroupTypeAlb, stackTg.Spec.Type)
+			assert.Equal(t, "vpc-id", stackTg.Spec.VpcId)
+			assert.Equal(t, tt.spec.Port, stackTg.Spec.Port)
+			assert.Equal(t, tt.wantProtocol, stackTg.Spec.Protocol)
+			assert.Equal(t, tt.wantProtocolVersion, stackTg.Spec.ProtocolVersion)
+			assert.Equal(t, tt.wantProtocolVersion, stackTg.Spec.K8SProtocolVersion)
+			assert.Equal(t, "", stackTg.Spec.IpAddressType)
+			assert.Equal(t, "legacy", stackTg.Spec.K8SServiceName)
+			assert.Equal(t, model.SourceTypeHTTPRoute, stackTg.Spec.K8SSourceType)
+
+			var stackTargets []*model.Targets
+			assert.NoError(t, stack.ListResources(&stackTargets))
+			assert.Len(t, stackTargets, 1)
+			assert.Equal(t, []model.Target{{TargetIP: albArn, Port: int64(tt.spec.Port), Ready: true}}, stackTargets[0].Spec.TargetList)
+		})
+	}
+}
+
 func Test_TGModelByServiceExportWithExportedPorts(t *testing.T) {
 	config.VpcID = "vpc-id"
 	config.ClusterName = "cluster-name"
@@ -1619,6 +1839,104 @@ func Test_TGModelByServiceExportWithExportedPorts_TargetGroupPolicy(t *testing.T
 			wantProtocolVersion: string(types.TargetGroupProtocolVersionHttp1),
 			description:         "should use HTTPS protocol with HTTP1 from TargetGroupPolicy",
 		},
+		{
+			name: "ServiceExport with TargetGroupPolicy for the exported port name",
+			svcExport: &anv1alpha1.ServiceExport{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "section-http",
+					Namespace: "bar",
+				},
+				Spec: anv1alpha1.ServiceExportSpec{
+					ExportedPorts: []anv1alpha1.ExportedPort{
+						{
+							Port:      80,
+							RouteType: "HTTP",
+						},
+					},
+				},
+			},
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "section-http",
+					Namespace: "bar",
+				},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{
+						{Name: "http", Port: 80},
+						{Name: "admin", Port: 9090},
+					},
+					IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol},
+				},
+			},
+			tgp: &anv1alpha1.TargetGroupPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "section-http",
+					Namespace: "bar",
+				},
+				Spec: anv1alpha1.TargetGroupPolicySpec{
+					TargetRef: &gwv1alpha2.NamespacedPolicyTargetReference{
+						Group: "application-networking.k8s.aws",
+						Kind:  "ServiceExport",
+						Name:  "section-http",
+					},
+					SectionName: func() *gwv1alpha2.SectionName { s := gwv1alpha2.SectionName("http"); return &s }(),
+					Protocol:    func() *string { s := string(types.TargetGroupProtocolHttps); return &s }(),
+				},
+			},
+			wantErrIsNil:        true,
+			wantProtocol:        string(types.TargetGroupProtocolHttps),
+			wantProtocolVersion: string(types.TargetGroupProtocolVersionHttp1),
+			description:         "should apply the TargetGroupPolicy of the exported port",
+		},
+		{
+			name: "ServiceExport with TargetGroupPolicy for another port name",
+			svcExport: &anv1alpha1.ServiceExport{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "section-admin",
+					Namespace: "bar",
+				},
+				Spec: anv1alpha1.ServiceExportSpec{
+					ExportedPorts: []anv1alpha1.ExportedPort{
+						{
+							Port:      80,
+							RouteType: "HTTP",
+						},
+					},
+				},
+			},
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "section-admin",
+					Namespace: "bar",
+				},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{
+						{Name: "http", Port: 80},
+						{Name: "admin", Port: 9090},
+					},
+					IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol},
+				},
+			},
+			tgp: &anv1alpha1.TargetGroupPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "section-admin",
+					Namespace: "bar",
+				},
+				Spec: anv1alpha1.TargetGroupPolicySpec{
+					TargetRef: &gwv1alpha2.NamespacedPolicyTargetReference{
+						Group: "application-networking.k8s.aws",
+						Kind:  "ServiceExport",
+						Name:  "section-admin",
+					},
+					SectionName: func() *gwv1alpha2.SectionName { s := gwv1alpha2.SectionName("admin"); return &s }(),
+					Protocol:    func() *string { s := string(types.TargetGroupProtocolHttps); return &s }(),
+				},
+			},
+			wantErrIsNil:        true,
+			wantProtocol:        string(types.TargetGroupProtocolHttp),
+			wantProtocolVersion: string(types.TargetGroupProtocolVersionHttp1),
+			description:         "should not apply the TargetGroupPolicy of another port",
+		},
 	}
 
 	for _, tt := range tests {
@@ -1661,3 +1979,273 @@ func Test_TGModelByServiceExportWithExportedPorts_TargetGroupPolicy(t *testing.T
 		})
 	}
 }
+
+func Test_InstanceTargetGroupBuild(t *testing.T) {
+	config.VpcID = "vpc-id"
+	config.ClusterName = "cluster-name"
+
+	kind := gwv1.Kind("Service")
+	route := core.NewHTTPRoute(gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "route1", Namespace: "default"},
+		Spec: gwv1.HTTPRouteSpec{
+			Rules: []gwv1.HTTPRouteRule{
+				{
+					BackendRefs: []gwv1.HTTPBackendRef{
+						{
+							BackendRef: gwv1.BackendRef{
+								BackendObjectReference: gwv1.BackendObjectReference{
+									Name: "svc1",
+									Kind: &kind,
+									Port: PortNumberPtr(80),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	tgp := &anv1alpha1.TargetGroupPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "instance-targets", Namespace: "default"},
+		Spec: anv1alpha1.TargetGroupPolicySpec{
+			TargetRef: &gwv1alpha2.NamespacedPolicyTargetReference{
+				Kind: "Service",
+				Name: "svc1",
+			},
+			TargetType: aws.String("INSTANCE"),
+		},
+	}
+	epSlice := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "svc1-abc",
+			Namespace: "default",
+			Labels:    map[string]string{discoveryv1.LabelServiceName: "svc1"},
+		},
+		Ports: []discoveryv1.EndpointPort{{Port: aws.Int32(8080)}},
+		Endpoints: []discoveryv1.Endpoint{
+			{
+				Addresses:  []string{"192.168.0.1"},
+				Conditions: discoveryv1.EndpointConditions{Ready: aws.Bool(true)},
+				NodeName:   aws.String("node-1"),
+			},
+			{
+				Addresses:  []string{"192.168.0.2"},
+				Conditions: discoveryv1.EndpointConditions{Ready: aws.Bool(false)},
+				NodeName:   aws.String("node-1"),
+			},
+			{
+				Addresses:  []string{"192.168.0.3"},
+				Conditions: discoveryv1.EndpointConditions{Ready: aws.Bool(false)},
+				NodeName:   aws.String("node-2"),
+			},
+			{
+				Addresses:  []string{"192.168.0.4"},
+				Conditions: discoveryv1.EndpointConditions{Ready: aws.Bool(false), Terminating: aws.Bool(true)},
+				NodeName:   aws.String("node-3"),
+			},
+			{
+				Addresses:  []string{"192.168.0.5"},
+				Conditions: discoveryv1.EndpointConditions{Ready: aws.Bool(true)},
+				NodeName:   aws.String("fargate-node"),
+			},
+		},
+	}
+	nodes := []*corev1.Node{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+			Spec:       corev1.NodeSpec{ProviderID: "aws:///us-west-2a/i-0000000000000001"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-2"},
+			Spec:       corev1.NodeSpec{ProviderID: "aws:///us-west-2b/i-0000000000000002"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-3"},
+			Spec:       corev1.NodeSpec{ProviderID: "aws:///us-west-2c/i-0000000000000003"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "fargate-node"},
+			Spec:       corev1.NodeSpec{ProviderID: "aws:///us-west-2a/fargate-ip-192-168-0-5"},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		serviceType corev1.ServiceType
+		nodePort    int32
+		wantErr     bool
+	}{
+		{
+			name:        "NodePort service registers the nodes hosting endpoints",
+			serviceType: corev1.ServiceTypeNodePort,
+			nodePort:    30080,
+		},
+		{
+			name:        "LoadBalancer service registers the nodes hosting endpoints",
+			serviceType: corev1.ServiceTypeLoadBalancer,
+			nodePort:    30080,
+		},
+		{
+			name:        "ClusterIP service is rejected",
+			serviceType: corev1.ServiceTypeClusterIP,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			anv1alpha1.Install(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			assert.NoError(t, k8sClient.Create(ctx, &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "svc1", Namespace: "default"},
+				Spec: corev1.ServiceSpec{
+					Type:       tt.serviceType,
+					Ports:      []corev1.ServicePort{{Port: 80, NodePort: tt.nodePort}},
+					IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol},
+				},
+			}))
+			assert.NoError(t, k8sClient.Create(ctx, tgp.DeepCopy()))
+			assert.NoError(t, k8sClient.Create(ctx, epSlice.DeepCopy()))
+			for _, node := range nodes {
+				assert.NoError(t, k8sClient.Create(ctx, node.DeepCopy()))
+			}
+
+			stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(route.K8sObject())))
+			backendRef := route.Spec().Rules()[0].BackendRefs()[0]
+
+			builder := NewBackendRefTargetGroupBuilder(gwlog.FallbackLogger, k8sClient)
+			_, stackTg, err := builder.Build(ctx, route, backendRef, stack)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, model.TargetGroupTypeInstance, stackTg.Spec.Type)
+			assert.Equal(t, "", stackTg.Spec.IpAddressType)
+			assert.Equal(t, "HTTP", stackTg.Spec.Protocol)
+			assert.NoError(t, stackTg.Spec.Validate())
+
+			var stackTargets []*model.Targets
+			assert.NoError(t, stack.ListResources(&stackTargets))
+			assert.Len(t, stackTargets, 1)
+			assert.Equal(t, []model.Target{
+				{TargetIP: "i-0000000000000001", Port: int64(tt.nodePort), Ready: true},
+				{TargetIP: "i-0000000000000002", Port: int64(tt.nodePort), Ready: false},
+			}, stackTargets[0].Spec.TargetList)
+		})
+	}
+}
+
+func Test_SharedTargetGroupBuild(t *testing.T) {
+	config.VpcID = "vpc-id"
+	config.ClusterName = "cluster-name"
+
+	kind := gwv1.Kind("Service")
+	newRoute := func(name string, port int) core.Route {
+		return core.NewHTTPRoute(gwv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: gwv1.HTTPRouteSpec{
+				Rules: []gwv1.HTTPRouteRule{
+					{
+						BackendRefs: []gwv1.HTTPBackendRef{
+							{
+								BackendRef: gwv1.BackendRef{
+									BackendObjectReference: gwv1.BackendObjectReference{
+										Name: "svc1",
+										Kind: &kind,
+										Port: PortNumberPtr(port),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+	}
+
+	buildSpec := func(t *testing.T, k8sClient client.Client, route core.Route) *model.TargetGroup {
+		stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(route.K8sObject())))
+		builder := NewBackendRefTargetGroupBuilder(gwlog.FallbackLogger, k8sClient)
+		_, stackTg, err := builder.Build(context.TODO(), route, route.Spec().Rules()[0].BackendRefs()[0], stack)
+		assert.NoError(t, err)
+		assert.NoError(t, stackTg.Spec.Validate())
+		return stackTg
+	}
+
+	newClient := func(annotations map[string]string) client.Client {
+		k8sSchema := runtime.NewScheme()
+		clientgoscheme.AddToScheme(k8sSchema)
+		anv1alpha1.Install(k8sSchema)
+		return testclient.NewClientBuilder().WithScheme(k8sSchema).WithObjects(&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "svc1", Namespace: "default", Annotations: annotations},
+			Spec: corev1.ServiceSpec{
+				Ports:      []corev1.ServicePort{{Name: "http", Port: 80}, {Name: "admin", Port: 81}},
+				IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol},
+			},
+		}).Build()
+	}
+
+	t.Run("routes referencing the same service port share a target group", func(t *testing.T) {
+		k8sClient := newClient(map[string]string{k8s.SharedTargetGroupAnnotation: "true"})
+
+		tg1 := buildSpec(t, k8sClient, newRoute("route1", 80))
+		tg2 := buildSpec(t, k8sClient, newRoute("route2", 80))
+		tg3 := buildSpec(t, k8sClient, newRoute("route3", 81))
+
+		assert.True(t, tg1.Spec.IsShared())
+		assert.True(t, strings.HasPrefix(tg1.Spec.K8SSharedKey, "80-"))
+		assert.Equal(t, "", tg1.Spec.K8SRouteName)
+		assert.Equal(t, "", tg1.Spec.K8SRouteNamespace)
+		assert.Equal(t, tg1.Spec, tg2.Spec)
+		assert.Equal(t, tg1.ID(), tg2.ID())
+
+		assert.True(t, strings.HasPrefix(tg3.Spec.K8SSharedKey, "81-"))
+		assert.NotEqual(t, tg1.ID(), tg3.ID())
+	})
+
+	t.Run("the shared target group key depends on the policy health check", func(t *testing.T) {
+		route := newRoute("route1", 80)
+		backendRef := route.Spec().Rules()[0].BackendRefs()[0]
+		spec := model.TargetGroupSpec{Protocol: "HTTP", ProtocolVersion: "HTTP1"}
+		key, err := sharedTargetGroupKey(backendRef, spec, nil)
+		assert.NoError(t, err)
+
+		policyKey, err := sharedTargetGroupKey(backendRef, spec, &types.HealthCheckConfig{Path: aws.String("/healthz")})
+		assert.NoError(t, err)
+		assert.NotEqual(t, key, policyKey)
+
+		// the health check derived from readiness probes changes while pods roll out, only its use is part of the key
+		spec.ReadinessProbe = &model.ReadinessProbeHealthCheck{Port: 80}
+		probeKey, err := sharedTargetGroupKey(backendRef, spec, nil)
+		assert.NoError(t, err)
+		assert.NotEqual(t, key, probeKey)
+
+		spec.HealthCheckConfig = &types.HealthCheckConfig{Path: aws.String("/ready")}
+		spec.ReadinessProbe.HealthCheckConfig = spec.HealthCheckConfig
+		changedProbeKey, err := sharedTargetGroupKey(backendRef, spec, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, probeKey, changedProbeKey)
+
+		spec = model.TargetGroupSpec{Protocol: "HTTP", ProtocolVersion: "GRPC"}
+		grpcKey, err := sharedTargetGroupKey(backendRef, spec, nil)
+		assert.NoError(t, err)
+		assert.NotEqual(t, key, grpcKey)
+	})
+
+	t.Run("routes get their own target groups by default", func(t *testing.T) {
+		k8sClient := newClient(nil)
+
+		tg1 := buildSpec(t, k8sClient, newRoute("route1", 80))
+		tg2 := buildSpec(t, k8sClient, newRoute("route2", 80))
+
+		assert.False(t, tg1.Spec.IsShared())
+		assert.Equal(t, "route1", tg1.Spec.K8SRouteName)
+		assert.NotEqual(t, tg1.ID(), tg2.ID())
+	})
+}
@@ -3,10 +3,13 @@ package gateway
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -58,6 +61,42 @@ func (b *LatticeTargetsModelBuilder) BuildForServiceExport(ctx context.Context,
 	return b.build(ctx, serviceExport, nil, nil, b.stack, stackTgId)
 }
 
+// BuildForSource rebuilds the targets of a target group from the source they were last built from,
+// without going through the route or service export of the target group
+func (b *LatticeTargetsModelBuilder) BuildForSource(ctx context.Context,
+	source *model.TargetsSource, stackTgId string) (core.Stack, error) {
+
+	service := &corev1.Service{}
+	if err := b.client.Get(ctx, source.Service, service); err != nil {
+		return nil, err
+	}
+
+	stack := b.stack
+	if stack == nil {
+		stack = core.NewDefaultStack(core.StackID(source.Service))
+	}
+
+	if !service.DeletionTimestamp.IsZero() {
+		b.log.Debugf(ctx, "service %s/%s is deleted, skipping target build", service.Name, service.Namespace)
+		return stack, nil
+	}
+
+	task := &latticeTargetsModelBuildTask{
+		log:         b.log,
+		client:      b.client,
+		service:     service,
+		sourcePorts: source.Ports,
+		stack:       stack,
+		stackTgId:   stackTgId,
+	}
+
+	if err := task.run(ctx); err != nil {
+		return nil, err
+	}
+
+	return task.stack, nil
+}
+
 func (b *LatticeTargetsModelBuilder) build(ctx context.Context,
 	serviceExport *anv1alpha1.ServiceExport,
 	service *corev1.Service, backendRef core.BackendRef,
@@ -153,7 +192,11 @@ func (t *latticeTargetsModelBuildTask) buildLatticeTargets(ctx context.Context)
 	var targetList []model.Target
 	if t.service.DeletionTimestamp.IsZero() {
 		var err error
-		targetList, err = t.getTargetListFromEndpoints(ctx, servicePortNames, skipMatch)
+		if t.isInstanceTargetGroup() {
+			targetList, err = t.getInstanceTargetList(ctx, definedPorts, skipMatch)
+		} else {
+			targetList, err = t.getTargetListFromEndpoints(ctx, servicePortNames, skipMatch)
+		}
 		if err != nil {
 			return err
 		}
@@ -164,11 +207,21 @@ func (t *latticeTargetsModelBuildTask) buildLatticeTargets(ctx context.Context)
 		TargetList:         targetList,
 	}
 
-	_, err := model.NewTargets(t.stack, spec)
+	targets, err := model.NewTargets(t.stack, spec)
 	if err != nil {
 		return err
 	}
 
+	sourcePorts := make([]int32, 0, len(definedPorts))
+	for port := range definedPorts {
+		sourcePorts = append(sourcePorts, port)
+	}
+	sort.Slice(sourcePorts, func(i, j int) bool { return sourcePorts[i] < sourcePorts[j] })
+	targets.Source = &model.TargetsSource{
+		Service: k8s.NamespacedName(t.service),
+		Ports:   sourcePorts,
+	}
+
 	return nil
 }
 
@@ -197,15 +250,18 @@ func (t *latticeTargetsModelBuildTask) getTargetListFromEndpoints(ctx context.Co
 						aws.ToBool(ep.Conditions.Terminating))
 
 					for _, address := range ep.Addresses {
-						// Do not model terminating endpoints so that they can deregister.
-						if aws.ToBool(ep.Conditions.Terminating) {
+						// Terminating endpoints deregister. The ones still serving are modeled as draining,
+						// so that their pods can be held until VPC Lattice has drained their connections.
+						draining := aws.ToBool(ep.Conditions.Terminating)
+						if draining && !aws.ToBool(ep.Conditions.Serving) {
 							t.log.Debugf(ctx, "Skipping terminating endpoint %s", address)
 							continue
 						}
 						target := model.Target{
 							TargetIP: address,
 							Port:     int64(aws.ToInt32(port.Port)),
-							Ready:    aws.ToBool(ep.Conditions.Ready),
+							Ready:    aws.ToBool(ep.Conditions.Ready) && !draining,
+							Draining: draining,
 						}
 						if ep.TargetRef != nil && ep.TargetRef.Kind == "Pod" {
 							target.TargetRef = types.NamespacedName{Namespace: ep.TargetRef.Namespace, Name: ep.TargetRef.Name}
@@ -223,6 +279,80 @@ func (t *latticeTargetsModelBuildTask) getTargetListFromEndpoints(ctx context.Co
 	return targetList, nil
 }
 
+func (t *latticeTargetsModelBuildTask) isInstanceTargetGroup() bool {
+	tg := &model.TargetGroup{}
+	if err := t.stack.GetResource(t.stackTgId, tg); err != nil {
+		return false
+	}
+	return tg.Spec.Type == model.TargetGroupTypeInstance
+}
+
+// getInstanceTargetList builds the targets of an INSTANCE target group, the EC2 instances of the nodes
+// hosting the service endpoints, registered on the NodePort of each matching service port
+func (t *latticeTargetsModelBuildTask) getInstanceTargetList(ctx context.Context, definedPorts map[int32]struct{}, skipMatch bool) ([]model.Target, error) {
+	var nodePorts []int32
+	for _, port := range t.service.Spec.Ports {
+		if _, ok := definedPorts[port.Port]; (ok || skipMatch) && port.NodePort != 0 {
+			nodePorts = append(nodePorts, port.NodePort)
+		}
+	}
+	if len(nodePorts) == 0 {
+		return nil, fmt.Errorf("service %s/%s has no NodePort for INSTANCE targets", t.service.Namespace, t.service.Name)
+	}
+
+	epSlices := &discoveryv1.EndpointSliceList{}
+	if err := t.client.List(ctx, epSlices,
+		client.InNamespace(t.service.Namespace),
+		client.MatchingLabels{discoveryv1.LabelServiceName: t.service.Name}); err != nil {
+		return nil, err
+	}
+
+	// a node is ready as long as one of its endpoints is
+	nodeReady := make(map[string]bool)
+	for _, epSlice := range epSlices.Items {
+		for _, ep := range epSlice.Endpoints {
+			// Do not model terminating endpoints so that their nodes can deregister.
+			if ep.NodeName == nil || aws.ToBool(ep.Conditions.Terminating) {
+				continue
+			}
+			nodeReady[*ep.NodeName] = nodeReady[*ep.NodeName] || aws.ToBool(ep.Conditions.Ready)
+		}
+	}
+
+	nodeNames := make([]string, 0, len(nodeReady))
+	for nodeName := range nodeReady {
+		nodeNames = append(nodeNames, nodeName)
+	}
+	sort.Strings(nodeNames)
+
+	var targetList []model.Target
+	for _, nodeName := range nodeNames {
+		node := &corev1.Node{}
+		if err := t.client.Get(ctx, types.NamespacedName{Name: nodeName}, node); err != nil {
+			if apierrors.IsNotFound(err) {
+				t.log.Debugf(ctx, "Skipping endpoints on node %s, node not found", nodeName)
+				continue
+			}
+			return nil, err
+		}
+		instanceId, err := k8s.InstanceIdFromProviderId(node.Spec.ProviderID)
+		if err != nil {
+			t.log.Infof(ctx, "Skipping endpoints on node %s, %s", nodeName, err)
+			continue
+		}
+		for _, nodePort := range nodePorts {
+			t.log.Debugf(ctx, "Adding target %s:%d for node %s", instanceId, nodePort, nodeName)
+			targetList = append(targetList, model.Target{
+				TargetIP: instanceId,
+				Port:     int64(nodePort),
+				Ready:    nodeReady[nodeName],
+			})
+		}
+	}
+	t.log.Debugf(ctx, "Built %d instance targets for service %s/%s", len(targetList), t.service.Namespace, t.service.Name)
+	return targetList, nil
+}
+
 func (t *latticeTargetsModelBuildTask) getDefinedPorts() map[int32]struct{} {
 	definedPorts := make(map[int32]struct{})
 
@@ -241,6 +371,10 @@ func (t *latticeTargetsModelBuildTask) getDefinedPorts() map[int32]struct{} {
 				}
 			}
 		}
+	} else if t.backendRef == nil {
+		for _, port := range t.sourcePorts {
+			definedPorts[port] = struct{}{}
+		}
 	} else if t.backendRef.Port() != nil {
 		backendRefPort := int32(*t.backendRef.Port())
 		if backendRefPort != undefinedPort {
@@ -256,6 +390,8 @@ type latticeTargetsModelBuildTask struct {
 	serviceExport *anv1alpha1.ServiceExport
 	service       *corev1.Service
 	backendRef    core.BackendRef
-	stack         core.Stack
-	stackTgId     string
+	// the service ports of targets rebuilt from their source, with no service export or backendRef
+	sourcePorts []int32
+	stack       core.Stack
+	stackTgId   string
 }
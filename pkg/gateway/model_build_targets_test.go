@@ -449,6 +449,67 @@ func Test_Targets(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Terminating endpoints still serving are modeled as draining",
+			port: 0,
+			endpointSlice: []discoveryv1.EndpointSlice{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns1",
+						Name:      "export8",
+						Labels:    map[string]string{discoveryv1.LabelServiceName: "export8"},
+					},
+					Ports: []discoveryv1.EndpointPort{
+						{Port: aws.Int32(8675)},
+					},
+					Endpoints: []discoveryv1.Endpoint{
+						{
+							Addresses: []string{"10.10.1.1"},
+							Conditions: discoveryv1.EndpointConditions{
+								Ready:       aws.Bool(false),
+								Serving:     aws.Bool(true),
+								Terminating: aws.Bool(true),
+							},
+							TargetRef: &corev1.ObjectReference{
+								Namespace: "ns1",
+								Name:      "pod1",
+								Kind:      "Pod",
+							},
+						},
+						{
+							Addresses: []string{"10.10.2.2"},
+							Conditions: discoveryv1.EndpointConditions{
+								Ready:       aws.Bool(false),
+								Serving:     aws.Bool(false),
+								Terminating: aws.Bool(true),
+							},
+							TargetRef: &corev1.ObjectReference{
+								Namespace: "ns1",
+								Name:      "pod2",
+								Kind:      "Pod",
+							},
+						},
+					},
+				},
+			},
+			svc: corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns1",
+					Name:      "export8",
+				},
+			},
+			refByService: true,
+			wantErrIsNil: true,
+			expectedTargetList: []model.Target{
+				{
+					TargetIP:  "10.10.1.1",
+					Port:      8675,
+					Ready:     false,
+					Draining:  true,
+					TargetRef: types.NamespacedName{Namespace: "ns1", Name: "pod1"},
+				},
+			},
+		},
 		{
 			name: "BackendRef port does not match service port",
 			port: 8750,
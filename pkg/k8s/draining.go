@@ -0,0 +1,57 @@
+package k8s
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// Holds the deletion of a terminating pod until VPC Lattice has drained the connections of its targets
+	PodDrainingFinalizer = "pod.k8s.aws/draining"
+
+	// Seconds the deletion of a pod holding PodDrainingFinalizer is held at most
+	DrainingTimeoutAnnotation = AnnotationPrefix + "draining-timeout-seconds"
+)
+
+// HoldPodDeletion adds the draining finalizer to a running pod, recording the longest draining timeout asked for
+func HoldPodDeletion(ctx context.Context, c client.Client, pod *corev1.Pod, timeoutSeconds int64) error {
+	if !pod.DeletionTimestamp.IsZero() {
+		return nil
+	}
+	current, _ := strconv.ParseInt(pod.Annotations[DrainingTimeoutAnnotation], 10, 64)
+	if HasFinalizer(pod, PodDrainingFinalizer) && current >= timeoutSeconds {
+		return nil
+	}
+
+	oldPod := pod.DeepCopy()
+	controllerutil.AddFinalizer(pod, PodDrainingFinalizer)
+	if current < timeoutSeconds {
+		if pod.Annotations == nil {
+			pod.Annotations = make(map[string]string)
+		}
+		pod.Annotations[DrainingTimeoutAnnotation] = strconv.FormatInt(timeoutSeconds, 10)
+	}
+	return c.Patch(ctx, pod, client.MergeFrom(oldPod))
+}
+
+// ReleasePodDeletion removes the draining finalizer of a pod
+func ReleasePodDeletion(ctx context.Context, c client.Client, pod *corev1.Pod) error {
+	if !HasFinalizer(pod, PodDrainingFinalizer) {
+		return nil
+	}
+	oldPod := pod.DeepCopy()
+	controllerutil.RemoveFinalizer(pod, PodDrainingFinalizer)
+	return c.Patch(ctx, pod, client.MergeFrom(oldPod))
+}
+
+// DrainingDeadline returns when the deletion of a terminating pod is released whether its targets
+// are drained or not, its deletion time plus the recorded draining timeout
+func DrainingDeadline(pod *corev1.Pod) time.Time {
+	timeoutSeconds, _ := strconv.ParseInt(pod.Annotations[DrainingTimeoutAnnotation], 10, 64)
+	return pod.DeletionTimestamp.Add(time.Duration(timeoutSeconds) * time.Second)
+}
@@ -0,0 +1,51 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestHoldPodDeletion(t *testing.T) {
+	ctx := context.TODO()
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "ns"}}
+	k8sClient := testclient.NewClientBuilder().WithObjects(pod).Build()
+
+	assert.NoError(t, HoldPodDeletion(ctx, k8sClient, pod, 60))
+	assert.True(t, HasFinalizer(pod, PodDrainingFinalizer))
+	assert.Equal(t, "60", pod.Annotations[DrainingTimeoutAnnotation])
+
+	// the longest timeout asked for is kept
+	assert.NoError(t, HoldPodDeletion(ctx, k8sClient, pod, 30))
+	assert.Equal(t, "60", pod.Annotations[DrainingTimeoutAnnotation])
+	assert.NoError(t, HoldPodDeletion(ctx, k8sClient, pod, 120))
+	assert.Equal(t, "120", pod.Annotations[DrainingTimeoutAnnotation])
+
+	stored := &corev1.Pod{}
+	assert.NoError(t, k8sClient.Get(ctx, NamespacedName(pod), stored))
+	assert.Equal(t, []string{PodDrainingFinalizer}, stored.Finalizers)
+
+	assert.NoError(t, ReleasePodDeletion(ctx, k8sClient, stored))
+	assert.NoError(t, k8sClient.Get(ctx, NamespacedName(pod), stored))
+	assert.Empty(t, stored.Finalizers)
+}
+
+func TestDrainingDeadline(t *testing.T) {
+	deletionTimestamp := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			DeletionTimestamp: &deletionTimestamp,
+			Annotations:       map[string]string{DrainingTimeoutAnnotation: "90"},
+		},
+	}
+	assert.Equal(t, deletionTimestamp.Add(90*time.Second), DrainingDeadline(pod))
+
+	// a missing timeout releases the pod at its deletion
+	pod.Annotations = nil
+	assert.Equal(t, deletionTimestamp.Time, DrainingDeadline(pod))
+}
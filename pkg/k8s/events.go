@@ -10,6 +10,9 @@ const (
 	GatewayEventReasonFailedAddFinalizer = "FailedAddFinalizer"
 	GatewayEventReasonFailedBuildModel   = "FailedBuildModel"
 	GatewayEventReasonFailedDeployModel  = "FailedDeployModel"
+	GatewayEventReasonDriftRepaired      = "ServiceNetworkDriftRepaired"
+	GatewayEventReasonProvisioned        = "ServiceNetworkProvisioned"
+	GatewayEventReasonRetained           = "ServiceNetworkRetained"
 
 	// Route events
 	RouteEventReasonReconcile          = "Reconcile"
@@ -20,9 +23,10 @@ const (
 	RouteEventReasonRetryReconcile     = "Retry-Reconcile"
 
 	// Service events
-	ServiceEventReasonFailedAddFinalizer = "FailedAddFinalizer"
-	ServiceEventReasonFailedBuildModel   = "FailedBuildModel"
-	ServiceEventReasonFailedDeployModel  = "FailedDeployModel"
+	ServiceEventReasonFailedAddFinalizer     = "FailedAddFinalizer"
+	ServiceEventReasonFailedBuildModel       = "FailedBuildModel"
+	ServiceEventReasonFailedDeployModel      = "FailedDeployModel"
+	ServiceEventReasonReadinessProbeConflict = "ReadinessProbeConflict"
 
 	// ServiceExport events
 	ServiceExportEventReasonFailedAddFinalizer = "FailedAddFinalizer"
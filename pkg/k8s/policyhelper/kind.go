@@ -28,6 +28,8 @@ func ObjToGroupKind(obj client.Object) GroupKind {
 		return GroupKind{anv1alpha1.GroupName, "ServiceExport"}
 	case *corev1.Service:
 		return GroupKind{corev1.GroupName, "Service"}
+	case *corev1.Namespace:
+		return GroupKind{corev1.GroupName, "Namespace"}
 	default:
 		return GroupKind{}
 	}
@@ -54,6 +56,20 @@ func GroupKindToObj(gk GroupKind) (client.Object, bool) {
 		return &corev1.Service{}, true
 	case GroupKind{anv1alpha1.GroupName, "ServiceExport"}:
 		return &anv1alpha1.ServiceExport{}, true
+	case GroupKind{corev1.GroupName, "Namespace"}:
+		return &corev1.Namespace{}, true
+	default:
+		return nil, false
+	}
+}
+
+// Only the kinds a policy targetSelector can select have a list
+func GroupKindToObjList(gk GroupKind) (client.ObjectList, bool) {
+	switch gk {
+	case GroupKind{corev1.GroupName, "Service"}:
+		return &corev1.ServiceList{}, true
+	case GroupKind{anv1alpha1.GroupName, "ServiceExport"}:
+		return &anv1alpha1.ServiceExportList{}, true
 	default:
 		return nil, false
 	}
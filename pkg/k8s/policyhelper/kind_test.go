@@ -20,6 +20,7 @@ func TestGroupKind(t *testing.T) {
 		{&gwv1.HTTPRoute{}, GroupKind{Group: gwv1.GroupName, Kind: "HTTPRoute"}},
 		{&gwv1.GRPCRoute{}, GroupKind{Group: gwv1.GroupName, Kind: "GRPCRoute"}},
 		{&corev1.Service{}, GroupKind{Group: corev1.GroupName, Kind: "Service"}},
+		{&corev1.Namespace{}, GroupKind{Group: corev1.GroupName, Kind: "Namespace"}},
 	}
 
 	t.Run("obj to kind", func(t *testing.T) {
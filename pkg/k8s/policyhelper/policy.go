@@ -1,6 +1,7 @@
 package policyhelper
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
@@ -10,6 +11,8 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -20,6 +23,7 @@ import (
 	"slices"
 
 	anv1alpha1 "github.com/aws/aws-application-networking-k8s/pkg/apis/applicationnetworking/v1alpha1"
+	"github.com/aws/aws-application-networking-k8s/pkg/k8s"
 	"github.com/aws/aws-application-networking-k8s/pkg/utils"
 	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
 )
@@ -28,6 +32,10 @@ var (
 	ErrGroupKind         = errors.New("group/kind error")
 	ErrTargetRefNotFound = errors.New("targetRef not found")
 	ErrTargetRefConflict = errors.New("targetRef has conflict")
+	ErrTargetRefMissing  = errors.New("targetRef missing")
+
+	// Not a validation failure, the policy is accepted but overridden on some of its targets
+	ErrTargetsOverridden = errors.New("overridden on some targets")
 )
 
 type (
@@ -73,7 +81,7 @@ func NewTargetGroupPolicyHandler(log gwlog.Logger, c k8sclient.Client) *PolicyHa
 	phcfg := PolicyHandlerConfig{
 		Log:            log,
 		Client:         c,
-		TargetRefKinds: NewGroupKindSet(&corev1.Service{}, &anv1alpha1.ServiceExport{}),
+		TargetRefKinds: NewGroupKindSet(&corev1.Service{}, &anv1alpha1.ServiceExport{}, &gwv1.Gateway{}, &corev1.Namespace{}),
 	}
 	return NewPolicyHandler[TGP, TGPL](phcfg)
 }
@@ -94,6 +102,30 @@ type Policy interface {
 	GetStatusConditions() *[]metav1.Condition
 }
 
+// SelectorPolicy is a Policy which can attach to the objects selected by their labels, instead of a targetRef
+type SelectorPolicy interface {
+	GetTargetSelector() *anv1alpha1.TargetSelector
+}
+
+// SectionPolicy is a Policy which can attach to a section of its targets only
+type SectionPolicy interface {
+	GetSectionName() *gwv1alpha2.SectionName
+}
+
+func policySelector(policy Policy) *anv1alpha1.TargetSelector {
+	if sp, ok := policy.(SelectorPolicy); ok {
+		return sp.GetTargetSelector()
+	}
+	return nil
+}
+
+func policySection(policy Policy) string {
+	if sp, ok := policy.(SectionPolicy); ok && sp.GetSectionName() != nil {
+		return string(*sp.GetSectionName())
+	}
+	return ""
+}
+
 type PolicyList[P Policy] interface {
 	k8sclient.ObjectList
 	GetItems() []P
@@ -140,6 +172,8 @@ type PolicyClient[P Policy] interface {
 	List(ctx context.Context, namespace string) ([]P, error)
 	Get(ctx context.Context, nsname types.NamespacedName) (P, error)
 	TargetRefObj(ctx context.Context, policy P) (k8sclient.Object, error)
+	TargetSelectorObjs(ctx context.Context, policy P) ([]k8sclient.Object, error)
+	GetObj(ctx context.Context, obj k8sclient.Object) error
 	UpdateStatus(ctx context.Context, policy P) error
 }
 
@@ -198,6 +232,9 @@ func (pc *k8sPolicyClient[T, U, P, PL]) TargetRefObj(ctx context.Context, p P) (
 		Namespace: p.GetNamespace(),
 		Name:      string(tr.Name),
 	}
+	if _, ok := obj.(*corev1.Namespace); ok {
+		key.Namespace = ""
+	}
 	err := pc.client.Get(ctx, key, obj)
 	if err != nil {
 		return nil, err
@@ -205,22 +242,48 @@ func (pc *k8sPolicyClient[T, U, P, PL]) TargetRefObj(ctx context.Context, p P) (
 	return obj, nil
 }
 
+func (pc *k8sPolicyClient[T, U, P, PL]) TargetSelectorObjs(ctx context.Context, p P) ([]k8sclient.Object, error) {
+	ts := policySelector(p)
+	gk := GroupKind{Group: string(ts.Group), Kind: string(ts.Kind)}
+	l, ok := GroupKindToObjList(gk)
+	if !ok {
+		return nil, fmt.Errorf("not supported GroupKind of targetSelector, group/kind=%s/%s",
+			ts.Group, ts.Kind)
+	}
+	err := pc.client.List(ctx, l, k8sclient.InNamespace(p.GetNamespace()), k8sclient.MatchingLabels(ts.MatchLabels))
+	if err != nil {
+		return nil, err
+	}
+	items, err := meta.ExtractList(l)
+	if err != nil {
+		return nil, err
+	}
+	return utils.SliceMap(items, func(o runtime.Object) k8sclient.Object {
+		return o.(k8sclient.Object)
+	}), nil
+}
+
+// Get object by the namespace and name already set on it
+func (pc *k8sPolicyClient[T, U, P, PL]) GetObj(ctx context.Context, obj k8sclient.Object) error {
+	return pc.client.Get(ctx, k8sclient.ObjectKeyFromObject(obj), obj)
+}
+
 func (pc *k8sPolicyClient[T, U, P, PL]) UpdateStatus(ctx context.Context, policy P) error {
 	return pc.client.Status().Update(ctx, policy)
 }
 
-// Get all policies for given object, filtered by targetRef match and sorted by conflict resolution
-// rules. First policy in the list is not-conflicting policy, but it might be in Accepted or Invalid
-// state. Conflict resolution order uses CreationTimestamp and Name.
+// Get all policies for given object, filtered by targetRef or targetSelector match and sorted by
+// conflict resolution rules. First policy in the list is not-conflicting policy, but it might be in
+// Accepted or Invalid state, and might apply to a section of the object only. Conflict resolution
+// order is described in conflictResolutionSort.
 func (h *PolicyHandler[P]) ObjPolicies(ctx context.Context, obj k8sclient.Object) ([]P, error) {
-	allPolicies, err := h.client.List(ctx, obj.GetNamespace())
+	allPolicies, err := h.client.List(ctx, objPolicyNamespace(obj))
 	if err != nil {
 		return nil, err
 	}
 	out := []P{}
 	for _, policy := range allPolicies {
-		tr := policy.GetTargetRef()
-		if h.targetRefMatch(obj, tr) {
+		if h.policyMatch(obj, policy) {
 			out = append(out, policy)
 		}
 	}
@@ -229,13 +292,23 @@ func (h *PolicyHandler[P]) ObjPolicies(ctx context.Context, obj k8sclient.Object
 }
 
 // Get Accepted policy for given object. Returns policy with conflict resolution and status
-// Accepted.  Will return at most single policy.
+// Accepted.  Will return at most single policy. Policies for a section of the object are ignored.
 func (h *PolicyHandler[P]) ObjResolvedPolicy(ctx context.Context, obj k8sclient.Object) (P, error) {
+	return h.ObjSectionResolvedPolicy(ctx, obj, "")
+}
+
+// Get Accepted policy for given section of object, like a Service port name. Policies for this section
+// take precedence over policies for the whole object. Will return at most single policy.
+func (h *PolicyHandler[P]) ObjSectionResolvedPolicy(ctx context.Context, obj k8sclient.Object, section string) (P, error) {
 	var empty P
 	objPolicies, err := h.ObjPolicies(ctx, obj)
 	if err != nil {
 		return empty, err
 	}
+	objPolicies = slices.DeleteFunc(objPolicies, func(policy P) bool {
+		ps := policySection(policy)
+		return ps != "" && ps != section
+	})
 	if len(objPolicies) == 0 {
 		return empty, nil
 	}
@@ -251,16 +324,33 @@ func (h *PolicyHandler[P]) ObjResolvedPolicy(ctx context.Context, obj k8sclient.
 // This method looks for policies that target either:
 // - The Service directly (if the policy targets Service objects)
 // - The ServiceExport with the same name and namespace (if the policy targets ServiceExport objects)
+// The section is the name of the service port, policies for another port are ignored.
 // Returns the resolved policy with conflict resolution and Accepted status, or nil if no applicable policy is found.
-func (h *PolicyHandler[P]) FindPolicyForService(ctx context.Context, serviceName, serviceNamespace string) (P, error) {
+func (h *PolicyHandler[P]) FindPolicyForService(ctx context.Context, serviceName, serviceNamespace, section string) (P, error) {
 	var empty P
 
 	// First, try to find policies that directly target the Service
 	service := &corev1.Service{}
 	service.SetName(serviceName)
 	service.SetNamespace(serviceNamespace)
+	serviceExport := &anv1alpha1.ServiceExport{}
+	serviceExport.SetName(serviceName)
+	serviceExport.SetNamespace(serviceNamespace)
+
+	// targetSelector matches on labels, which only the stored objects have
+	policies, err := h.client.List(ctx, serviceNamespace)
+	if err != nil {
+		return empty, err
+	}
+	if slices.ContainsFunc(policies, func(policy P) bool { return policySelector(policy) != nil }) {
+		for _, obj := range []k8sclient.Object{service, serviceExport} {
+			if err := h.client.GetObj(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+				return empty, err
+			}
+		}
+	}
 
-	policy, err := h.ObjResolvedPolicy(ctx, service)
+	policy, err := h.ObjSectionResolvedPolicy(ctx, service, section)
 	if err != nil {
 		return empty, err
 	}
@@ -271,11 +361,7 @@ func (h *PolicyHandler[P]) FindPolicyForService(ctx context.Context, serviceName
 
 	// If no policy targets the Service directly, try to find policies that target the ServiceExport
 	// with the same name and namespace (ServiceExport has the same name and namespace as the Service it exports)
-	serviceExport := &anv1alpha1.ServiceExport{}
-	serviceExport.SetName(serviceName)
-	serviceExport.SetNamespace(serviceNamespace)
-
-	policy, err = h.ObjResolvedPolicy(ctx, serviceExport)
+	policy, err = h.ObjSectionResolvedPolicy(ctx, serviceExport, section)
 	if err != nil {
 		return empty, err
 	}
@@ -299,14 +385,14 @@ func (h *PolicyHandler[P]) AddWatchers(b *builder.Builder, objs ...k8sclient.Obj
 
 func (h *PolicyHandler[P]) watchMapFn(ctx context.Context, obj k8sclient.Object) []reconcile.Request {
 	out := []reconcile.Request{}
-	policies, err := h.client.List(ctx, obj.GetNamespace())
+	policies, err := h.client.List(ctx, objPolicyNamespace(obj))
 	if err != nil {
 		h.log.Errorf(ctx, "watch mapfn error: for obj=%s/%s: %s",
 			obj.GetName(), obj.GetNamespace(), err)
 		return nil
 	}
 	for _, policy := range policies {
-		if h.targetRefMatch(obj, policy.GetTargetRef()) {
+		if h.policyMatch(obj, policy) {
 			out = append(out, reconcile.Request{
 				NamespacedName: types.NamespacedName{
 					Name:      policy.GetName(),
@@ -318,6 +404,24 @@ func (h *PolicyHandler[P]) watchMapFn(ctx context.Context, obj k8sclient.Object)
 	return out
 }
 
+// Returns the namespace of the policies of an object, policies for a Namespace are in the Namespace itself
+func objPolicyNamespace(obj k8sclient.Object) string {
+	if _, ok := obj.(*corev1.Namespace); ok {
+		return obj.GetName()
+	}
+	return obj.GetNamespace()
+}
+
+// Checks if object matches the targetSelector of policy, or its targetReference when it has no selector
+func (h *PolicyHandler[P]) policyMatch(obj k8sclient.Object, policy P) bool {
+	if ts := policySelector(policy); ts != nil {
+		return ObjToGroupKind(obj) == GroupKind{Group: string(ts.Group), Kind: string(ts.Kind)} &&
+			labels.SelectorFromSet(ts.MatchLabels).Matches(labels.Set(obj.GetLabels()))
+	}
+	tr := policy.GetTargetRef()
+	return tr != nil && h.targetRefMatch(obj, tr)
+}
+
 // Checks if objects matches targetReference, returns true if they match
 // targetRef might not have namespace set, it should be inferred from policy itself.
 // In this case we assume namespace already checked
@@ -343,7 +447,13 @@ func (h *PolicyHandler[P]) ValidateAndUpdateCondition(ctx context.Context, polic
 }
 
 func (h *PolicyHandler[P]) ValidateTargetRef(ctx context.Context, policy P) error {
+	if policySelector(policy) != nil {
+		return h.validateTargetSelector(ctx, policy)
+	}
 	tr := policy.GetTargetRef()
+	if tr == nil {
+		return fmt.Errorf("%w: either targetRef or targetSelector is required", ErrTargetRefMissing)
+	}
 
 	// invalid
 	trGk := TargetRefGroupKind(tr)
@@ -352,6 +462,11 @@ func (h *PolicyHandler[P]) ValidateTargetRef(ctx context.Context, policy P) erro
 			ErrGroupKind, tr.Group, tr.Kind)
 	}
 
+	if trGk == (GroupKind{corev1.GroupName, "Namespace"}) && string(tr.Name) != policy.GetNamespace() {
+		return fmt.Errorf("%w: a policy can only target its own Namespace, target=%s",
+			ErrGroupKind, tr.Name)
+	}
+
 	// not found
 	targetRefObj, err := h.client.TargetRefObj(ctx, policy)
 	if err != nil {
@@ -361,29 +476,138 @@ func (h *PolicyHandler[P]) ValidateTargetRef(ctx context.Context, policy P) erro
 		}
 		return err
 	}
+	found, err := h.sectionFound(ctx, targetRefObj, policySection(policy))
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("%w, target=%s/%s sectionName=%s",
+			ErrTargetRefNotFound, policy.GetNamespace(), tr.Name, policySection(policy))
+	}
 
 	// conflicted
-	objPolicies, err := h.ObjPolicies(ctx, targetRefObj)
+	resolvedPolicy, err := h.sectionResolvedPolicy(ctx, targetRefObj, policy)
 	if err != nil {
 		return err
 	}
-	if len(objPolicies) > 0 {
-		resolvedPolicy := objPolicies[0]
+	if resolvedPolicy.GetName() != policy.GetName() {
+		return fmt.Errorf("%w, policy=%s %s",
+			ErrTargetRefConflict, resolvedPolicy.GetName(), precedenceReason(resolvedPolicy, policy))
+	}
+
+	// valid
+	return nil
+}
+
+// A policy with a targetSelector is conflicted when other policies take precedence on all the
+// selected objects. It is accepted when it applies to some of them at least, the message of its
+// condition then lists the objects where other policies take precedence.
+func (h *PolicyHandler[P]) validateTargetSelector(ctx context.Context, policy P) error {
+	ts := policySelector(policy)
+
+	// invalid
+	gk := GroupKind{Group: string(ts.Group), Kind: string(ts.Kind)}
+	if _, ok := GroupKindToObjList(gk); !ok || !h.kinds.Contains(gk) {
+		return fmt.Errorf("%w: not supported GroupKind=%s/%s",
+			ErrGroupKind, ts.Group, ts.Kind)
+	}
+
+	// not found
+	objs, err := h.client.TargetSelectorObjs(ctx, policy)
+	if err != nil {
+		return err
+	}
+	section := policySection(policy)
+	var targets []k8sclient.Object
+	for _, obj := range objs {
+		found, err := h.sectionFound(ctx, obj, section)
+		if err != nil {
+			return err
+		}
+		if found {
+			targets = append(targets, obj)
+		}
+	}
+	if len(targets) == 0 {
+		return fmt.Errorf("%w, no %s selected by targetSelector in namespace %s",
+			ErrTargetRefNotFound, ts.Kind, policy.GetNamespace())
+	}
+
+	// conflicted
+	overrides := []string{}
+	for _, obj := range targets {
+		resolvedPolicy, err := h.sectionResolvedPolicy(ctx, obj, policy)
+		if err != nil {
+			return err
+		}
 		if resolvedPolicy.GetName() != policy.GetName() {
-			return fmt.Errorf("%w, policy=%s",
-				ErrTargetRefConflict, resolvedPolicy.GetName())
+			overrides = append(overrides, fmt.Sprintf("%s by policy=%s %s",
+				obj.GetName(), resolvedPolicy.GetName(), precedenceReason(resolvedPolicy, policy)))
 		}
 	}
+	if len(overrides) == len(targets) {
+		return fmt.Errorf("%w, overridden on all selected targets: %s",
+			ErrTargetRefConflict, strings.Join(overrides, "; "))
+	}
+	if len(overrides) > 0 {
+		return fmt.Errorf("%w: %s", ErrTargetsOverridden, strings.Join(overrides, "; "))
+	}
 
 	// valid
 	return nil
 }
 
+// Returns the first policy of the object for the same section as the given policy, it is the given
+// policy itself when no other policy takes precedence. Policies for a section do not conflict with
+// policies for the whole object, they override them on this section only.
+func (h *PolicyHandler[P]) sectionResolvedPolicy(ctx context.Context, obj k8sclient.Object, policy P) (P, error) {
+	objPolicies, err := h.ObjPolicies(ctx, obj)
+	if err != nil {
+		return policy, err
+	}
+	for _, objPolicy := range objPolicies {
+		if policySection(objPolicy) == policySection(policy) {
+			return objPolicy, nil
+		}
+	}
+	return policy, nil
+}
+
+// Checks if object has the given section, an empty section is the whole object. Only Service ports
+// are known sections: the ports of a ServiceExport are the ports of the Service it exports.
+func (h *PolicyHandler[P]) sectionFound(ctx context.Context, obj k8sclient.Object, section string) (bool, error) {
+	if section == "" {
+		return true, nil
+	}
+	var svc *corev1.Service
+	switch o := obj.(type) {
+	case *corev1.Service:
+		svc = o
+	case *anv1alpha1.ServiceExport:
+		svc = &corev1.Service{}
+		svc.SetName(k8s.GetServiceNameFromServiceExport(o))
+		svc.SetNamespace(o.GetNamespace())
+		if err := h.client.GetObj(ctx, svc); err != nil {
+			if apierrors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+	default:
+		return false, nil
+	}
+	return slices.ContainsFunc(svc.Spec.Ports, func(port corev1.ServicePort) bool {
+		return port.Name == section
+	}), nil
+}
+
 func errToReason(err error) ConditionReason {
 	switch {
 	case err == nil:
 		return ReasonAccepted
-	case errors.Is(err, ErrGroupKind):
+	case errors.Is(err, ErrTargetsOverridden):
+		return ReasonAccepted
+	case errors.Is(err, ErrGroupKind), errors.Is(err, ErrTargetRefMissing):
 		return ReasonInvalid
 	case errors.Is(err, ErrTargetRefNotFound):
 		return ReasonTargetNotFound
@@ -412,10 +636,21 @@ func (h *PolicyHandler[P]) UpdateAcceptedCondition(ctx context.Context, policy P
 }
 
 // sort in-place for policy conflict resolution
-// 1. older policy (CreationTimeStamp) has precedence
-// 2. alphabetical order namespace, then name
+// 1. policy for a section (sectionName) of its target has precedence over policy for the whole target
+// 2. policy with targetRef has precedence over policy with targetSelector
+// 3. older policy (CreationTimeStamp) has precedence
+// 4. alphabetical order namespace, then name
 func (h *PolicyHandler[P]) conflictResolutionSort(policies []P) {
 	slices.SortFunc(policies, func(a, b P) int {
+		if sA, sB := policySection(a) != "", policySection(b) != ""; sA != sB {
+			if sA {
+				return -1
+			}
+			return 1
+		}
+		if c := cmp.Compare(policyScope(a), policyScope(b)); c != 0 {
+			return c
+		}
 		tsA := a.GetCreationTimestamp().Time
 		tsB := b.GetCreationTimestamp().Time
 		switch {
@@ -424,13 +659,39 @@ func (h *PolicyHandler[P]) conflictResolutionSort(policies []P) {
 		case tsA.After(tsB):
 			return 1
 		default:
-			nA := a.GetName()
-			nB := b.GetName()
-			return strings.Compare(nA, nB)
+			return cmp.Or(
+				strings.Compare(a.GetNamespace(), b.GetNamespace()),
+				strings.Compare(a.GetName(), b.GetName()),
+			)
 		}
 	})
 }
 
+type scope int
+
+const (
+	scopeTargetRef scope = iota
+	scopeTargetSelector
+)
+
+func policyScope(policy Policy) scope {
+	if policySelector(policy) != nil {
+		return scopeTargetSelector
+	}
+	return scopeTargetRef
+}
+
+// Explains why winner takes precedence over loser, both for the same section
+func precedenceReason(winner, loser Policy) string {
+	if policyScope(winner) != policyScope(loser) {
+		return "takes precedence as it has a targetRef"
+	}
+	if winner.GetCreationTimestamp().Time.Before(loser.GetCreationTimestamp().Time) {
+		return "takes precedence as it is older"
+	}
+	return "takes precedence as it has the same creation time and sorts first by name"
+}
+
 func (h *PolicyHandler[P]) GetTargetRefObj(ctx context.Context, policy P) (k8sclient.Object, error) {
 	return h.client.TargetRefObj(ctx, policy)
 }
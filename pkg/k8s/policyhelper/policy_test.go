@@ -9,8 +9,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -18,6 +20,7 @@ import (
 
 	mock_client "github.com/aws/aws-application-networking-k8s/mocks/controller-runtime/client"
 	anv1alpha1 "github.com/aws/aws-application-networking-k8s/pkg/apis/applicationnetworking/v1alpha1"
+	"github.com/aws/aws-application-networking-k8s/pkg/utils"
 	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
 )
 
@@ -288,7 +291,7 @@ func Test_FindPolicyForService_ServiceBasedResolution(t *testing.T) {
 			ph := NewPolicyHandler[tgp, tgpl](phcfg)
 
 			// Call the method under test
-			policy, err := ph.FindPolicyForService(ctx, tt.serviceName, tt.serviceNamespace)
+			policy, err := ph.FindPolicyForService(ctx, tt.serviceName, tt.serviceNamespace, "")
 
 			// Verify results
 			if tt.expectError {
@@ -335,7 +338,7 @@ func Test_FindPolicyForService_ErrorHandling(t *testing.T) {
 		ph := NewPolicyHandler[tgp, tgpl](phcfg)
 
 		// Call the method under test
-		policy, err := ph.FindPolicyForService(ctx, "test-service", "test-namespace")
+		policy, err := ph.FindPolicyForService(ctx, "test-service", "test-namespace", "")
 
 		// Verify error is propagated
 		assert.Error(t, err)
@@ -346,3 +349,273 @@ func Test_FindPolicyForService_ErrorHandling(t *testing.T) {
 		assert.Equal(t, fmt.Sprintf("%v", zero), fmt.Sprintf("%v", policy))
 	})
 }
+
+func Test_ConflictResolutionSort(t *testing.T) {
+	ph := NewTargetGroupPolicyHandler(gwlog.FallbackLogger, nil)
+	policies := []*anv1alpha1.TargetGroupPolicy{
+		&anv1alpha1.TargetGroupPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "selector", Namespace: "ns1", CreationTimestamp: metav1.Unix(1, 0)},
+			Spec: anv1alpha1.TargetGroupPolicySpec{
+				TargetSelector: &anv1alpha1.TargetSelector{Kind: "Service", MatchLabels: map[string]string{"app": "a"}},
+			},
+		},
+		&anv1alpha1.TargetGroupPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "newer", Namespace: "ns1", CreationTimestamp: metav1.Unix(2, 0)},
+			Spec: anv1alpha1.TargetGroupPolicySpec{
+				TargetRef: &gwv1alpha2.NamespacedPolicyTargetReference{Kind: "Service", Name: "svc1"},
+			},
+		},
+		&anv1alpha1.TargetGroupPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "b-same-time", Namespace: "ns1", CreationTimestamp: metav1.Unix(1, 0)},
+			Spec: anv1alpha1.TargetGroupPolicySpec{
+				TargetRef: &gwv1alpha2.NamespacedPolicyTargetReference{Kind: "Service", Name: "svc1"},
+			},
+		},
+		&anv1alpha1.TargetGroupPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "a-same-time", Namespace: "ns1", CreationTimestamp: metav1.Unix(1, 0)},
+			Spec: anv1alpha1.TargetGroupPolicySpec{
+				TargetRef: &gwv1alpha2.NamespacedPolicyTargetReference{Kind: "Service", Name: "svc1"},
+			},
+		},
+		&anv1alpha1.TargetGroupPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "selector-section", Namespace: "ns1", CreationTimestamp: metav1.Unix(3, 0)},
+			Spec: anv1alpha1.TargetGroupPolicySpec{
+				TargetSelector: &anv1alpha1.TargetSelector{Kind: "Service", MatchLabels: map[string]string{"app": "a"}},
+				SectionName:    ptr.To(gwv1alpha2.SectionName("http")),
+			},
+		},
+		&anv1alpha1.TargetGroupPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "section", Namespace: "ns1", CreationTimestamp: metav1.Unix(3, 0)},
+			Spec: anv1alpha1.TargetGroupPolicySpec{
+				TargetRef:   &gwv1alpha2.NamespacedPolicyTargetReference{Kind: "Service", Name: "svc1"},
+				SectionName: ptr.To(gwv1alpha2.SectionName("http")),
+			},
+		},
+	}
+	ph.conflictResolutionSort(policies)
+	names := utils.SliceMap(policies, func(p *anv1alpha1.TargetGroupPolicy) string { return p.Name })
+	assert.Equal(t, []string{"section", "selector-section", "a-same-time", "b-same-time", "newer", "selector"}, names)
+}
+
+func Test_ObjSectionResolvedPolicy(t *testing.T) {
+	ctx := context.Background()
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "svc1", Namespace: "ns1", Labels: map[string]string{"app": "a"}},
+		Spec: corev1.ServiceSpec{Ports: []corev1.ServicePort{
+			{Name: "http", Port: 80},
+			{Name: "admin", Port: 9090},
+		}},
+	}
+	scheme := runtime.NewScheme()
+	anv1alpha1.Install(scheme)
+	corev1.AddToScheme(scheme)
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		svc,
+		&anv1alpha1.TargetGroupPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "selector", Namespace: "ns1", CreationTimestamp: metav1.Unix(1, 0)},
+			Spec: anv1alpha1.TargetGroupPolicySpec{
+				TargetSelector: &anv1alpha1.TargetSelector{Kind: "Service", MatchLabels: map[string]string{"app": "a"}},
+			},
+		},
+		&anv1alpha1.TargetGroupPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "admin", Namespace: "ns1", CreationTimestamp: metav1.Unix(2, 0)},
+			Spec: anv1alpha1.TargetGroupPolicySpec{
+				TargetSelector: &anv1alpha1.TargetSelector{Kind: "Service", MatchLabels: map[string]string{"app": "a"}},
+				SectionName:    ptr.To(gwv1alpha2.SectionName("admin")),
+			},
+		},
+		&anv1alpha1.TargetGroupPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "ns1", CreationTimestamp: metav1.Unix(1, 0)},
+			Spec: anv1alpha1.TargetGroupPolicySpec{
+				TargetSelector: &anv1alpha1.TargetSelector{Kind: "Service", MatchLabels: map[string]string{"app": "b"}},
+			},
+		},
+	).Build()
+	ph := NewTargetGroupPolicyHandler(gwlog.FallbackLogger, k8sClient)
+
+	policy, err := ph.ObjSectionResolvedPolicy(ctx, svc, "http")
+	assert.NoError(t, err)
+	assert.Equal(t, "selector", policy.Name)
+
+	policy, err = ph.ObjSectionResolvedPolicy(ctx, svc, "admin")
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", policy.Name)
+
+	policy, err = ph.ObjResolvedPolicy(ctx, svc)
+	assert.NoError(t, err)
+	assert.Equal(t, "selector", policy.Name)
+
+	// the labels of the stored service are matched
+	policy, err = ph.FindPolicyForService(ctx, "svc1", "ns1", "admin")
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", policy.Name)
+}
+
+func Test_ValidateTargetRef_SectionAndSelector(t *testing.T) {
+	svc1 := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "svc1", Namespace: "ns1", Labels: map[string]string{"app": "a"}},
+		Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "http", Port: 80}}},
+	}
+	svc2 := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "svc2", Namespace: "ns1", Labels: map[string]string{"app": "a"}},
+		Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "http", Port: 80}}},
+	}
+
+	tests := []struct {
+		name           string
+		policy         *anv1alpha1.TargetGroupPolicy
+		others         []client.Object
+		expectedReason ConditionReason
+		expectedMsg    string
+	}{
+		{
+			name: "neither targetRef nor targetSelector",
+			policy: &anv1alpha1.TargetGroupPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "p", Namespace: "ns1", CreationTimestamp: metav1.Unix(1, 0)},
+			},
+			expectedReason: ReasonInvalid,
+		},
+		{
+			name: "unknown section",
+			policy: &anv1alpha1.TargetGroupPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "p", Namespace: "ns1", CreationTimestamp: metav1.Unix(1, 0)},
+				Spec: anv1alpha1.TargetGroupPolicySpec{
+					TargetRef:   &gwv1alpha2.NamespacedPolicyTargetReference{Kind: "Service", Name: "svc1"},
+					SectionName: ptr.To(gwv1alpha2.SectionName("grpc")),
+				},
+			},
+			expectedReason: ReasonTargetNotFound,
+		},
+		{
+			name: "older policy takes precedence",
+			policy: &anv1alpha1.TargetGroupPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "p", Namespace: "ns1", CreationTimestamp: metav1.Unix(2, 0)},
+				Spec: anv1alpha1.TargetGroupPolicySpec{
+					TargetRef: &gwv1alpha2.NamespacedPolicyTargetReference{Kind: "Service", Name: "svc1"},
+				},
+			},
+			others: []client.Object{&anv1alpha1.TargetGroupPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "older", Namespace: "ns1", CreationTimestamp: metav1.Unix(1, 0)},
+				Spec: anv1alpha1.TargetGroupPolicySpec{
+					TargetRef: &gwv1alpha2.NamespacedPolicyTargetReference{Kind: "Service", Name: "svc1"},
+				},
+			}},
+			expectedReason: ReasonConflicted,
+			expectedMsg:    "policy=older takes precedence as it is older",
+		},
+		{
+			name: "same creation time sorts by name",
+			policy: &anv1alpha1.TargetGroupPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "p", Namespace: "ns1", CreationTimestamp: metav1.Unix(1, 0)},
+				Spec: anv1alpha1.TargetGroupPolicySpec{
+					TargetRef: &gwv1alpha2.NamespacedPolicyTargetReference{Kind: "Service", Name: "svc1"},
+				},
+			},
+			others: []client.Object{&anv1alpha1.TargetGroupPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "ns1", CreationTimestamp: metav1.Unix(1, 0)},
+				Spec: anv1alpha1.TargetGroupPolicySpec{
+					TargetRef: &gwv1alpha2.NamespacedPolicyTargetReference{Kind: "Service", Name: "svc1"},
+				},
+			}},
+			expectedReason: ReasonConflicted,
+			expectedMsg:    "policy=a takes precedence as it has the same creation time and sorts first by name",
+		},
+		{
+			name: "section policy does not conflict with whole service policy",
+			policy: &anv1alpha1.TargetGroupPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "p", Namespace: "ns1", CreationTimestamp: metav1.Unix(2, 0)},
+				Spec: anv1alpha1.TargetGroupPolicySpec{
+					TargetRef:   &gwv1alpha2.NamespacedPolicyTargetReference{Kind: "Service", Name: "svc1"},
+					SectionName: ptr.To(gwv1alpha2.SectionName("http")),
+				},
+			},
+			others: []client.Object{&anv1alpha1.TargetGroupPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "older", Namespace: "ns1", CreationTimestamp: metav1.Unix(1, 0)},
+				Spec: anv1alpha1.TargetGroupPolicySpec{
+					TargetRef: &gwv1alpha2.NamespacedPolicyTargetReference{Kind: "Service", Name: "svc1"},
+				},
+			}},
+			expectedReason: ReasonAccepted,
+		},
+		{
+			name: "selector selects nothing",
+			policy: &anv1alpha1.TargetGroupPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "p", Namespace: "ns1", CreationTimestamp: metav1.Unix(1, 0)},
+				Spec: anv1alpha1.TargetGroupPolicySpec{
+					TargetSelector: &anv1alpha1.TargetSelector{Kind: "Service", MatchLabels: map[string]string{"app": "none"}},
+				},
+			},
+			expectedReason: ReasonTargetNotFound,
+		},
+		{
+			name: "selector overridden on all targets",
+			policy: &anv1alpha1.TargetGroupPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "p", Namespace: "ns1", CreationTimestamp: metav1.Unix(2, 0)},
+				Spec: anv1alpha1.TargetGroupPolicySpec{
+					TargetSelector: &anv1alpha1.TargetSelector{Kind: "Service", MatchLabels: map[string]string{"app": "a"}},
+				},
+			},
+			others: []client.Object{
+				&anv1alpha1.TargetGroupPolicy{
+					ObjectMeta: metav1.ObjectMeta{Name: "older", Namespace: "ns1", CreationTimestamp: metav1.Unix(1, 0)},
+					Spec: anv1alpha1.TargetGroupPolicySpec{
+						TargetSelector: &anv1alpha1.TargetSelector{Kind: "Service", MatchLabels: map[string]string{"app": "a"}},
+					},
+				},
+				&anv1alpha1.TargetGroupPolicy{
+					ObjectMeta: metav1.ObjectMeta{Name: "ref", Namespace: "ns1", CreationTimestamp: metav1.Unix(3, 0)},
+					Spec: anv1alpha1.TargetGroupPolicySpec{
+						TargetRef: &gwv1alpha2.NamespacedPolicyTargetReference{Kind: "Service", Name: "svc1"},
+					},
+				},
+			},
+			expectedReason: ReasonConflicted,
+			expectedMsg:    "svc1 by policy=ref takes precedence as it has a targetRef; svc2 by policy=older takes precedence as it is older",
+		},
+		{
+			name: "selector accepted with targetRef override",
+			policy: &anv1alpha1.TargetGroupPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "p", Namespace: "ns1", CreationTimestamp: metav1.Unix(1, 0)},
+				Spec: anv1alpha1.TargetGroupPolicySpec{
+					TargetSelector: &anv1alpha1.TargetSelector{Kind: "Service", MatchLabels: map[string]string{"app": "a"}},
+				},
+			},
+			others: []client.Object{&anv1alpha1.TargetGroupPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "ref", Namespace: "ns1", CreationTimestamp: metav1.Unix(2, 0)},
+				Spec: anv1alpha1.TargetGroupPolicySpec{
+					TargetRef: &gwv1alpha2.NamespacedPolicyTargetReference{Kind: "Service", Name: "svc1"},
+				},
+			}},
+			expectedReason: ReasonAccepted,
+			expectedMsg:    "overridden on some targets: svc1 by policy=ref takes precedence as it has a targetRef",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			objs := append([]client.Object{svc1.DeepCopy(), svc2.DeepCopy(), tt.policy}, tt.others...)
+			scheme := runtime.NewScheme()
+			anv1alpha1.Install(scheme)
+			corev1.AddToScheme(scheme)
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(objs...).
+				WithStatusSubresource(&anv1alpha1.TargetGroupPolicy{}).
+				Build()
+			ph := NewTargetGroupPolicyHandler(gwlog.FallbackLogger, k8sClient)
+
+			policy, err := ph.client.Get(ctx, client.ObjectKeyFromObject(tt.policy))
+			assert.NoError(t, err)
+			reason, err := ph.ValidateAndUpdateCondition(ctx, policy)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedReason, reason)
+
+			policy, err = ph.client.Get(ctx, client.ObjectKeyFromObject(tt.policy))
+			assert.NoError(t, err)
+			cnd := meta.FindStatusCondition(policy.Status.Conditions, string(ConditionTypeAccepted))
+			assert.Equal(t, string(tt.expectedReason), cnd.Reason)
+			assert.Contains(t, cnd.Message, tt.expectedMsg)
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package policyhelper
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	anv1alpha1 "github.com/aws/aws-application-networking-k8s/pkg/apis/applicationnetworking/v1alpha1"
+)
+
+// ResolveTargetGroupPolicy returns the TargetGroupPolicy of the target groups of given section of object, merged
+// over the defaults of the policies of its namespace and of its gateways, and under their overrides. Returns nil when
+// no policy applies.
+func ResolveTargetGroupPolicy(ctx context.Context, h *PolicyHandler[*TGP], obj k8sclient.Object, section string,
+	gateways []*gwv1.Gateway) (*TGP, error) {
+	tgp, err := h.ObjSectionResolvedPolicy(ctx, obj, section)
+	if err != nil {
+		return nil, err
+	}
+	return InheritTargetGroupPolicyDefaults(ctx, h, obj.GetNamespace(), gateways, tgp)
+}
+
+// InheritTargetGroupPolicyDefaults merges policy over the defaults of the policy of namespace, and of the policy of
+// the first gateway having one, then applies their overrides. Gateways are given in the order of the route
+// parentRefs.
+func InheritTargetGroupPolicyDefaults(ctx context.Context, h *PolicyHandler[*TGP], namespace string,
+	gateways []*gwv1.Gateway, tgp *TGP) (*TGP, error) {
+	ns := &corev1.Namespace{}
+	ns.SetName(namespace)
+	nsDefaults, err := h.ObjResolvedPolicy(ctx, ns)
+	if err != nil {
+		return nil, err
+	}
+	var gwDefaults *TGP
+	for _, gw := range gateways {
+		gwDefaults, err = h.ObjResolvedPolicy(ctx, gw)
+		if err != nil {
+			return nil, err
+		}
+		if gwDefaults != nil {
+			break
+		}
+	}
+	merged := MergeTargetGroupPolicies(nsDefaults, gwDefaults, tgp)
+	return applyTargetGroupPolicyOverrides(merged, gwDefaults, nsDefaults), nil
+}
+
+// applyTargetGroupPolicyOverrides merges the overrides of policies over merged, from the most to the least specific
+// policy, following the overrides semantics of Gateway API policy attachment. Nil policies are skipped.
+func applyTargetGroupPolicyOverrides(merged *TGP, policies ...*TGP) *TGP {
+	for _, tgp := range policies {
+		if tgp == nil || tgp.Spec.Overrides == nil {
+			continue
+		}
+		cfg := mergeTargetGroupPolicyConfig(TargetGroupPolicyConfig(merged), tgp.Spec.Overrides)
+		merged = merged.DeepCopy()
+		merged.Spec.Protocol = cfg.Protocol
+		merged.Spec.ProtocolVersion = cfg.ProtocolVersion
+		merged.Spec.TargetType = cfg.TargetType
+		merged.Spec.HealthCheck = cfg.HealthCheck
+		merged.Spec.DrainingTimeoutSeconds = cfg.DrainingTimeoutSeconds
+	}
+	return merged
+}
+
+// ValidateTargetGroupPolicyOverrides rejects the overrides of a policy that does not target a Gateway or Namespace,
+// since no policy is more specific than the one of a Service or ServiceExport
+func ValidateTargetGroupPolicyOverrides(tgp *TGP) error {
+	if tgp.Spec.Overrides == nil {
+		return nil
+	}
+	tr := tgp.Spec.TargetRef
+	if tr != nil {
+		switch TargetRefGroupKind(tr) {
+		case GroupKind{gwv1.GroupName, "Gateway"}, GroupKind{corev1.GroupName, "Namespace"}:
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: overrides are only supported for Gateway and Namespace targets", ErrGroupKind)
+}
+
+// MergeTargetGroupPolicies merges policies from the least to the most specific one, following the defaults
+// semantics of Gateway API policy attachment. The fields set by a policy override the ones of the previous
+// policies, health check fields one by one. The merged policy keeps the metadata, target and status of the most
+// specific policy. Nil policies are skipped, returns nil without any policy.
+func MergeTargetGroupPolicies(policies ...*TGP) *TGP {
+	var merged *TGP
+	for _, tgp := range policies {
+		if tgp == nil {
+			continue
+		}
+		if merged == nil {
+			merged = tgp.DeepCopy()
+			continue
+		}
+		cfg := mergeTargetGroupPolicyConfig(TargetGroupPolicyConfig(merged), TargetGroupPolicyConfig(tgp))
+		merged = tgp.DeepCopy()
+		merged.Spec.Protocol = cfg.Protocol
+		merged.Spec.ProtocolVersion = cfg.ProtocolVersion
+		merged.Spec.TargetType = cfg.TargetType
+		merged.Spec.HealthCheck = cfg.HealthCheck
+		merged.Spec.DrainingTimeoutSeconds = cfg.DrainingTimeoutSeconds
+	}
+	return merged
+}
+
+// TargetGroupPolicyConfig returns the target group configuration of policy
+func TargetGroupPolicyConfig(tgp *TGP) *anv1alpha1.TargetGroupPolicyConfig {
+	if tgp == nil {
+		return nil
+	}
+	cfg := &anv1alpha1.TargetGroupPolicyConfig{
+		Protocol:               tgp.Spec.Protocol,
+		ProtocolVersion:        tgp.Spec.ProtocolVersion,
+		TargetType:             tgp.Spec.TargetType,
+		HealthCheck:            tgp.Spec.HealthCheck,
+		DrainingTimeoutSeconds: tgp.Spec.DrainingTimeoutSeconds,
+	}
+	return cfg.DeepCopy()
+}
+
+func mergeTargetGroupPolicyConfig(base, override *anv1alpha1.TargetGroupPolicyConfig) *anv1alpha1.TargetGroupPolicyConfig {
+	merged := base.DeepCopy()
+	if override.Protocol != nil {
+		merged.Protocol = override.Protocol
+	}
+	if override.ProtocolVersion != nil {
+		merged.ProtocolVersion = override.ProtocolVersion
+	}
+	if override.TargetType != nil {
+		merged.TargetType = override.TargetType
+	}
+	if override.DrainingTimeoutSeconds != nil {
+		merged.DrainingTimeoutSeconds = override.DrainingTimeoutSeconds
+	}
+	merged.HealthCheck = mergeHealthCheckConfig(merged.HealthCheck, override.HealthCheck)
+	return merged
+}
+
+func mergeHealthCheckConfig(base, override *anv1alpha1.HealthCheckConfig) *anv1alpha1.HealthCheckConfig {
+	if base == nil {
+		return override.DeepCopy()
+	}
+	if override == nil {
+		return base
+	}
+	merged := base.DeepCopy()
+	if override.Enabled != nil {
+		merged.Enabled = override.Enabled
+	}
+	if override.IntervalSeconds != nil {
+		merged.IntervalSeconds = override.IntervalSeconds
+	}
+	if override.TimeoutSeconds != nil {
+		merged.TimeoutSeconds = override.TimeoutSeconds
+	}
+	if override.HealthyThresholdCount != nil {
+		merged.HealthyThresholdCount = override.HealthyThresholdCount
+	}
+	if override.UnhealthyThresholdCount != nil {
+		merged.UnhealthyThresholdCount = override.UnhealthyThresholdCount
+	}
+	if override.StatusMatch != nil {
+		merged.StatusMatch = override.StatusMatch
+	}
+	if override.Path != nil {
+		merged.Path = override.Path
+	}
+	if override.Port != nil {
+		merged.Port = override.Port
+	}
+	if override.Protocol != nil {
+		merged.Protocol = override.Protocol
+	}
+	if override.ProtocolVersion != nil {
+		merged.ProtocolVersion = override.ProtocolVersion
+	}
+	if override.FromReadinessProbe != nil {
+		merged.FromReadinessProbe = override.FromReadinessProbe
+	}
+	return merged
+}
+
+// EffectiveTargetGroupPolicyConfig returns the configuration of policy merged over the defaults of the policy of
+// its namespace. Gateway defaults depend on the routes of each target group and are not included.
+func EffectiveTargetGroupPolicyConfig(ctx context.Context, h *PolicyHandler[*TGP], tgp *TGP) (*anv1alpha1.TargetGroupPolicyConfig, error) {
+	merged, err := InheritTargetGroupPolicyDefaults(ctx, h, tgp.Namespace, nil, tgp)
+	if err != nil {
+		return nil, err
+	}
+	return TargetGroupPolicyConfig(merged), nil
+}
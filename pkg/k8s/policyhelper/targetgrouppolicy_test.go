@@ -0,0 +1,316 @@
+package policyhelper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	anv1alpha1 "github.com/aws/aws-application-networking-k8s/pkg/apis/applicationnetworking/v1alpha1"
+	"github.com/aws/aws-application-networking-k8s/pkg/utils/gwlog"
+)
+
+func Test_MergeTargetGroupPolicies(t *testing.T) {
+	nsDefaults := &anv1alpha1.TargetGroupPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "ns", Namespace: "ns1"},
+		Spec: anv1alpha1.TargetGroupPolicySpec{
+			TargetRef:              &gwv1alpha2.NamespacedPolicyTargetReference{Group: corev1.GroupName, Kind: "Namespace", Name: "ns1"},
+			Protocol:               aws.String("HTTPS"),
+			DrainingTimeoutSeconds: aws.Int64(60),
+			HealthCheck: &anv1alpha1.HealthCheckConfig{
+				Path:            aws.String("/healthz"),
+				IntervalSeconds: aws.Int64(10),
+			},
+		},
+	}
+	gwDefaults := &anv1alpha1.TargetGroupPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: "ns1"},
+		Spec: anv1alpha1.TargetGroupPolicySpec{
+			TargetRef:       &gwv1alpha2.NamespacedPolicyTargetReference{Group: gwv1.GroupName, Kind: "Gateway", Name: "gw1"},
+			ProtocolVersion: aws.String("HTTP2"),
+			HealthCheck: &anv1alpha1.HealthCheckConfig{
+				IntervalSeconds: aws.Int64(20),
+			},
+		},
+	}
+	svcPolicy := &anv1alpha1.TargetGroupPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "ns1"},
+		Spec: anv1alpha1.TargetGroupPolicySpec{
+			TargetRef: &gwv1alpha2.NamespacedPolicyTargetReference{Group: corev1.GroupName, Kind: "Service", Name: "svc1"},
+			Protocol:  aws.String("HTTP"),
+			HealthCheck: &anv1alpha1.HealthCheckConfig{
+				Path: aws.String("/ready"),
+			},
+		},
+	}
+
+	merged := MergeTargetGroupPolicies(nsDefaults, gwDefaults, svcPolicy)
+	assert.Equal(t, "svc", merged.Name)
+	assert.Equal(t, svcPolicy.Spec.TargetRef, merged.Spec.TargetRef)
+	assert.Equal(t, &anv1alpha1.TargetGroupPolicyConfig{
+		Protocol:               aws.String("HTTP"),
+		ProtocolVersion:        aws.String("HTTP2"),
+		DrainingTimeoutSeconds: aws.Int64(60),
+		HealthCheck: &anv1alpha1.HealthCheckConfig{
+			Path:            aws.String("/ready"),
+			IntervalSeconds: aws.Int64(20),
+		},
+	}, TargetGroupPolicyConfig(merged))
+
+	// merging leaves the policies untouched
+	assert.Equal(t, aws.String("/healthz"), nsDefaults.Spec.HealthCheck.Path)
+	assert.Nil(t, svcPolicy.Spec.ProtocolVersion)
+
+	assert.Equal(t, "ns", MergeTargetGroupPolicies(nsDefaults, nil, nil).Name)
+	assert.Nil(t, MergeTargetGroupPolicies(nil, nil, nil))
+}
+
+func Test_ResolveTargetGroupPolicy(t *testing.T) {
+	ctx := context.Background()
+	accepted := []metav1.Condition{{
+		Type:   string(ConditionTypeAccepted),
+		Status: metav1.ConditionTrue,
+		Reason: string(ReasonAccepted),
+	}}
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1"}}
+	gw := &gwv1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "gw1", Namespace: "ns1"}}
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "svc1", Namespace: "ns1"},
+		Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "http", Port: 80}}},
+	}
+	nsDefaults := &anv1alpha1.TargetGroupPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "ns", Namespace: "ns1"},
+		Spec: anv1alpha1.TargetGroupPolicySpec{
+			TargetRef:  &gwv1alpha2.NamespacedPolicyTargetReference{Group: corev1.GroupName, Kind: "Namespace", Name: "ns1"},
+			TargetType: aws.String("INSTANCE"),
+			HealthCheck: &anv1alpha1.HealthCheckConfig{
+				Path: aws.String("/healthz"),
+			},
+		},
+		Status: anv1alpha1.TargetGroupPolicyStatus{Conditions: accepted},
+	}
+	gwDefaults := &anv1alpha1.TargetGroupPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: "ns1"},
+		Spec: anv1alpha1.TargetGroupPolicySpec{
+			TargetRef: &gwv1alpha2.NamespacedPolicyTargetReference{Group: gwv1.GroupName, Kind: "Gateway", Name: "gw1"},
+			Protocol:  aws.String("HTTPS"),
+		},
+		Status: anv1alpha1.TargetGroupPolicyStatus{Conditions: accepted},
+	}
+	svcPolicy := &anv1alpha1.TargetGroupPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "ns1"},
+		Spec: anv1alpha1.TargetGroupPolicySpec{
+			TargetRef:  &gwv1alpha2.NamespacedPolicyTargetReference{Group: corev1.GroupName, Kind: "Service", Name: "svc1"},
+			TargetType: aws.String("IP"),
+		},
+		Status: anv1alpha1.TargetGroupPolicyStatus{Conditions: accepted},
+	}
+	otherNs := &anv1alpha1.TargetGroupPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "other-ns", Namespace: "ns1"},
+		Spec: anv1alpha1.TargetGroupPolicySpec{
+			TargetRef: &gwv1alpha2.NamespacedPolicyTargetReference{Group: corev1.GroupName, Kind: "Namespace", Name: "ns2"},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	_ = anv1alpha1.Install(scheme)
+	_ = corev1.AddToScheme(scheme)
+	_ = gwv1.Install(scheme)
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(ns, gw, svc, nsDefaults, gwDefaults, svcPolicy, otherNs).
+		WithStatusSubresource(&anv1alpha1.TargetGroupPolicy{}).
+		Build()
+	ph := NewTargetGroupPolicyHandler(gwlog.FallbackLogger, k8sClient)
+
+	tgp, err := ResolveTargetGroupPolicy(ctx, ph, svc, "http", []*gwv1.Gateway{gw})
+	assert.NoError(t, err)
+	assert.Equal(t, "svc", tgp.Name)
+	assert.Equal(t, &anv1alpha1.TargetGroupPolicyConfig{
+		Protocol:   aws.String("HTTPS"),
+		TargetType: aws.String("IP"),
+		HealthCheck: &anv1alpha1.HealthCheckConfig{
+			Path: aws.String("/healthz"),
+		},
+	}, TargetGroupPolicyConfig(tgp))
+
+	// without policy of its own, a service exported to other clusters has the namespace defaults only
+	svcExport := &anv1alpha1.ServiceExport{ObjectMeta: metav1.ObjectMeta{Name: "svc2", Namespace: "ns1"}}
+	tgp, err = ResolveTargetGroupPolicy(ctx, ph, svcExport, "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "ns", tgp.Name)
+	assert.Equal(t, aws.String("INSTANCE"), tgp.Spec.TargetType)
+
+	effectiveConfig, err := EffectiveTargetGroupPolicyConfig(ctx, ph, gwDefaults)
+	assert.NoError(t, err)
+	assert.Equal(t, &anv1alpha1.TargetGroupPolicyConfig{
+		Protocol:   aws.String("HTTPS"),
+		TargetType: aws.String("INSTANCE"),
+		HealthCheck: &anv1alpha1.HealthCheckConfig{
+			Path: aws.String("/healthz"),
+		},
+	}, effectiveConfig)
+
+	// policies can only provide the defaults of their own namespace
+	reason, err := ph.ValidateAndUpdateCondition(ctx, nsDefaults)
+	assert.NoError(t, err)
+	assert.Equal(t, ReasonAccepted, reason)
+	policy, err := ph.client.Get(ctx, client.ObjectKeyFromObject(otherNs))
+	assert.NoError(t, err)
+	reason, err = ph.ValidateAndUpdateCondition(ctx, policy)
+	assert.NoError(t, err)
+	assert.Equal(t, ReasonInvalid, reason)
+}
+
+func Test_ResolveTargetGroupPolicy_Overrides(t *testing.T) {
+	ctx := context.Background()
+	accepted := []metav1.Condition{{
+		Type:   string(ConditionTypeAccepted),
+		Status: metav1.ConditionTrue,
+		Reason: string(ReasonAccepted),
+	}}
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1"}}
+	gw := &gwv1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "gw1", Namespace: "ns1"}}
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "svc1", Namespace: "ns1"},
+		Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "http", Port: 80}}},
+	}
+	nsPolicy := &anv1alpha1.TargetGroupPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "ns", Namespace: "ns1"},
+		Spec: anv1alpha1.TargetGroupPolicySpec{
+			TargetRef: &gwv1alpha2.NamespacedPolicyTargetReference{Group: corev1.GroupName, Kind: "Namespace", Name: "ns1"},
+			Overrides: &anv1alpha1.TargetGroupPolicyConfig{
+				HealthCheck: &anv1alpha1.HealthCheckConfig{IntervalSeconds: aws.Int64(10)},
+			},
+		},
+		Status: anv1alpha1.TargetGroupPolicyStatus{Conditions: accepted},
+	}
+	gwPolicy := &anv1alpha1.TargetGroupPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: "ns1"},
+		Spec: anv1alpha1.TargetGroupPolicySpec{
+			TargetRef:       &gwv1alpha2.NamespacedPolicyTargetReference{Group: gwv1.GroupName, Kind: "Gateway", Name: "gw1"},
+			ProtocolVersion: aws.String("HTTP2"),
+			Overrides: &anv1alpha1.TargetGroupPolicyConfig{
+				Protocol: aws.String("HTTPS"),
+				HealthCheck: &anv1alpha1.HealthCheckConfig{
+					Path:            aws.String("/gw"),
+					IntervalSeconds: aws.Int64(20),
+				},
+			},
+		},
+		Status: anv1alpha1.TargetGroupPolicyStatus{Conditions: accepted},
+	}
+	svcPolicy := &anv1alpha1.TargetGroupPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "ns1"},
+		Spec: anv1alpha1.TargetGroupPolicySpec{
+			TargetRef:       &gwv1alpha2.NamespacedPolicyTargetReference{Group: corev1.GroupName, Kind: "Service", Name: "svc1"},
+			Protocol:        aws.String("HTTP"),
+			ProtocolVersion: aws.String("HTTP1"),
+			HealthCheck: &anv1alpha1.HealthCheckConfig{
+				Path:            aws.String("/svc"),
+				IntervalSeconds: aws.Int64(30),
+				TimeoutSeconds:  aws.Int64(5),
+			},
+		},
+		Status: anv1alpha1.TargetGroupPolicyStatus{Conditions: accepted},
+	}
+
+	scheme := runtime.NewScheme()
+	_ = anv1alpha1.Install(scheme)
+	_ = corev1.AddToScheme(scheme)
+	_ = gwv1.Install(scheme)
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(ns, gw, svc, nsPolicy, gwPolicy, svcPolicy).
+		WithStatusSubresource(&anv1alpha1.TargetGroupPolicy{}).
+		Build()
+	ph := NewTargetGroupPolicyHandler(gwlog.FallbackLogger, k8sClient)
+
+	tgp, err := ResolveTargetGroupPolicy(ctx, ph, svc, "http", []*gwv1.Gateway{gw})
+	assert.NoError(t, err)
+	assert.Equal(t, "svc", tgp.Name)
+	assert.Equal(t, &anv1alpha1.TargetGroupPolicyConfig{
+		Protocol:        aws.String("HTTPS"),
+		ProtocolVersion: aws.String("HTTP1"),
+		HealthCheck: &anv1alpha1.HealthCheckConfig{
+			Path:            aws.String("/gw"),
+			IntervalSeconds: aws.Int64(10),
+			TimeoutSeconds:  aws.Int64(5),
+		},
+	}, TargetGroupPolicyConfig(tgp))
+
+	// without gateway, only the namespace overrides apply
+	tgp, err = ResolveTargetGroupPolicy(ctx, ph, svc, "http", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, aws.String("HTTP"), tgp.Spec.Protocol)
+	assert.Equal(t, aws.String("/svc"), tgp.Spec.HealthCheck.Path)
+	assert.Equal(t, aws.Int64(10), tgp.Spec.HealthCheck.IntervalSeconds)
+
+	// resolving leaves the policies untouched
+	assert.Equal(t, aws.Int64(30), svcPolicy.Spec.HealthCheck.IntervalSeconds)
+}
+
+func Test_ValidateTargetGroupPolicyOverrides(t *testing.T) {
+	overrides := &anv1alpha1.TargetGroupPolicyConfig{Protocol: aws.String("HTTPS")}
+	tests := []struct {
+		name      string
+		spec      anv1alpha1.TargetGroupPolicySpec
+		expectErr bool
+	}{
+		{
+			name: "Gateway policy with overrides",
+			spec: anv1alpha1.TargetGroupPolicySpec{
+				TargetRef: &gwv1alpha2.NamespacedPolicyTargetReference{Group: gwv1.GroupName, Kind: "Gateway", Name: "gw1"},
+				Overrides: overrides,
+			},
+		},
+		{
+			name: "Namespace policy with overrides",
+			spec: anv1alpha1.TargetGroupPolicySpec{
+				TargetRef: &gwv1alpha2.NamespacedPolicyTargetReference{Group: corev1.GroupName, Kind: "Namespace", Name: "ns1"},
+				Overrides: overrides,
+			},
+		},
+		{
+			name: "Service policy without overrides",
+			spec: anv1alpha1.TargetGroupPolicySpec{
+				TargetRef: &gwv1alpha2.NamespacedPolicyTargetReference{Group: corev1.GroupName, Kind: "Service", Name: "svc1"},
+			},
+		},
+		{
+			name: "Service policy with overrides",
+			spec: anv1alpha1.TargetGroupPolicySpec{
+				TargetRef: &gwv1alpha2.NamespacedPolicyTargetReference{Group: corev1.GroupName, Kind: "Service", Name: "svc1"},
+				Overrides: overrides,
+			},
+			expectErr: true,
+		},
+		{
+			name: "selector policy with overrides",
+			spec: anv1alpha1.TargetGroupPolicySpec{
+				TargetSelector: &anv1alpha1.TargetSelector{Kind: "Service", MatchLabels: map[string]string{"app": "a"}},
+				Overrides:      overrides,
+			},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTargetGroupPolicyOverrides(&anv1alpha1.TargetGroupPolicy{Spec: tt.spec})
+			if tt.expectErr {
+				assert.ErrorIs(t, err, ErrGroupKind)
+				assert.Equal(t, ReasonInvalid, errToReason(err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package k8s
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/aws/aws-application-networking-k8s/pkg/model/core"
+)
+
+// ReferenceGrantTarget identifies the object a route is referencing
+type ReferenceGrantTarget struct {
+	Group     string
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// IsBackendRefPermitted returns true when the route is allowed to reference the target.
+// References within the route namespace are always allowed, cross-namespace references
+// need a ReferenceGrant in the target namespace. When the ReferenceGrant CRD is not
+// installed, cross-namespace references are not permitted.
+func IsBackendRefPermitted(ctx context.Context, c client.Client, route core.Route, target ReferenceGrantTarget) (bool, error) {
+	if target.Namespace == route.Namespace() {
+		return true, nil
+	}
+
+	grants := &gwv1beta1.ReferenceGrantList{}
+	if err := c.List(ctx, grants, client.InNamespace(target.Namespace)); err != nil {
+		if meta.IsNoMatchError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	for i := range grants.Items {
+		if ReferenceGrantAllows(&grants.Items[i], route, target) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ReferenceGrantAllows checks a single grant against the route and target, following
+// the Gateway API matching rules: a from entry must match group, kind and namespace of
+// the route, and a to entry must match group and kind, and name when it is set
+func ReferenceGrantAllows(grant *gwv1beta1.ReferenceGrant, route core.Route, target ReferenceGrantTarget) bool {
+	if grant.Namespace != target.Namespace {
+		return false
+	}
+
+	fromAllowed := false
+	for _, from := range grant.Spec.From {
+		if string(from.Group) == gwv1.GroupName &&
+			string(from.Kind) == route.GroupKind().Kind &&
+			string(from.Namespace) == route.Namespace() {
+			fromAllowed = true
+			break
+		}
+	}
+	if !fromAllowed {
+		return false
+	}
+
+	for _, to := range grant.Spec.To {
+		if string(to.Group) != target.Group || string(to.Kind) != target.Kind {
+			continue
+		}
+		if to.Name == nil || *to.Name == "" || string(*to.Name) == target.Name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/aws/aws-application-networking-k8s/pkg/model/core"
+)
+
+func TestReferenceGrantAllows(t *testing.T) {
+	svcName := gwv1.ObjectName("svc")
+	otherName := gwv1.ObjectName("other")
+	route := core.NewHTTPRoute(gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "route", Namespace: "route-ns"},
+	})
+	target := ReferenceGrantTarget{Group: "", Kind: "Service", Namespace: "backend-ns", Name: "svc"}
+
+	newGrant := func(namespace string, from gwv1beta1.ReferenceGrantFrom, to gwv1beta1.ReferenceGrantTo) *gwv1beta1.ReferenceGrant {
+		return &gwv1beta1.ReferenceGrant{
+			ObjectMeta: metav1.ObjectMeta{Name: "grant", Namespace: namespace},
+			Spec: gwv1beta1.ReferenceGrantSpec{
+				From: []gwv1beta1.ReferenceGrantFrom{from},
+				To:   []gwv1beta1.ReferenceGrantTo{to},
+			},
+		}
+	}
+	httpRouteFrom := gwv1beta1.ReferenceGrantFrom{Group: gwv1.GroupName, Kind: "HTTPRoute", Namespace: "route-ns"}
+	serviceTo := gwv1beta1.ReferenceGrantTo{Group: "", Kind: "Service"}
+
+	tests := []struct {
+		name     string
+		grant    *gwv1beta1.ReferenceGrant
+		expected bool
+	}{
+		{
+			name:     "allows all services",
+			grant:    newGrant("backend-ns", httpRouteFrom, serviceTo),
+			expected: true,
+		},
+		{
+			name:     "allows named service",
+			grant:    newGrant("backend-ns", httpRouteFrom, gwv1beta1.ReferenceGrantTo{Group: "", Kind: "Service", Name: &svcName}),
+			expected: true,
+		},
+		{
+			name:     "different service name",
+			grant:    newGrant("backend-ns", httpRouteFrom, gwv1beta1.ReferenceGrantTo{Group: "", Kind: "Service", Name: &otherName}),
+			expected: false,
+		},
+		{
+			name:     "grant in different namespace",
+			grant:    newGrant("other-ns", httpRouteFrom, serviceTo),
+			expected: false,
+		},
+		{
+			name:     "different route kind",
+			grant:    newGrant("backend-ns", gwv1beta1.ReferenceGrantFrom{Group: gwv1.GroupName, Kind: "GRPCRoute", Namespace: "route-ns"}, serviceTo),
+			expected: false,
+		},
+		{
+			name:     "different route namespace",
+			grant:    newGrant("backend-ns", gwv1beta1.ReferenceGrantFrom{Group: gwv1.GroupName, Kind: "HTTPRoute", Namespace: "other-ns"}, serviceTo),
+			expected: false,
+		},
+		{
+			name:     "different target kind",
+			grant:    newGrant("backend-ns", httpRouteFrom, gwv1beta1.ReferenceGrantTo{Group: "application-networking.k8s.aws", Kind: "ServiceImport"}),
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ReferenceGrantAllows(tt.grant, route, target))
+		})
+	}
+}
@@ -35,6 +35,12 @@ const (
 	// Additional tags
 	TagsAnnotationKey = AnnotationPrefix + "tags"
 
+	// ARN of the service network created by the controller for a Gateway, recorded to recreate it when deleted out of band
+	ServiceNetworkArnAnnotation = AnnotationPrefix + "service-network-arn"
+
+	// Auth type last applied by the controller to the service network of a Gateway, so only an auth type it set is reverted
+	ServiceNetworkAuthTypeAnnotation = AnnotationPrefix + "service-network-auth-type"
+
 	// HttpRoute takeover annotation
 	AllowTakeoverFromAnnotation = AnnotationPrefix + "allow-takeover-from"
 
@@ -50,6 +56,33 @@ const (
 	// TargetGroupArnAnnotation references external target group by its full arn on a ServiceImport
 	TargetGroupArnAnnotation = AnnotationPrefix + "target-group-arn"
 
+	// Orders route rules by Gateway API match precedence instead of rule index
+	PrecedenceRuleOrderingAnnotation = AnnotationPrefix + "precedence-rule-ordering"
+
+	// Zero-based index of a route rule without matches, used as the listener default action
+	DefaultRuleAnnotation = AnnotationPrefix + "default-rule"
+
+	// Status code of the listener default fixed response, when the route has no default rule
+	DefaultActionStatusCodeAnnotation = AnnotationPrefix + "default-action-status-code"
+
+	// Comma separated hostnames of a TLSRoute served by the rule at the given index
+	RuleHostnamesAnnotationFormat = AnnotationPrefix + "rule-%d-hostnames"
+
+	// Splits the rules of a route over several VPC Lattice services when they exceed the listener rule quota
+	RuleSplittingAnnotation = AnnotationPrefix + "rule-splitting"
+
+	// Status of the services built for the rule parts of a split route
+	LatticeServicePartsAnnotation = AnnotationPrefix + "lattice-service-parts"
+
+	// Builds one VPC Lattice service per route hostname instead of one for the first hostname
+	ServicePerHostnameAnnotation = AnnotationPrefix + "service-per-hostname"
+
+	// Route status listing the VPC Lattice service built for each route hostname
+	LatticeServicesAnnotation = AnnotationPrefix + "lattice-services"
+
+	// Shares the target groups of a service between all the routes referencing the same service port
+	SharedTargetGroupAnnotation = AnnotationPrefix + "shared-target-group"
+
 	AwsVpcAnnotation            = AnnotationPrefix + "aws-vpc"
 	AwsEksClusterNameAnnotation = AnnotationPrefix + "aws-eks-cluster-name"
 
@@ -304,6 +337,46 @@ func ParseBoolAnnotation(value string) bool {
 	return strings.ToLower(trimmed) == "true"
 }
 
+// IsPrecedenceRuleOrderingEnabled determines if a route's rule priorities should be computed from
+// Gateway API match precedence. The route annotation takes precedence over the controller default.
+func IsPrecedenceRuleOrderingEnabled(route core.Route) bool {
+	if value, exists := route.K8sObject().GetAnnotations()[PrecedenceRuleOrderingAnnotation]; exists {
+		return ParseBoolAnnotation(value)
+	}
+	return config.PrecedenceRuleOrdering
+}
+
+// IsServicePerHostnameEnabled determines if a route should get one VPC Lattice service per hostname.
+// The route annotation takes precedence over the controller default. TLSRoutes default to it, since
+// the SNI hostname selects the backend.
+func IsServicePerHostnameEnabled(route core.Route) bool {
+	if value, exists := route.K8sObject().GetAnnotations()[ServicePerHostnameAnnotation]; exists {
+		return ParseBoolAnnotation(value)
+	}
+	if _, ok := route.(*core.TLSRoute); ok {
+		return true
+	}
+	return config.ServicePerHostname
+}
+
+// IsRuleSplittingEnabled determines if a route exceeding the listener rule quota should be split across
+// several VPC Lattice services. The route annotation takes precedence over the controller default.
+func IsRuleSplittingEnabled(route core.Route) bool {
+	if value, exists := route.K8sObject().GetAnnotations()[RuleSplittingAnnotation]; exists {
+		return ParseBoolAnnotation(value)
+	}
+	return config.RuleSplitting
+}
+
+// IsSharedTargetGroupEnabled determines if the routes referencing a service should share one target group per
+// service port. The service annotation takes precedence over the controller default.
+func IsSharedTargetGroupEnabled(svc client.Object) bool {
+	if value, exists := svc.GetAnnotations()[SharedTargetGroupAnnotation]; exists {
+		return ParseBoolAnnotation(value)
+	}
+	return config.SharedTargetGroups
+}
+
 // GetStandaloneModeForRoute determines if standalone mode should be enabled for a route.
 // It checks the route-level annotation first (highest precedence), then falls back to
 // the gateway-level annotation. Returns false if neither annotation is present or set to "true".
@@ -555,6 +628,18 @@ func TargetGroupIdFromArn(s string) (string, error) {
 	return strings.TrimPrefix(a.Resource, "targetgroup/"), nil
 }
 
+// InstanceIdFromProviderId returns the EC2 instance ID of a node provider ID, aws:///<az>/<instance-id>
+func InstanceIdFromProviderId(providerId string) (string, error) {
+	if !strings.HasPrefix(providerId, "aws://") {
+		return "", fmt.Errorf("expected an aws provider ID, got %q", providerId)
+	}
+	instanceId := providerId[strings.LastIndex(providerId, "/")+1:]
+	if !strings.HasPrefix(instanceId, "i-") {
+		return "", fmt.Errorf("expected an EC2 instance provider ID, got %q", providerId)
+	}
+	return instanceId, nil
+}
+
 func ServiceImportHasTagDiscoveryAnnotation(annotations map[string]string) bool {
 	for _, key := range []string{ExportNameAnnotation, AwsVpcAnnotation, AwsEksClusterNameAnnotation} {
 		if _, ok := annotations[key]; ok {
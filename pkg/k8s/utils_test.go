@@ -1767,6 +1767,49 @@ func TestTargetGroupIdFromArn(t *testing.T) {
 	}
 }
 
+func TestInstanceIdFromProviderId(t *testing.T) {
+	tests := []struct {
+		name       string
+		providerId string
+		wantID     string
+		wantErr    bool
+	}{
+		{
+			name:       "ec2 instance",
+			providerId: "aws:///us-west-2a/i-0123456789abcdef0",
+			wantID:     "i-0123456789abcdef0",
+		},
+		{
+			name:       "empty",
+			providerId: "",
+			wantErr:    true,
+		},
+		{
+			name:       "other cloud provider",
+			providerId: "kind://docker/kind/kind-control-plane",
+			wantErr:    true,
+		},
+		{
+			name:       "fargate",
+			providerId: "aws:///us-west-2a/fargate-ip-10-0-0-1",
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := InstanceIdFromProviderId(tt.providerId)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Empty(t, id)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantID, id)
+			}
+		})
+	}
+}
+
 func TestHasTagDiscoveryAnnotation(t *testing.T) {
 	tests := []struct {
 		name string
@@ -1907,3 +1950,36 @@ func TestExternalTargetGroupTypedErrors(t *testing.T) {
 		assert.Contains(t, conflict.Error(), "export-name")
 	})
 }
+
+func TestIsServicePerHostnameEnabled(t *testing.T) {
+	tests := []struct {
+		name        string
+		route       core.Route
+		annotations map[string]string
+		expected    bool
+	}{
+		{
+			name:     "HTTPRoute without annotation",
+			route:    core.NewHTTPRoute(gwv1.HTTPRoute{}),
+			expected: false,
+		},
+		{
+			name:     "TLSRoute without annotation gets one service per hostname",
+			route:    core.NewTLSRoute(gwv1.TLSRoute{}),
+			expected: true,
+		},
+		{
+			name:        "TLSRoute with annotation disabled keeps a single service",
+			route:       core.NewTLSRoute(gwv1.TLSRoute{}),
+			annotations: map[string]string{ServicePerHostnameAnnotation: "false"},
+			expected:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.route.K8sObject().SetAnnotations(tt.annotations)
+			assert.Equal(t, tt.expected, IsServicePerHostnameEnabled(tt.route))
+		})
+	}
+}
@@ -188,6 +188,10 @@ func (r *GRPCRouteRule) Matches() []RouteMatch {
 	return routeMatches
 }
 
+func (r *GRPCRouteRule) Filters() []gwv1.GRPCRouteFilter {
+	return r.r.Filters
+}
+
 func (r *GRPCRouteRule) Equals(routeRule RouteRule) bool {
 	other, ok := routeRule.(*GRPCRouteRule)
 	if !ok {
@@ -188,6 +188,10 @@ func (r *HTTPRouteRule) Matches() []RouteMatch {
 	return routeMatches
 }
 
+func (r *HTTPRouteRule) Filters() []gwv1.HTTPRouteFilter {
+	return r.r.Filters
+}
+
 func (r *HTTPRouteRule) Equals(routeRule RouteRule) bool {
 	other, ok := routeRule.(*HTTPRouteRule)
 	if !ok {
@@ -33,10 +33,16 @@ type RuleSpec struct {
 	Action          RuleAction          `json:"action"`
 	CreateTime      time.Time           `json:"createtime"`
 	AdditionalTags  services.Tags       `json:"additionaltags,omitempty"`
+	// a route rule with multiple matches expands to one lattice rule per match,
+	// these identify the route rule and match each lattice rule was built from
+	RouteRuleIndex int `json:"routeruleindex"`
+	MatchIndex     int `json:"matchindex"`
 }
 
 type RuleAction struct {
 	TargetGroups []*RuleTargetGroup `json:"ruletarget"`
+	// when set, the rule returns this status code instead of forwarding to TargetGroups
+	FixedResponseStatusCode *int32 `json:"fixedresponsestatuscode,omitempty"`
 }
 
 type RuleTargetGroup struct {
@@ -21,6 +21,12 @@ type ServiceSpec struct {
 	AdditionalTags      services.Tags `json:"additionaltags,omitempty"`
 	AllowTakeoverFrom   string        `json:"allowtakeoverfrom,omitempty"`
 	ServiceNameOverride string        `json:"servicenameoverride,omitempty"`
+	// set on the services built for a route's hostnames after the first one, when the route has
+	// one service per hostname. Their names are derived from CustomerDomainName
+	AdditionalHostname bool `json:"additionalhostname,omitempty"`
+	// set on the services built for the rule parts after the first one, when the route rules are split
+	// across services
+	RulePart int `json:"rulepart,omitempty"`
 }
 
 type ServiceStatus struct {
@@ -73,5 +79,11 @@ func (s *Service) LatticeServiceName() string {
 }
 
 func (s *ServiceSpec) LatticeServiceName() string {
+	if s.RulePart > 0 {
+		return utils.LatticeServiceNameForRulePart(s.RouteName, s.RouteNamespace, s.ServiceNameOverride, s.RulePart)
+	}
+	if s.AdditionalHostname {
+		return utils.LatticeServiceNameForHostname(s.RouteName, s.RouteNamespace, s.ServiceNameOverride, s.CustomerDomainName)
+	}
 	return utils.LatticeServiceName(s.RouteName, s.RouteNamespace, s.ServiceNameOverride)
 }
@@ -1,6 +1,11 @@
 package lattice
 
 import (
+	"slices"
+
+	anv1alpha1 "github.com/aws/aws-application-networking-k8s/pkg/apis/applicationnetworking/v1alpha1"
+	"github.com/aws/aws-application-networking-k8s/pkg/aws"
+	"github.com/aws/aws-application-networking-k8s/pkg/aws/services"
 	"github.com/aws/aws-application-networking-k8s/pkg/model/core"
 )
 
@@ -9,6 +14,16 @@ const (
 	K8SServiceOwnedByVPC        = "K8SServiceOwnedByVPC"
 )
 
+// ServiceNetworkTagKey tags the access log subscriptions and VPC associations created for a ServiceNetwork resource,
+// or for the service network provisioned for a Gateway
+const ServiceNetworkTagKey = aws.TagBase + "ServiceNetwork"
+
+// Tags of the service networks provisioned for Gateways
+const (
+	K8SGatewayNameKey      = aws.TagBase + "GatewayName"
+	K8SGatewayNamespaceKey = aws.TagBase + "GatewayNamespace"
+)
+
 type ServiceNetwork struct {
 	core.ResourceMeta `json:"-"`
 	Spec              ServiceNetworkSpec    `json:"spec"`
@@ -46,3 +61,88 @@ func NewServiceNetwork(stack core.Stack, id string, spec ServiceNetworkSpec) *Se
 	return servicenetwork
 
 }
+
+// ServiceNetworkConfig is the configuration of a service network declared in a ServiceNetwork resource
+type ServiceNetworkConfig struct {
+	Name string
+	// AuthType is left as it is when nil
+	AuthType   *string
+	AuthPolicy *string
+	// AuthPolicyManaged tells whether the auth policy was set from a previous spec, so it is deleted when unset
+	AuthPolicyManaged     bool
+	AccessLogDestinations []string
+	VpcAssociations       []ServiceNetworkVpcAssociation
+	// Sharing is nil when the service network is not shared
+	Sharing *ServiceNetworkSharing
+	// SharingManaged tells whether the service network was shared from a previous spec, so its share is deleted when unset
+	SharingManaged bool
+	AdditionalTags services.Tags
+}
+
+type ServiceNetworkSharing struct {
+	Principals              []string
+	AllowExternalPrincipals bool
+}
+
+type ServiceNetworkVpcAssociation struct {
+	VpcId            string
+	SecurityGroupIds []string
+}
+
+type ServiceNetworkConfigStatus struct {
+	AuthType               string
+	AuthPolicyState        string
+	AccessLogSubscriptions []ServiceNetworkAccessLogSubscriptionStatus
+	VpcAssociations        []ServiceNetworkVpcAssociationStatus
+	Sharing                *ServiceNetworkSharingStatus
+}
+
+type ServiceNetworkAccessLogSubscriptionStatus struct {
+	DestinationArn string
+	Arn            string
+}
+
+type ServiceNetworkSharingStatus struct {
+	ResourceShareArn string
+	Status           string
+	Principals       []ServiceNetworkSharingPrincipalStatus
+}
+
+type ServiceNetworkSharingPrincipalStatus struct {
+	Principal         string
+	AssociationStatus string
+	InvitationStatus  string
+	Message           string
+}
+
+type ServiceNetworkVpcAssociationStatus struct {
+	VpcId            string
+	Arn              string
+	Status           string
+	SecurityGroupIds []string
+}
+
+func NewServiceNetworkConfig(sn *anv1alpha1.ServiceNetwork, additionalTags services.Tags) *ServiceNetworkConfig {
+	cfg := &ServiceNetworkConfig{
+		Name:                  sn.Name,
+		AuthType:              sn.Spec.AuthType,
+		AuthPolicy:            sn.Spec.AuthPolicy,
+		AuthPolicyManaged:     sn.Status.AuthPolicyState != "",
+		SharingManaged:        sn.Status.Sharing != nil,
+		AccessLogDestinations: sn.Spec.AccessLogDestinations,
+		AdditionalTags:        additionalTags,
+	}
+	for _, snva := range sn.Spec.VpcAssociations {
+		cfg.VpcAssociations = append(cfg.VpcAssociations, ServiceNetworkVpcAssociation{
+			VpcId:            snva.VpcId,
+			SecurityGroupIds: snva.SecurityGroupIds,
+		})
+	}
+	if sharing := sn.Spec.Sharing; sharing != nil {
+		cfg.Sharing = &ServiceNetworkSharing{
+			Principals:              append(slices.Clone(sharing.AccountIds), sharing.OrganizationalUnitArns...),
+			AllowExternalPrincipals: sharing.AllowExternalPrincipals == nil || *sharing.AllowExternalPrincipals,
+		}
+	}
+	return cfg
+}
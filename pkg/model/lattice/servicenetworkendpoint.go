@@ -0,0 +1,59 @@
+package lattice
+
+import (
+	anv1alpha1 "github.com/aws/aws-application-networking-k8s/pkg/apis/applicationnetworking/v1alpha1"
+	"github.com/aws/aws-application-networking-k8s/pkg/aws"
+	"github.com/aws/aws-application-networking-k8s/pkg/aws/services"
+)
+
+// ServiceNetworkEndpointTagKey tags the VPC endpoints created for ServiceNetworkEndpoint resources with their
+// namespaced name
+const ServiceNetworkEndpointTagKey = aws.TagBase + "ServiceNetworkEndpoint"
+
+// ServiceNetworkEndpoint is a VPC endpoint of type ServiceNetwork declared in a ServiceNetworkEndpoint resource
+type ServiceNetworkEndpoint struct {
+	// Name is the namespaced name of the ServiceNetworkEndpoint resource
+	Name           string
+	ServiceNetwork string
+	VpcId          string
+	SubnetIds      []string
+	// SecurityGroupIds are left as they are when empty, the default security group of the VPC being used
+	SecurityGroupIds []string
+	// AdditionalTags are applied when creating the VPC endpoint
+	AdditionalTags services.Tags
+}
+
+type ServiceNetworkEndpointStatus struct {
+	ServiceNetworkARN string
+	VpcEndpointId     string
+	State             string
+	Services          []ServiceNetworkEndpointService
+	// Modified is true when the VPC endpoint was created, replaced or updated
+	Modified bool
+}
+
+// ServiceNetworkEndpointService is a service reachable through a service network endpoint
+type ServiceNetworkEndpointService struct {
+	ServiceArn       string
+	CustomDomainName string
+	DnsName          string
+	HostedZoneId     string
+	Accessibility    string
+}
+
+func NewServiceNetworkEndpoint(sne *anv1alpha1.ServiceNetworkEndpoint, defaultVpcId string, additionalTags services.Tags) *ServiceNetworkEndpoint {
+	endpoint := &ServiceNetworkEndpoint{
+		Name:           sne.Namespace + "/" + sne.Name,
+		ServiceNetwork: sne.Spec.ServiceNetwork,
+		VpcId:          defaultVpcId,
+		SubnetIds:      sne.Spec.SubnetIds,
+		AdditionalTags: additionalTags,
+	}
+	if sne.Spec.VpcId != nil {
+		endpoint.VpcId = *sne.Spec.VpcId
+	}
+	for _, sgId := range sne.Spec.SecurityGroupIds {
+		endpoint.SecurityGroupIds = append(endpoint.SecurityGroupIds, string(sgId))
+	}
+	return endpoint
+}
@@ -20,6 +20,7 @@ const (
 	K8SRouteNamespaceKey   = aws.TagBase + "RouteNamespace"
 	K8SSourceTypeKey       = aws.TagBase + "SourceTypeKey"
 	K8SProtocolVersionKey  = aws.TagBase + "ProtocolVersion"
+	K8SSharedKeyKey        = aws.TagBase + "SharedTargetGroupKey"
 
 	// Service specific tags
 	K8SRouteTypeKey = aws.TagBase + "RouteType"
@@ -44,6 +45,14 @@ type TargetGroupSpec struct {
 	ProtocolVersion   string                   `json:"protocolversion"`
 	IpAddressType     string                   `json:"ipaddresstype"`
 	HealthCheckConfig *types.HealthCheckConfig `json:"healthcheckconfig"`
+	// only set for LAMBDA target groups
+	LambdaEventStructureVersion string `json:"lambdaeventstructureversion,omitempty"`
+	// how long the deletion of terminating pods is held for their targets to drain, not held when 0
+	DrainingTimeoutSeconds int64 `json:"drainingtimeoutseconds,omitempty"`
+	// name of the service port, selects the policies for this port only
+	K8SServicePortName string `json:"k8sserviceportname,omitempty"`
+	// set when the health check is derived from the readiness probes of the pods of the service
+	ReadinessProbe *ReadinessProbeHealthCheck `json:"readinessprobe,omitempty"`
 	TargetGroupTagFields
 	AdditionalTags services.Tags `json:"additionaltags,omitempty"`
 }
@@ -55,6 +64,18 @@ type TargetGroupTagFields struct {
 	K8SRouteName        string        `json:"k8sroutename"`
 	K8SRouteNamespace   string        `json:"k8sroutenamespace"`
 	K8SProtocolVersion  string        `json:"k8sprotocolversion"`
+	// set instead of the route name and namespace on a route target group shared by all the routes
+	// referencing the same service port, with the same protocol and health check
+	K8SSharedKey string `json:"k8ssharedkey,omitempty"`
+}
+
+// ReadinessProbeHealthCheck is the health check derived from the readiness probes of the pods behind a service port
+type ReadinessProbeHealthCheck struct {
+	// the service port, 0 for the only port of the service
+	Port              int32                    `json:"port"`
+	HealthCheckConfig *types.HealthCheckConfig `json:"healthcheckconfig"`
+	// the pods disagree on their readiness probe, the deployed health check is kept until they agree again
+	Conflict bool `json:"conflict,omitempty"`
 }
 
 type TargetGroupStatus struct {
@@ -68,7 +89,10 @@ type K8SSourceType string
 type RouteType string
 
 const (
-	TargetGroupTypeIP TargetGroupType = "IP"
+	TargetGroupTypeIP       TargetGroupType = "IP"
+	TargetGroupTypeInstance TargetGroupType = "INSTANCE"
+	TargetGroupTypeLambda   TargetGroupType = "LAMBDA"
+	TargetGroupTypeAlb      TargetGroupType = "ALB"
 
 	SourceTypeSvcExport K8SSourceType = "ServiceExport"
 	SourceTypeHTTPRoute K8SSourceType = "HTTPRoute"
@@ -86,6 +110,7 @@ func TGTagFieldsFromTags(tags map[string]string) TargetGroupTagFields {
 		K8SRouteName:        tags[K8SRouteNameKey],
 		K8SRouteNamespace:   tags[K8SRouteNamespaceKey],
 		K8SProtocolVersion:  tags[K8SProtocolVersionKey],
+		K8SSharedKey:        tags[K8SSharedKeyKey],
 	}
 }
 
@@ -99,7 +124,9 @@ func TagsFromTGTagFields(tagFields TargetGroupTagFields) map[string]string {
 		K8SSourceTypeKey:       st,
 		K8SProtocolVersionKey:  tagFields.K8SProtocolVersion,
 	}
-	if tagFields.K8SSourceType != SourceTypeSvcExport {
+	if tagFields.IsShared() {
+		tags[K8SSharedKeyKey] = tagFields.K8SSharedKey
+	} else if tagFields.K8SSourceType != SourceTypeSvcExport {
 		tags[K8SRouteNameKey] = tagFields.K8SRouteName
 		tags[K8SRouteNamespaceKey] = tagFields.K8SRouteNamespace
 	}
@@ -160,13 +187,33 @@ func (t *TargetGroupTagFields) IsSourceTypeRoute() bool {
 		t.K8SSourceType == SourceTypeTLSRoute
 }
 
+// IsShared tells whether a route target group is shared by the routes referencing its service port
+func (t *TargetGroupTagFields) IsShared() bool {
+	return t.K8SSharedKey != ""
+}
+
 func (t *TargetGroupSpec) Validate() error {
 	requiredFields := []string{t.K8SServiceName, t.K8SServiceNamespace,
-		t.Protocol, t.VpcId, t.K8SClusterName, t.IpAddressType,
-		string(t.K8SSourceType)}
-
-	if t.Protocol != "TCP" {
-		requiredFields = append(requiredFields, t.ProtocolVersion)
+		t.K8SClusterName, string(t.K8SSourceType)}
+
+	switch t.Type {
+	case TargetGroupTypeLambda:
+		// lambda target groups have no VPC, port or protocol
+		requiredFields = append(requiredFields, t.LambdaEventStructureVersion)
+	case TargetGroupTypeAlb:
+		// alb target groups have no ip address type
+		requiredFields = append(requiredFields, t.Protocol, t.ProtocolVersion, t.VpcId)
+	case TargetGroupTypeInstance:
+		// ip address type is only supported by ip target groups
+		requiredFields = append(requiredFields, t.Protocol, t.VpcId)
+		if t.Protocol != "TCP" {
+			requiredFields = append(requiredFields, t.ProtocolVersion)
+		}
+	default:
+		requiredFields = append(requiredFields, t.Protocol, t.VpcId, t.IpAddressType)
+		if t.Protocol != "TCP" {
+			requiredFields = append(requiredFields, t.ProtocolVersion)
+		}
 	}
 
 	for _, s := range requiredFields {
@@ -175,7 +222,7 @@ func (t *TargetGroupSpec) Validate() error {
 		}
 	}
 
-	if t.IsSourceTypeRoute() {
+	if t.IsSourceTypeRoute() && !t.IsShared() {
 		if t.K8SRouteName == "" || t.K8SRouteNamespace == "" {
 			return errors.New("route name or namespace missing for route-based target group")
 		}
@@ -8,6 +8,14 @@ import (
 type Targets struct {
 	core.ResourceMeta `json:"-"`
 	Spec              TargetsSpec `json:"spec"`
+	// what the targets of a service were built from, so that they can be rebuilt on endpoint changes alone
+	Source *TargetsSource `json:"-"`
+}
+
+type TargetsSource struct {
+	Service types.NamespacedName
+	// the service ports whose endpoints are targets, all of them when empty
+	Ports []int32
 }
 
 // unlike target groups, which can reference a service export, targets
@@ -19,9 +27,13 @@ type TargetsSpec struct {
 }
 
 type Target struct {
-	TargetIP  string `json:"targetip"`
-	Port      int64  `json:"port"`
-	Ready     bool   `json:"ready"`
+	// the EC2 instance ID for INSTANCE target groups, the function ARN for LAMBDA target groups,
+	// which have no port, and the load balancer ARN for ALB target groups
+	TargetIP string `json:"targetip"`
+	Port     int64  `json:"port"`
+	Ready    bool   `json:"ready"`
+	// a terminating endpoint that is still serving, deregistered so that VPC Lattice drains its connections
+	Draining  bool `json:"draining,omitempty"`
 	TargetRef types.NamespacedName
 }
 
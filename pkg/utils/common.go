@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"math/rand"
 	"strings"
@@ -58,6 +60,36 @@ func LatticeServiceName(k8sSourceRouteName string, k8sSourceRouteNamespace strin
 	return fmt.Sprintf("%s-%s", Truncate(k8sSourceRouteName, 20), Truncate(k8sSourceRouteNamespace, 18))
 }
 
+// LatticeServiceNameForHostname returns the name of the service built for an additional route hostname.
+// A short hash of the hostname keeps names unique and within the 40 character limit.
+func LatticeServiceNameForHostname(k8sSourceRouteName string, k8sSourceRouteNamespace string, serviceNameOverride string, hostname string) string {
+	if serviceNameOverride != "" {
+		return fmt.Sprintf("%s-%s", Truncate(serviceNameOverride, 31), HostnameHash(hostname))
+	}
+
+	return fmt.Sprintf("%s-%s-%s", Truncate(k8sSourceRouteName, 15), Truncate(k8sSourceRouteNamespace, 15), HostnameHash(hostname))
+}
+
+// LatticeServiceNameForRulePart returns the name of the service built for a rule part of a split route
+func LatticeServiceNameForRulePart(k8sSourceRouteName string, k8sSourceRouteNamespace string, serviceNameOverride string, part int) string {
+	if serviceNameOverride != "" {
+		return fmt.Sprintf("%s-part%d", Truncate(serviceNameOverride, 33), part)
+	}
+
+	return fmt.Sprintf("%s-%s-part%d", Truncate(k8sSourceRouteName, 16), Truncate(k8sSourceRouteNamespace, 15), part)
+}
+
+// RulePartHostname returns the custom domain name of the service built for a rule part of a split route
+func RulePartHostname(hostname string, part int) string {
+	return fmt.Sprintf("part%d.%s", part, hostname)
+}
+
+// HostnameHash returns the first 8 hex characters of the hostname's SHA-256 hash
+func HostnameHash(hostname string) string {
+	hash := sha256.Sum256([]byte(hostname))
+	return hex.EncodeToString(hash[:])[:8]
+}
+
 func RandomAlphaString(length int) string {
 	str := make([]rune, length)
 	for i := range str {
@@ -61,3 +61,29 @@ func TestSet(t *testing.T) {
 	})
 
 }
+
+func TestLatticeServiceNameForHostname(t *testing.T) {
+	name := LatticeServiceNameForHostname("a-very-long-route-name", "a-very-long-namespace", "", "api.example.com")
+	assert.Equal(t, "a-very-long-rou-a-very-long-nam-"+HostnameHash("api.example.com"), name)
+	assert.LessOrEqual(t, len(name), 40)
+
+	override := LatticeServiceNameForHostname("route", "ns", "my-service-name-override-that-is-long", "api.example.com")
+	assert.Equal(t, "my-service-name-override-that-i-"+HostnameHash("api.example.com"), override)
+	assert.LessOrEqual(t, len(override), 40)
+
+	assert.NotEqual(t,
+		LatticeServiceNameForHostname("route", "ns", "", "api.example.com"),
+		LatticeServiceNameForHostname("route", "ns", "", "legacy.example.com"))
+}
+
+func TestLatticeServiceNameForRulePart(t *testing.T) {
+	name := LatticeServiceNameForRulePart("a-very-long-route-name", "a-very-long-namespace", "", 12)
+	assert.Equal(t, "a-very-long-rout-a-very-long-nam-part12", name)
+	assert.LessOrEqual(t, len(name), 40)
+
+	override := LatticeServiceNameForRulePart("route", "ns", "my-long-service-name-override-that-is-long", 2)
+	assert.Equal(t, "my-long-service-name-override-tha-part2", override)
+	assert.LessOrEqual(t, len(override), 40)
+
+	assert.Equal(t, "part2.api.example.com", RulePartHostname("api.example.com", 2))
+}